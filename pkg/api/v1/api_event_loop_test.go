@@ -37,13 +37,21 @@ var _ = Describe("ApiEventLoop", func() {
 		reportClient, err := NewReportClient(reportClientFactory)
 		Expect(err).NotTo(HaveOccurred())
 
-		emitter = NewApiEmitter(experimentClient, reportClient)
+		experimentSuiteClientFactory := &factory.MemoryResourceClientFactory{
+			Cache: memory.NewInMemoryResourceCache(),
+		}
+		experimentSuiteClient, err := NewExperimentSuiteClient(experimentSuiteClientFactory)
+		Expect(err).NotTo(HaveOccurred())
+
+		emitter = NewApiEmitter(experimentClient, reportClient, experimentSuiteClient)
 	})
 	It("runs sync function on a new snapshot", func() {
 		_, err = emitter.Experiment().Write(NewExperiment(namespace, "jerry"), clients.WriteOpts{})
 		Expect(err).NotTo(HaveOccurred())
 		_, err = emitter.Report().Write(NewReport(namespace, "jerry"), clients.WriteOpts{})
 		Expect(err).NotTo(HaveOccurred())
+		_, err = emitter.ExperimentSuite().Write(NewExperimentSuite(namespace, "jerry"), clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
 		sync := &mockApiSyncer{}
 		el := NewApiEventLoop(emitter, sync)
 		_, err := el.Run([]string{namespace}, clients.WatchOpts{})
@@ -10,14 +10,16 @@ import (
 )
 
 type ApiSnapshot struct {
-	Experiments ExperimentList
-	Reports     ReportList
+	Experiments      ExperimentList
+	Reports          ReportList
+	Experimentsuites ExperimentSuiteList
 }
 
 func (s ApiSnapshot) Clone() ApiSnapshot {
 	return ApiSnapshot{
-		Experiments: s.Experiments.Clone(),
-		Reports:     s.Reports.Clone(),
+		Experiments:      s.Experiments.Clone(),
+		Reports:          s.Reports.Clone(),
+		Experimentsuites: s.Experimentsuites.Clone(),
 	}
 }
 
@@ -25,6 +27,7 @@ func (s ApiSnapshot) Hash() uint64 {
 	return hashutils.HashAll(
 		s.hashExperiments(),
 		s.hashReports(),
+		s.hashExperimentsuites(),
 	)
 }
 
@@ -36,18 +39,24 @@ func (s ApiSnapshot) hashReports() uint64 {
 	return hashutils.HashAll(s.Reports.AsInterfaces()...)
 }
 
+func (s ApiSnapshot) hashExperimentsuites() uint64 {
+	return hashutils.HashAll(s.Experimentsuites.AsInterfaces()...)
+}
+
 func (s ApiSnapshot) HashFields() []zap.Field {
 	var fields []zap.Field
 	fields = append(fields, zap.Uint64("experiments", s.hashExperiments()))
 	fields = append(fields, zap.Uint64("reports", s.hashReports()))
+	fields = append(fields, zap.Uint64("experimentsuites", s.hashExperimentsuites()))
 
 	return append(fields, zap.Uint64("snapshotHash", s.Hash()))
 }
 
 type ApiSnapshotStringer struct {
-	Version     uint64
-	Experiments []string
-	Reports     []string
+	Version          uint64
+	Experiments      []string
+	Reports          []string
+	Experimentsuites []string
 }
 
 func (ss ApiSnapshotStringer) String() string {
@@ -63,13 +72,19 @@ func (ss ApiSnapshotStringer) String() string {
 		s += fmt.Sprintf("    %v\n", name)
 	}
 
+	s += fmt.Sprintf("  Experimentsuites %v\n", len(ss.Experimentsuites))
+	for _, name := range ss.Experimentsuites {
+		s += fmt.Sprintf("    %v\n", name)
+	}
+
 	return s
 }
 
 func (s ApiSnapshot) Stringer() ApiSnapshotStringer {
 	return ApiSnapshotStringer{
-		Version:     s.Hash(),
-		Experiments: s.Experiments.NamespacesDotNames(),
-		Reports:     s.Reports.NamespacesDotNames(),
+		Version:          s.Hash(),
+		Experiments:      s.Experiments.NamespacesDotNames(),
+		Reports:          s.Reports.NamespacesDotNames(),
+		Experimentsuites: s.Experimentsuites.NamespacesDotNames(),
 	}
 }
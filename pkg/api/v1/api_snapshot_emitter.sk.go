@@ -43,25 +43,28 @@ type ApiEmitter interface {
 	Register() error
 	Experiment() ExperimentClient
 	Report() ReportClient
+	ExperimentSuite() ExperimentSuiteClient
 	Snapshots(watchNamespaces []string, opts clients.WatchOpts) (<-chan *ApiSnapshot, <-chan error, error)
 }
 
-func NewApiEmitter(experimentClient ExperimentClient, reportClient ReportClient) ApiEmitter {
-	return NewApiEmitterWithEmit(experimentClient, reportClient, make(chan struct{}))
+func NewApiEmitter(experimentClient ExperimentClient, reportClient ReportClient, experimentSuiteClient ExperimentSuiteClient) ApiEmitter {
+	return NewApiEmitterWithEmit(experimentClient, reportClient, experimentSuiteClient, make(chan struct{}))
 }
 
-func NewApiEmitterWithEmit(experimentClient ExperimentClient, reportClient ReportClient, emit <-chan struct{}) ApiEmitter {
+func NewApiEmitterWithEmit(experimentClient ExperimentClient, reportClient ReportClient, experimentSuiteClient ExperimentSuiteClient, emit <-chan struct{}) ApiEmitter {
 	return &apiEmitter{
-		experiment: experimentClient,
-		report:     reportClient,
-		forceEmit:  emit,
+		experiment:      experimentClient,
+		report:          reportClient,
+		experimentSuite: experimentSuiteClient,
+		forceEmit:       emit,
 	}
 }
 
 type apiEmitter struct {
-	forceEmit  <-chan struct{}
-	experiment ExperimentClient
-	report     ReportClient
+	forceEmit       <-chan struct{}
+	experiment      ExperimentClient
+	report          ReportClient
+	experimentSuite ExperimentSuiteClient
 }
 
 func (c *apiEmitter) Register() error {
@@ -71,6 +74,9 @@ func (c *apiEmitter) Register() error {
 	if err := c.report.Register(); err != nil {
 		return err
 	}
+	if err := c.experimentSuite.Register(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -82,6 +88,10 @@ func (c *apiEmitter) Report() ReportClient {
 	return c.report
 }
 
+func (c *apiEmitter) ExperimentSuite() ExperimentSuiteClient {
+	return c.experimentSuite
+}
+
 func (c *apiEmitter) Snapshots(watchNamespaces []string, opts clients.WatchOpts) (<-chan *ApiSnapshot, <-chan error, error) {
 
 	if len(watchNamespaces) == 0 {
@@ -110,6 +120,12 @@ func (c *apiEmitter) Snapshots(watchNamespaces []string, opts clients.WatchOpts)
 		namespace string
 	}
 	reportChan := make(chan reportListWithNamespace)
+	/* Create channel for ExperimentSuite */
+	type experimentSuiteListWithNamespace struct {
+		list      ExperimentSuiteList
+		namespace string
+	}
+	experimentSuiteChan := make(chan experimentSuiteListWithNamespace)
 
 	for _, namespace := range watchNamespaces {
 		/* Setup namespaced watch for Experiment */
@@ -134,6 +150,17 @@ func (c *apiEmitter) Snapshots(watchNamespaces []string, opts clients.WatchOpts)
 			defer done.Done()
 			errutils.AggregateErrs(ctx, errs, reportErrs, namespace+"-reports")
 		}(namespace)
+		/* Setup namespaced watch for ExperimentSuite */
+		experimentSuiteNamespacesChan, experimentSuiteErrs, err := c.experimentSuite.Watch(namespace, opts)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "starting ExperimentSuite watch")
+		}
+
+		done.Add(1)
+		go func(namespace string) {
+			defer done.Done()
+			errutils.AggregateErrs(ctx, errs, experimentSuiteErrs, namespace+"-experimentsuites")
+		}(namespace)
 
 		/* Watch for changes and update snapshot */
 		go func(namespace string) {
@@ -153,6 +180,12 @@ func (c *apiEmitter) Snapshots(watchNamespaces []string, opts clients.WatchOpts)
 						return
 					case reportChan <- reportListWithNamespace{list: reportList, namespace: namespace}:
 					}
+				case experimentSuiteList := <-experimentSuiteNamespacesChan:
+					select {
+					case <-ctx.Done():
+						return
+					case experimentSuiteChan <- experimentSuiteListWithNamespace{list: experimentSuiteList, namespace: namespace}:
+					}
 				}
 			}
 		}(namespace)
@@ -175,6 +208,7 @@ func (c *apiEmitter) Snapshots(watchNamespaces []string, opts clients.WatchOpts)
 		}
 		experimentsByNamespace := make(map[string]ExperimentList)
 		reportsByNamespace := make(map[string]ReportList)
+		experimentsuitesByNamespace := make(map[string]ExperimentSuiteList)
 
 		for {
 			record := func() { stats.Record(ctx, mApiSnapshotIn.M(1)) }
@@ -214,6 +248,18 @@ func (c *apiEmitter) Snapshots(watchNamespaces []string, opts clients.WatchOpts)
 					reportList = append(reportList, reports...)
 				}
 				currentSnapshot.Reports = reportList.Sort()
+			case experimentSuiteNamespacedList := <-experimentSuiteChan:
+				record()
+
+				namespace := experimentSuiteNamespacedList.namespace
+
+				// merge lists by namespace
+				experimentsuitesByNamespace[namespace] = experimentSuiteNamespacedList.list
+				var experimentSuiteList ExperimentSuiteList
+				for _, experimentsuites := range experimentsuitesByNamespace {
+					experimentSuiteList = append(experimentSuiteList, experimentsuites...)
+				}
+				currentSnapshot.Experimentsuites = experimentSuiteList.Sort()
 			}
 		}
 	}()
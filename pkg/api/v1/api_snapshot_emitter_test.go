@@ -33,14 +33,15 @@ var _ = Describe("V1Emitter", func() {
 		return
 	}
 	var (
-		namespace1       string
-		namespace2       string
-		name1, name2     = "angela" + helpers.RandString(3), "bob" + helpers.RandString(3)
-		cfg              *rest.Config
-		kube             kubernetes.Interface
-		emitter          ApiEmitter
-		experimentClient ExperimentClient
-		reportClient     ReportClient
+		namespace1            string
+		namespace2            string
+		name1, name2          = "angela" + helpers.RandString(3), "bob" + helpers.RandString(3)
+		cfg                   *rest.Config
+		kube                  kubernetes.Interface
+		emitter               ApiEmitter
+		experimentClient      ExperimentClient
+		reportClient          ReportClient
+		experimentSuiteClient ExperimentSuiteClient
 	)
 
 	BeforeEach(func() {
@@ -69,7 +70,16 @@ var _ = Describe("V1Emitter", func() {
 
 		reportClient, err = NewReportClient(reportClientFactory)
 		Expect(err).NotTo(HaveOccurred())
-		emitter = NewApiEmitter(experimentClient, reportClient)
+		// ExperimentSuite Constructor
+		experimentSuiteClientFactory := &factory.KubeResourceClientFactory{
+			Crd:         ExperimentSuiteCrd,
+			Cfg:         cfg,
+			SharedCache: kuberc.NewKubeCache(context.TODO()),
+		}
+
+		experimentSuiteClient, err = NewExperimentSuiteClient(experimentSuiteClientFactory)
+		Expect(err).NotTo(HaveOccurred())
+		emitter = NewApiEmitter(experimentClient, reportClient, experimentSuiteClient)
 	})
 	AfterEach(func() {
 		err := kubeutils.DeleteNamespacesInParallelBlocking(kube, namespace1, namespace2)
@@ -201,6 +211,63 @@ var _ = Describe("V1Emitter", func() {
 		Expect(err).NotTo(HaveOccurred())
 
 		assertSnapshotReports(nil, ReportList{report1a, report1b, report2a, report2b})
+
+		/*
+			ExperimentSuite
+		*/
+
+		assertSnapshotExperimentSuites := func(expectExperimentSuites ExperimentSuiteList, unexpectExperimentSuites ExperimentSuiteList) {
+		drain:
+			for {
+				select {
+				case snap = <-snapshots:
+					for _, expected := range expectExperimentSuites {
+						if _, err := snap.Experimentsuites.Find(expected.GetMetadata().Ref().Strings()); err != nil {
+							continue drain
+						}
+					}
+					for _, unexpected := range unexpectExperimentSuites {
+						if _, err := snap.Experimentsuites.Find(unexpected.GetMetadata().Ref().Strings()); err == nil {
+							continue drain
+						}
+					}
+					break drain
+				case err := <-errs:
+					Expect(err).NotTo(HaveOccurred())
+				case <-time.After(time.Second * 10):
+					nsList1, _ := experimentSuiteClient.List(namespace1, clients.ListOpts{})
+					nsList2, _ := experimentSuiteClient.List(namespace2, clients.ListOpts{})
+					combined := append(nsList1, nsList2...)
+					Fail("expected final snapshot before 10 seconds. expected " + log.Sprintf("%v", combined))
+				}
+			}
+		}
+		experimentSuite1a, err := experimentSuiteClient.Write(NewExperimentSuite(namespace1, name1), clients.WriteOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+		experimentSuite1b, err := experimentSuiteClient.Write(NewExperimentSuite(namespace2, name1), clients.WriteOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+
+		assertSnapshotExperimentSuites(ExperimentSuiteList{experimentSuite1a, experimentSuite1b}, nil)
+		experimentSuite2a, err := experimentSuiteClient.Write(NewExperimentSuite(namespace1, name2), clients.WriteOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+		experimentSuite2b, err := experimentSuiteClient.Write(NewExperimentSuite(namespace2, name2), clients.WriteOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+
+		assertSnapshotExperimentSuites(ExperimentSuiteList{experimentSuite1a, experimentSuite1b, experimentSuite2a, experimentSuite2b}, nil)
+
+		err = experimentSuiteClient.Delete(experimentSuite2a.GetMetadata().Namespace, experimentSuite2a.GetMetadata().Name, clients.DeleteOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+		err = experimentSuiteClient.Delete(experimentSuite2b.GetMetadata().Namespace, experimentSuite2b.GetMetadata().Name, clients.DeleteOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+
+		assertSnapshotExperimentSuites(ExperimentSuiteList{experimentSuite1a, experimentSuite1b}, ExperimentSuiteList{experimentSuite2a, experimentSuite2b})
+
+		err = experimentSuiteClient.Delete(experimentSuite1a.GetMetadata().Namespace, experimentSuite1a.GetMetadata().Name, clients.DeleteOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+		err = experimentSuiteClient.Delete(experimentSuite1b.GetMetadata().Namespace, experimentSuite1b.GetMetadata().Name, clients.DeleteOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+
+		assertSnapshotExperimentSuites(nil, ExperimentSuiteList{experimentSuite1a, experimentSuite1b, experimentSuite2a, experimentSuite2b})
 	})
 	It("tracks snapshots on changes to any resource using AllNamespace", func() {
 		ctx := context.Background()
@@ -328,5 +395,62 @@ var _ = Describe("V1Emitter", func() {
 		Expect(err).NotTo(HaveOccurred())
 
 		assertSnapshotReports(nil, ReportList{report1a, report1b, report2a, report2b})
+
+		/*
+			ExperimentSuite
+		*/
+
+		assertSnapshotExperimentSuites := func(expectExperimentSuites ExperimentSuiteList, unexpectExperimentSuites ExperimentSuiteList) {
+		drain:
+			for {
+				select {
+				case snap = <-snapshots:
+					for _, expected := range expectExperimentSuites {
+						if _, err := snap.Experimentsuites.Find(expected.GetMetadata().Ref().Strings()); err != nil {
+							continue drain
+						}
+					}
+					for _, unexpected := range unexpectExperimentSuites {
+						if _, err := snap.Experimentsuites.Find(unexpected.GetMetadata().Ref().Strings()); err == nil {
+							continue drain
+						}
+					}
+					break drain
+				case err := <-errs:
+					Expect(err).NotTo(HaveOccurred())
+				case <-time.After(time.Second * 10):
+					nsList1, _ := experimentSuiteClient.List(namespace1, clients.ListOpts{})
+					nsList2, _ := experimentSuiteClient.List(namespace2, clients.ListOpts{})
+					combined := append(nsList1, nsList2...)
+					Fail("expected final snapshot before 10 seconds. expected " + log.Sprintf("%v", combined))
+				}
+			}
+		}
+		experimentSuite1a, err := experimentSuiteClient.Write(NewExperimentSuite(namespace1, name1), clients.WriteOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+		experimentSuite1b, err := experimentSuiteClient.Write(NewExperimentSuite(namespace2, name1), clients.WriteOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+
+		assertSnapshotExperimentSuites(ExperimentSuiteList{experimentSuite1a, experimentSuite1b}, nil)
+		experimentSuite2a, err := experimentSuiteClient.Write(NewExperimentSuite(namespace1, name2), clients.WriteOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+		experimentSuite2b, err := experimentSuiteClient.Write(NewExperimentSuite(namespace2, name2), clients.WriteOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+
+		assertSnapshotExperimentSuites(ExperimentSuiteList{experimentSuite1a, experimentSuite1b, experimentSuite2a, experimentSuite2b}, nil)
+
+		err = experimentSuiteClient.Delete(experimentSuite2a.GetMetadata().Namespace, experimentSuite2a.GetMetadata().Name, clients.DeleteOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+		err = experimentSuiteClient.Delete(experimentSuite2b.GetMetadata().Namespace, experimentSuite2b.GetMetadata().Name, clients.DeleteOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+
+		assertSnapshotExperimentSuites(ExperimentSuiteList{experimentSuite1a, experimentSuite1b}, ExperimentSuiteList{experimentSuite2a, experimentSuite2b})
+
+		err = experimentSuiteClient.Delete(experimentSuite1a.GetMetadata().Namespace, experimentSuite1a.GetMetadata().Name, clients.DeleteOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+		err = experimentSuiteClient.Delete(experimentSuite1b.GetMetadata().Namespace, experimentSuite1b.GetMetadata().Name, clients.DeleteOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+
+		assertSnapshotExperimentSuites(nil, ExperimentSuiteList{experimentSuite1a, experimentSuite1b, experimentSuite2a, experimentSuite2b})
 	})
 })
@@ -85,6 +85,8 @@ func (c *apiSimpleEmitter) Snapshots(ctx context.Context) (<-chan *ApiSnapshot,
 						currentSnapshot.Experiments = append(currentSnapshot.Experiments, typed)
 					case *Report:
 						currentSnapshot.Reports = append(currentSnapshot.Reports, typed)
+					case *ExperimentSuite:
+						currentSnapshot.Experimentsuites = append(currentSnapshot.Experimentsuites, typed)
 					default:
 						select {
 						case errs <- fmt.Errorf("ApiSnapshotEmitter "+
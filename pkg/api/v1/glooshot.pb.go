@@ -41,6 +41,9 @@ const (
 	// If duration is not specified, the Experiment will never
 	// be marked Succeeded
 	ExperimentResult_Succeeded ExperimentResult_State = 3
+	// Experiment was not started because its spec is invalid
+	// The failure report describes the problem
+	ExperimentResult_Rejected ExperimentResult_State = 4
 )
 
 var ExperimentResult_State_name = map[int32]string{
@@ -48,6 +51,7 @@ var ExperimentResult_State_name = map[int32]string{
 	1: "Started",
 	2: "Failed",
 	3: "Succeeded",
+	4: "Rejected",
 }
 
 var ExperimentResult_State_value = map[string]int32{
@@ -55,6 +59,7 @@ var ExperimentResult_State_value = map[string]int32{
 	"Started":   1,
 	"Failed":    2,
 	"Succeeded": 3,
+	"Rejected":  4,
 }
 
 func (x ExperimentResult_State) String() string {
@@ -65,6 +70,234 @@ func (ExperimentResult_State) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_b9da8418b9c75752, []int{1, 0}
 }
 
+type PrometheusTrigger_ComparisonOperator int32
+
+const (
+	// fall back to comparison_operator
+	PrometheusTrigger_UNSPECIFIED           PrometheusTrigger_ComparisonOperator = 0
+	PrometheusTrigger_LESS_THAN             PrometheusTrigger_ComparisonOperator = 1
+	PrometheusTrigger_LESS_THAN_OR_EQUAL    PrometheusTrigger_ComparisonOperator = 2
+	PrometheusTrigger_GREATER_THAN          PrometheusTrigger_ComparisonOperator = 3
+	PrometheusTrigger_GREATER_THAN_OR_EQUAL PrometheusTrigger_ComparisonOperator = 4
+	PrometheusTrigger_EQUAL                 PrometheusTrigger_ComparisonOperator = 5
+	PrometheusTrigger_NOT_EQUAL             PrometheusTrigger_ComparisonOperator = 6
+	// met if the metric falls outside of the given range
+	PrometheusTrigger_OUTSIDE_RANGE PrometheusTrigger_ComparisonOperator = 7
+)
+
+var PrometheusTrigger_ComparisonOperator_name = map[int32]string{
+	0: "UNSPECIFIED",
+	1: "LESS_THAN",
+	2: "LESS_THAN_OR_EQUAL",
+	3: "GREATER_THAN",
+	4: "GREATER_THAN_OR_EQUAL",
+	5: "EQUAL",
+	6: "NOT_EQUAL",
+	7: "OUTSIDE_RANGE",
+}
+
+var PrometheusTrigger_ComparisonOperator_value = map[string]int32{
+	"UNSPECIFIED":           0,
+	"LESS_THAN":             1,
+	"LESS_THAN_OR_EQUAL":    2,
+	"GREATER_THAN":          3,
+	"GREATER_THAN_OR_EQUAL": 4,
+	"EQUAL":                 5,
+	"NOT_EQUAL":             6,
+	"OUTSIDE_RANGE":         7,
+}
+
+func (x PrometheusTrigger_ComparisonOperator) String() string {
+	return proto.EnumName(PrometheusTrigger_ComparisonOperator_name, int32(x))
+}
+
+func (PrometheusTrigger_ComparisonOperator) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b9da8418b9c75752, []int{4, 0}
+}
+
+type CompoundTrigger_Operator int32
+
+const (
+	// met if all of the conditions are met
+	CompoundTrigger_AND CompoundTrigger_Operator = 0
+	// met if any of the conditions is met
+	CompoundTrigger_OR CompoundTrigger_Operator = 1
+	// met if the single condition is not met
+	CompoundTrigger_NOT CompoundTrigger_Operator = 2
+)
+
+var CompoundTrigger_Operator_name = map[int32]string{
+	0: "AND",
+	1: "OR",
+	2: "NOT",
+}
+
+var CompoundTrigger_Operator_value = map[string]int32{
+	"AND": 0,
+	"OR":  1,
+	"NOT": 2,
+}
+
+func (x CompoundTrigger_Operator) String() string {
+	return proto.EnumName(CompoundTrigger_Operator_name, int32(x))
+}
+
+func (CompoundTrigger_Operator) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b9da8418b9c75752, []int{6, 0}
+}
+
+type ExperimentTemplate_Parameter_Type int32
+
+const (
+	// any string
+	ExperimentTemplate_Parameter_STRING ExperimentTemplate_Parameter_Type = 0
+	// a reference to a resource, given as namespace.name
+	ExperimentTemplate_Parameter_SERVICE_REF ExperimentTemplate_Parameter_Type = 1
+	// the name of a kubernetes namespace
+	ExperimentTemplate_Parameter_NAMESPACE ExperimentTemplate_Parameter_Type = 2
+	// a number between 0 and 100
+	ExperimentTemplate_Parameter_PERCENTAGE ExperimentTemplate_Parameter_Type = 3
+	// any number, e.g. the threshold of a failure condition
+	ExperimentTemplate_Parameter_NUMBER ExperimentTemplate_Parameter_Type = 4
+	// a duration such as 30s or 5m, rendered in seconds, e.g. 300s
+	ExperimentTemplate_Parameter_DURATION ExperimentTemplate_Parameter_Type = 5
+)
+
+var ExperimentTemplate_Parameter_Type_name = map[int32]string{
+	0: "STRING",
+	1: "SERVICE_REF",
+	2: "NAMESPACE",
+	3: "PERCENTAGE",
+	4: "NUMBER",
+	5: "DURATION",
+}
+
+var ExperimentTemplate_Parameter_Type_value = map[string]int32{
+	"STRING":      0,
+	"SERVICE_REF": 1,
+	"NAMESPACE":   2,
+	"PERCENTAGE":  3,
+	"NUMBER":      4,
+	"DURATION":    5,
+}
+
+func (x ExperimentTemplate_Parameter_Type) String() string {
+	return proto.EnumName(ExperimentTemplate_Parameter_Type_name, int32(x))
+}
+
+func (ExperimentTemplate_Parameter_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b9da8418b9c75752, []int{9, 0, 0}
+}
+
+type ExperimentSuite_Mode int32
+
+const (
+	// runs every experiment after the previous one concluded
+	ExperimentSuite_SEQUENTIAL ExperimentSuite_Mode = 0
+	// runs all experiments at once
+	ExperimentSuite_PARALLEL ExperimentSuite_Mode = 1
+	// runs every experiment after the previous one concluded, skipping the remaining experiments once one failed
+	ExperimentSuite_STOP_ON_FIRST_FAILURE ExperimentSuite_Mode = 2
+)
+
+var ExperimentSuite_Mode_name = map[int32]string{
+	0: "SEQUENTIAL",
+	1: "PARALLEL",
+	2: "STOP_ON_FIRST_FAILURE",
+}
+
+var ExperimentSuite_Mode_value = map[string]int32{
+	"SEQUENTIAL":            0,
+	"PARALLEL":              1,
+	"STOP_ON_FIRST_FAILURE": 2,
+}
+
+func (x ExperimentSuite_Mode) String() string {
+	return proto.EnumName(ExperimentSuite_Mode_name, int32(x))
+}
+
+func (ExperimentSuite_Mode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b9da8418b9c75752, []int{10, 0}
+}
+
+type SuiteResult_State int32
+
+const (
+	// the suite has not started
+	SuiteResult_Pending SuiteResult_State = 0
+	// experiments of the suite are running or waiting to be run
+	SuiteResult_Running SuiteResult_State = 1
+	// all experiments of the suite succeeded
+	SuiteResult_Succeeded SuiteResult_State = 2
+	// at least one experiment of the suite failed or was rejected
+	SuiteResult_Failed SuiteResult_State = 3
+	// the suite is invalid, none of its experiments were run
+	SuiteResult_Rejected SuiteResult_State = 4
+)
+
+var SuiteResult_State_name = map[int32]string{
+	0: "Pending",
+	1: "Running",
+	2: "Succeeded",
+	3: "Failed",
+	4: "Rejected",
+}
+
+var SuiteResult_State_value = map[string]int32{
+	"Pending":   0,
+	"Running":   1,
+	"Succeeded": 2,
+	"Failed":    3,
+	"Rejected":  4,
+}
+
+func (x SuiteResult_State) String() string {
+	return proto.EnumName(SuiteResult_State_name, int32(x))
+}
+
+func (SuiteResult_State) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b9da8418b9c75752, []int{11, 0}
+}
+
+type SuiteResult_EntryResult_State int32
+
+const (
+	// the experiment has not been created yet
+	SuiteResult_EntryResult_Waiting SuiteResult_EntryResult_State = 0
+	// the experiment was created and has not concluded
+	SuiteResult_EntryResult_Running SuiteResult_EntryResult_State = 1
+	// the experiment succeeded
+	SuiteResult_EntryResult_Succeeded SuiteResult_EntryResult_State = 2
+	// the experiment failed, was rejected, or could not be created
+	SuiteResult_EntryResult_Failed SuiteResult_EntryResult_State = 3
+	// the experiment was not run, as an earlier experiment failed
+	SuiteResult_EntryResult_Skipped SuiteResult_EntryResult_State = 4
+)
+
+var SuiteResult_EntryResult_State_name = map[int32]string{
+	0: "Waiting",
+	1: "Running",
+	2: "Succeeded",
+	3: "Failed",
+	4: "Skipped",
+}
+
+var SuiteResult_EntryResult_State_value = map[string]int32{
+	"Waiting":   0,
+	"Running":   1,
+	"Succeeded": 2,
+	"Failed":    3,
+	"Skipped":   4,
+}
+
+func (x SuiteResult_EntryResult_State) String() string {
+	return proto.EnumName(SuiteResult_EntryResult_State_name, int32(x))
+}
+
+func (SuiteResult_EntryResult_State) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b9da8418b9c75752, []int{11, 0, 0}
+}
+
 //
 //Describes an Experiment that GlooShot should run
 type Experiment struct {
@@ -384,6 +617,8 @@ type FailureCondition_Trigger struct {
 	// Types that are valid to be assigned to FailureTrigger:
 	//	*FailureCondition_Trigger_WebhookUrl
 	//	*FailureCondition_Trigger_Prometheus
+	//	*FailureCondition_Trigger_ErrorBudget
+	//	*FailureCondition_Trigger_Compound
 	FailureTrigger       isFailureCondition_Trigger_FailureTrigger `protobuf_oneof:"failure_trigger"`
 	XXX_NoUnkeyedLiteral struct{}                                  `json:"-"`
 	XXX_unrecognized     []byte                                    `json:"-"`
@@ -425,9 +660,17 @@ type FailureCondition_Trigger_WebhookUrl struct {
 type FailureCondition_Trigger_Prometheus struct {
 	Prometheus *PrometheusTrigger `protobuf:"bytes,2,opt,name=prometheus,proto3,oneof"`
 }
+type FailureCondition_Trigger_ErrorBudget struct {
+	ErrorBudget *ErrorBudgetTrigger `protobuf:"bytes,3,opt,name=error_budget,json=errorBudget,proto3,oneof"`
+}
+type FailureCondition_Trigger_Compound struct {
+	Compound *CompoundTrigger `protobuf:"bytes,4,opt,name=compound,proto3,oneof"`
+}
 
-func (*FailureCondition_Trigger_WebhookUrl) isFailureCondition_Trigger_FailureTrigger() {}
-func (*FailureCondition_Trigger_Prometheus) isFailureCondition_Trigger_FailureTrigger() {}
+func (*FailureCondition_Trigger_WebhookUrl) isFailureCondition_Trigger_FailureTrigger()  {}
+func (*FailureCondition_Trigger_Prometheus) isFailureCondition_Trigger_FailureTrigger()  {}
+func (*FailureCondition_Trigger_ErrorBudget) isFailureCondition_Trigger_FailureTrigger() {}
+func (*FailureCondition_Trigger_Compound) isFailureCondition_Trigger_FailureTrigger()    {}
 
 func (m *FailureCondition_Trigger) GetFailureTrigger() isFailureCondition_Trigger_FailureTrigger {
 	if m != nil {
@@ -450,11 +693,27 @@ func (m *FailureCondition_Trigger) GetPrometheus() *PrometheusTrigger {
 	return nil
 }
 
+func (m *FailureCondition_Trigger) GetErrorBudget() *ErrorBudgetTrigger {
+	if x, ok := m.GetFailureTrigger().(*FailureCondition_Trigger_ErrorBudget); ok {
+		return x.ErrorBudget
+	}
+	return nil
+}
+
+func (m *FailureCondition_Trigger) GetCompound() *CompoundTrigger {
+	if x, ok := m.GetFailureTrigger().(*FailureCondition_Trigger_Compound); ok {
+		return x.Compound
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*FailureCondition_Trigger) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _FailureCondition_Trigger_OneofMarshaler, _FailureCondition_Trigger_OneofUnmarshaler, _FailureCondition_Trigger_OneofSizer, []interface{}{
 		(*FailureCondition_Trigger_WebhookUrl)(nil),
 		(*FailureCondition_Trigger_Prometheus)(nil),
+		(*FailureCondition_Trigger_ErrorBudget)(nil),
+		(*FailureCondition_Trigger_Compound)(nil),
 	}
 }
 
@@ -470,6 +729,16 @@ func _FailureCondition_Trigger_OneofMarshaler(msg proto.Message, b *proto.Buffer
 		if err := b.EncodeMessage(x.Prometheus); err != nil {
 			return err
 		}
+	case *FailureCondition_Trigger_ErrorBudget:
+		_ = b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ErrorBudget); err != nil {
+			return err
+		}
+	case *FailureCondition_Trigger_Compound:
+		_ = b.EncodeVarint(4<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Compound); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("FailureCondition_Trigger.FailureTrigger has unexpected type %T", x)
@@ -495,6 +764,22 @@ func _FailureCondition_Trigger_OneofUnmarshaler(msg proto.Message, tag, wire int
 		err := b.DecodeMessage(msg)
 		m.FailureTrigger = &FailureCondition_Trigger_Prometheus{msg}
 		return true, err
+	case 3: // failure_trigger.error_budget
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ErrorBudgetTrigger)
+		err := b.DecodeMessage(msg)
+		m.FailureTrigger = &FailureCondition_Trigger_ErrorBudget{msg}
+		return true, err
+	case 4: // failure_trigger.compound
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(CompoundTrigger)
+		err := b.DecodeMessage(msg)
+		m.FailureTrigger = &FailureCondition_Trigger_Compound{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -513,6 +798,16 @@ func _FailureCondition_Trigger_OneofSizer(msg proto.Message) (n int) {
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *FailureCondition_Trigger_ErrorBudget:
+		s := proto.Size(x.ErrorBudget)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *FailureCondition_Trigger_Compound:
+		s := proto.Size(x.Compound)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -524,17 +819,30 @@ type PrometheusTrigger struct {
 	// Types that are valid to be assigned to QueryType:
 	//	*PrometheusTrigger_CustomQuery
 	//	*PrometheusTrigger_SuccessRate
+	//	*PrometheusTrigger_LatencyPercentile
+	//	*PrometheusTrigger_RequestVolume
+	//	*PrometheusTrigger_ErrorRate
+	//	*PrometheusTrigger_TcpConnectionErrors
+	//	*PrometheusTrigger_ContainerRestarts
 	QueryType isPrometheusTrigger_QueryType `protobuf_oneof:"query_type"`
 	// consider the failure condition met if the metric falls below this threshold
 	ThresholdValue float64 `protobuf:"fixed64,3,opt,name=threshold_value,json=thresholdValue,proto3" json:"threshold_value,omitempty"`
 	// the comparison operator to use when comparing the threshold and observed metric values
 	// if the comparison evaluates to true, the failure condition will be considered met
-	// possible values are '==', '>', '<', '>=', and '<='
+	// possible values are '==', '!=', '>', '<', '>=', and '<='
 	// defaults to '<'
-	ComparisonOperator   string   `protobuf:"bytes,4,opt,name=comparison_operator,json=comparisonOperator,proto3" json:"comparison_operator,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	// deprecated: use operator instead
+	ComparisonOperator string `protobuf:"bytes,4,opt,name=comparison_operator,json=comparisonOperator,proto3" json:"comparison_operator,omitempty"`
+	// the comparison to use when comparing the threshold and observed metric values
+	// if the comparison evaluates to true, the failure condition will be considered met
+	// takes precedence over comparison_operator, and must agree with it if both are set
+	// defaults to LESS_THAN
+	Operator PrometheusTrigger_ComparisonOperator `protobuf:"varint,10,opt,name=operator,proto3,enum=glooshot.solo.io.PrometheusTrigger_ComparisonOperator" json:"operator,omitempty"`
+	// the bounds used by the OUTSIDE_RANGE operator
+	Range                *PrometheusTrigger_Range `protobuf:"bytes,11,opt,name=range,proto3" json:"range,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
 func (m *PrometheusTrigger) Reset()         { *m = PrometheusTrigger{} }
@@ -572,9 +880,29 @@ type PrometheusTrigger_CustomQuery struct {
 type PrometheusTrigger_SuccessRate struct {
 	SuccessRate *PrometheusTrigger_SuccessRateQuery `protobuf:"bytes,2,opt,name=success_rate,json=successRate,proto3,oneof"`
 }
+type PrometheusTrigger_LatencyPercentile struct {
+	LatencyPercentile *PrometheusTrigger_LatencyPercentileQuery `protobuf:"bytes,5,opt,name=latency_percentile,json=latencyPercentile,proto3,oneof"`
+}
+type PrometheusTrigger_RequestVolume struct {
+	RequestVolume *PrometheusTrigger_RequestVolumeQuery `protobuf:"bytes,6,opt,name=request_volume,json=requestVolume,proto3,oneof"`
+}
+type PrometheusTrigger_ErrorRate struct {
+	ErrorRate *PrometheusTrigger_ErrorRateQuery `protobuf:"bytes,7,opt,name=error_rate,json=errorRate,proto3,oneof"`
+}
+type PrometheusTrigger_TcpConnectionErrors struct {
+	TcpConnectionErrors *PrometheusTrigger_TcpConnectionErrorsQuery `protobuf:"bytes,8,opt,name=tcp_connection_errors,json=tcpConnectionErrors,proto3,oneof"`
+}
+type PrometheusTrigger_ContainerRestarts struct {
+	ContainerRestarts *PrometheusTrigger_ContainerRestartsQuery `protobuf:"bytes,9,opt,name=container_restarts,json=containerRestarts,proto3,oneof"`
+}
 
-func (*PrometheusTrigger_CustomQuery) isPrometheusTrigger_QueryType() {}
-func (*PrometheusTrigger_SuccessRate) isPrometheusTrigger_QueryType() {}
+func (*PrometheusTrigger_CustomQuery) isPrometheusTrigger_QueryType()         {}
+func (*PrometheusTrigger_SuccessRate) isPrometheusTrigger_QueryType()         {}
+func (*PrometheusTrigger_LatencyPercentile) isPrometheusTrigger_QueryType()   {}
+func (*PrometheusTrigger_RequestVolume) isPrometheusTrigger_QueryType()       {}
+func (*PrometheusTrigger_ErrorRate) isPrometheusTrigger_QueryType()           {}
+func (*PrometheusTrigger_TcpConnectionErrors) isPrometheusTrigger_QueryType() {}
+func (*PrometheusTrigger_ContainerRestarts) isPrometheusTrigger_QueryType()   {}
 
 func (m *PrometheusTrigger) GetQueryType() isPrometheusTrigger_QueryType {
 	if m != nil {
@@ -597,6 +925,41 @@ func (m *PrometheusTrigger) GetSuccessRate() *PrometheusTrigger_SuccessRateQuery
 	return nil
 }
 
+func (m *PrometheusTrigger) GetLatencyPercentile() *PrometheusTrigger_LatencyPercentileQuery {
+	if x, ok := m.GetQueryType().(*PrometheusTrigger_LatencyPercentile); ok {
+		return x.LatencyPercentile
+	}
+	return nil
+}
+
+func (m *PrometheusTrigger) GetRequestVolume() *PrometheusTrigger_RequestVolumeQuery {
+	if x, ok := m.GetQueryType().(*PrometheusTrigger_RequestVolume); ok {
+		return x.RequestVolume
+	}
+	return nil
+}
+
+func (m *PrometheusTrigger) GetErrorRate() *PrometheusTrigger_ErrorRateQuery {
+	if x, ok := m.GetQueryType().(*PrometheusTrigger_ErrorRate); ok {
+		return x.ErrorRate
+	}
+	return nil
+}
+
+func (m *PrometheusTrigger) GetTcpConnectionErrors() *PrometheusTrigger_TcpConnectionErrorsQuery {
+	if x, ok := m.GetQueryType().(*PrometheusTrigger_TcpConnectionErrors); ok {
+		return x.TcpConnectionErrors
+	}
+	return nil
+}
+
+func (m *PrometheusTrigger) GetContainerRestarts() *PrometheusTrigger_ContainerRestartsQuery {
+	if x, ok := m.GetQueryType().(*PrometheusTrigger_ContainerRestarts); ok {
+		return x.ContainerRestarts
+	}
+	return nil
+}
+
 func (m *PrometheusTrigger) GetThresholdValue() float64 {
 	if m != nil {
 		return m.ThresholdValue
@@ -611,11 +974,30 @@ func (m *PrometheusTrigger) GetComparisonOperator() string {
 	return ""
 }
 
+func (m *PrometheusTrigger) GetOperator() PrometheusTrigger_ComparisonOperator {
+	if m != nil {
+		return m.Operator
+	}
+	return PrometheusTrigger_UNSPECIFIED
+}
+
+func (m *PrometheusTrigger) GetRange() *PrometheusTrigger_Range {
+	if m != nil {
+		return m.Range
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*PrometheusTrigger) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _PrometheusTrigger_OneofMarshaler, _PrometheusTrigger_OneofUnmarshaler, _PrometheusTrigger_OneofSizer, []interface{}{
 		(*PrometheusTrigger_CustomQuery)(nil),
 		(*PrometheusTrigger_SuccessRate)(nil),
+		(*PrometheusTrigger_LatencyPercentile)(nil),
+		(*PrometheusTrigger_RequestVolume)(nil),
+		(*PrometheusTrigger_ErrorRate)(nil),
+		(*PrometheusTrigger_TcpConnectionErrors)(nil),
+		(*PrometheusTrigger_ContainerRestarts)(nil),
 	}
 }
 
@@ -631,6 +1013,31 @@ func _PrometheusTrigger_OneofMarshaler(msg proto.Message, b *proto.Buffer) error
 		if err := b.EncodeMessage(x.SuccessRate); err != nil {
 			return err
 		}
+	case *PrometheusTrigger_LatencyPercentile:
+		_ = b.EncodeVarint(5<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.LatencyPercentile); err != nil {
+			return err
+		}
+	case *PrometheusTrigger_RequestVolume:
+		_ = b.EncodeVarint(6<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.RequestVolume); err != nil {
+			return err
+		}
+	case *PrometheusTrigger_ErrorRate:
+		_ = b.EncodeVarint(7<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ErrorRate); err != nil {
+			return err
+		}
+	case *PrometheusTrigger_TcpConnectionErrors:
+		_ = b.EncodeVarint(8<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.TcpConnectionErrors); err != nil {
+			return err
+		}
+	case *PrometheusTrigger_ContainerRestarts:
+		_ = b.EncodeVarint(9<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ContainerRestarts); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("PrometheusTrigger.QueryType has unexpected type %T", x)
@@ -656,6 +1063,46 @@ func _PrometheusTrigger_OneofUnmarshaler(msg proto.Message, tag, wire int, b *pr
 		err := b.DecodeMessage(msg)
 		m.QueryType = &PrometheusTrigger_SuccessRate{msg}
 		return true, err
+	case 5: // query_type.latency_percentile
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(PrometheusTrigger_LatencyPercentileQuery)
+		err := b.DecodeMessage(msg)
+		m.QueryType = &PrometheusTrigger_LatencyPercentile{msg}
+		return true, err
+	case 6: // query_type.request_volume
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(PrometheusTrigger_RequestVolumeQuery)
+		err := b.DecodeMessage(msg)
+		m.QueryType = &PrometheusTrigger_RequestVolume{msg}
+		return true, err
+	case 7: // query_type.error_rate
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(PrometheusTrigger_ErrorRateQuery)
+		err := b.DecodeMessage(msg)
+		m.QueryType = &PrometheusTrigger_ErrorRate{msg}
+		return true, err
+	case 8: // query_type.tcp_connection_errors
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(PrometheusTrigger_TcpConnectionErrorsQuery)
+		err := b.DecodeMessage(msg)
+		m.QueryType = &PrometheusTrigger_TcpConnectionErrors{msg}
+		return true, err
+	case 9: // query_type.container_restarts
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(PrometheusTrigger_ContainerRestartsQuery)
+		err := b.DecodeMessage(msg)
+		m.QueryType = &PrometheusTrigger_ContainerRestarts{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -674,6 +1121,31 @@ func _PrometheusTrigger_OneofSizer(msg proto.Message) (n int) {
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *PrometheusTrigger_LatencyPercentile:
+		s := proto.Size(x.LatencyPercentile)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *PrometheusTrigger_RequestVolume:
+		s := proto.Size(x.RequestVolume)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *PrometheusTrigger_ErrorRate:
+		s := proto.Size(x.ErrorRate)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *PrometheusTrigger_TcpConnectionErrors:
+		s := proto.Size(x.TcpConnectionErrors)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *PrometheusTrigger_ContainerRestarts:
+		s := proto.Size(x.ContainerRestarts)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -681,6 +1153,53 @@ func _PrometheusTrigger_OneofSizer(msg proto.Message) (n int) {
 	return n
 }
 
+// an inclusive range of acceptable metric values
+type PrometheusTrigger_Range struct {
+	Low                  float64  `protobuf:"fixed64,1,opt,name=low,proto3" json:"low,omitempty"`
+	High                 float64  `protobuf:"fixed64,2,opt,name=high,proto3" json:"high,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PrometheusTrigger_Range) Reset()         { *m = PrometheusTrigger_Range{} }
+func (m *PrometheusTrigger_Range) String() string { return proto.CompactTextString(m) }
+func (*PrometheusTrigger_Range) ProtoMessage()    {}
+func (*PrometheusTrigger_Range) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9da8418b9c75752, []int{4, 0}
+}
+func (m *PrometheusTrigger_Range) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PrometheusTrigger_Range.Unmarshal(m, b)
+}
+func (m *PrometheusTrigger_Range) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PrometheusTrigger_Range.Marshal(b, m, deterministic)
+}
+func (m *PrometheusTrigger_Range) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrometheusTrigger_Range.Merge(m, src)
+}
+func (m *PrometheusTrigger_Range) XXX_Size() int {
+	return xxx_messageInfo_PrometheusTrigger_Range.Size(m)
+}
+func (m *PrometheusTrigger_Range) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrometheusTrigger_Range.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrometheusTrigger_Range proto.InternalMessageInfo
+
+func (m *PrometheusTrigger_Range) GetLow() float64 {
+	if m != nil {
+		return m.Low
+	}
+	return 0
+}
+
+func (m *PrometheusTrigger_Range) GetHigh() float64 {
+	if m != nil {
+		return m.High
+	}
+	return 0
+}
+
 // returns the # of non-5XX requests / total requests for the given interval
 type PrometheusTrigger_SuccessRateQuery struct {
 	// the service whose success rate Glooshot should monitor
@@ -697,7 +1216,7 @@ func (m *PrometheusTrigger_SuccessRateQuery) Reset()         { *m = PrometheusTr
 func (m *PrometheusTrigger_SuccessRateQuery) String() string { return proto.CompactTextString(m) }
 func (*PrometheusTrigger_SuccessRateQuery) ProtoMessage()    {}
 func (*PrometheusTrigger_SuccessRateQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b9da8418b9c75752, []int{4, 0}
+	return fileDescriptor_b9da8418b9c75752, []int{4, 1}
 }
 func (m *PrometheusTrigger_SuccessRateQuery) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PrometheusTrigger_SuccessRateQuery.Unmarshal(m, b)
@@ -731,49 +1250,467 @@ func (m *PrometheusTrigger_SuccessRateQuery) GetInterval() *time.Duration {
 	return nil
 }
 
-// a snapshot of experiment metric values
-type Report struct {
-	// the object metadata for this resource
-	Metadata core.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata"`
-	Status   core.Status   `protobuf:"bytes,3,opt,name=status,proto3" json:"status"`
-	// Name of the experiment this report pertains to
-	Experiment *core.ResourceRef `protobuf:"bytes,4,opt,name=experiment,proto3" json:"experiment,omitempty"`
-	// the measured values of each of the failure conditions at the time the report was captured
-	FailureConditionHistory []*Report_FailureConditionHistory `protobuf:"bytes,5,rep,name=failure_condition_history,json=failureConditionHistory,proto3" json:"failure_condition_history,omitempty"`
-	XXX_NoUnkeyedLiteral    struct{}                          `json:"-"`
-	XXX_unrecognized        []byte                            `json:"-"`
-	XXX_sizecache           int32                             `json:"-"`
+// returns the given percentile of request duration, in milliseconds, for the given interval
+type PrometheusTrigger_LatencyPercentileQuery struct {
+	// the service whose latency Glooshot should monitor
+	Service *core.ResourceRef `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	// the time interval over which the latency should be measured
+	// defaults to 1 minute
+	Interval *time.Duration `protobuf:"bytes,2,opt,name=interval,proto3,stdduration" json:"interval,omitempty"`
+	// the percentile to measure, e.g. 50, 90 or 99
+	// must be greater than 0 and less than 100
+	// defaults to 99
+	Percentile           float64  `protobuf:"fixed64,3,opt,name=percentile,proto3" json:"percentile,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Report) Reset()         { *m = Report{} }
-func (m *Report) String() string { return proto.CompactTextString(m) }
-func (*Report) ProtoMessage()    {}
-func (*Report) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b9da8418b9c75752, []int{5}
+func (m *PrometheusTrigger_LatencyPercentileQuery) Reset() {
+	*m = PrometheusTrigger_LatencyPercentileQuery{}
 }
-func (m *Report) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Report.Unmarshal(m, b)
+func (m *PrometheusTrigger_LatencyPercentileQuery) String() string { return proto.CompactTextString(m) }
+func (*PrometheusTrigger_LatencyPercentileQuery) ProtoMessage()    {}
+func (*PrometheusTrigger_LatencyPercentileQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9da8418b9c75752, []int{4, 2}
 }
-func (m *Report) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Report.Marshal(b, m, deterministic)
+func (m *PrometheusTrigger_LatencyPercentileQuery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PrometheusTrigger_LatencyPercentileQuery.Unmarshal(m, b)
 }
-func (m *Report) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Report.Merge(m, src)
+func (m *PrometheusTrigger_LatencyPercentileQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PrometheusTrigger_LatencyPercentileQuery.Marshal(b, m, deterministic)
 }
-func (m *Report) XXX_Size() int {
-	return xxx_messageInfo_Report.Size(m)
+func (m *PrometheusTrigger_LatencyPercentileQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrometheusTrigger_LatencyPercentileQuery.Merge(m, src)
 }
-func (m *Report) XXX_DiscardUnknown() {
-	xxx_messageInfo_Report.DiscardUnknown(m)
+func (m *PrometheusTrigger_LatencyPercentileQuery) XXX_Size() int {
+	return xxx_messageInfo_PrometheusTrigger_LatencyPercentileQuery.Size(m)
+}
+func (m *PrometheusTrigger_LatencyPercentileQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrometheusTrigger_LatencyPercentileQuery.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Report proto.InternalMessageInfo
+var xxx_messageInfo_PrometheusTrigger_LatencyPercentileQuery proto.InternalMessageInfo
 
-func (m *Report) GetMetadata() core.Metadata {
+func (m *PrometheusTrigger_LatencyPercentileQuery) GetService() *core.ResourceRef {
 	if m != nil {
-		return m.Metadata
+		return m.Service
 	}
-	return core.Metadata{}
+	return nil
+}
+
+func (m *PrometheusTrigger_LatencyPercentileQuery) GetInterval() *time.Duration {
+	if m != nil {
+		return m.Interval
+	}
+	return nil
+}
+
+func (m *PrometheusTrigger_LatencyPercentileQuery) GetPercentile() float64 {
+	if m != nil {
+		return m.Percentile
+	}
+	return 0
+}
+
+// returns the # of requests per second received by the service for the given interval
+// use with the '<' comparison operator to detect a drop in request volume
+type PrometheusTrigger_RequestVolumeQuery struct {
+	// the service whose request volume Glooshot should monitor
+	Service *core.ResourceRef `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	// the time interval over which the request volume should be measured
+	// defaults to 1 minute
+	Interval             *time.Duration `protobuf:"bytes,2,opt,name=interval,proto3,stdduration" json:"interval,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *PrometheusTrigger_RequestVolumeQuery) Reset()         { *m = PrometheusTrigger_RequestVolumeQuery{} }
+func (m *PrometheusTrigger_RequestVolumeQuery) String() string { return proto.CompactTextString(m) }
+func (*PrometheusTrigger_RequestVolumeQuery) ProtoMessage()    {}
+func (*PrometheusTrigger_RequestVolumeQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9da8418b9c75752, []int{4, 3}
+}
+func (m *PrometheusTrigger_RequestVolumeQuery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PrometheusTrigger_RequestVolumeQuery.Unmarshal(m, b)
+}
+func (m *PrometheusTrigger_RequestVolumeQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PrometheusTrigger_RequestVolumeQuery.Marshal(b, m, deterministic)
+}
+func (m *PrometheusTrigger_RequestVolumeQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrometheusTrigger_RequestVolumeQuery.Merge(m, src)
+}
+func (m *PrometheusTrigger_RequestVolumeQuery) XXX_Size() int {
+	return xxx_messageInfo_PrometheusTrigger_RequestVolumeQuery.Size(m)
+}
+func (m *PrometheusTrigger_RequestVolumeQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrometheusTrigger_RequestVolumeQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrometheusTrigger_RequestVolumeQuery proto.InternalMessageInfo
+
+func (m *PrometheusTrigger_RequestVolumeQuery) GetService() *core.ResourceRef {
+	if m != nil {
+		return m.Service
+	}
+	return nil
+}
+
+func (m *PrometheusTrigger_RequestVolumeQuery) GetInterval() *time.Duration {
+	if m != nil {
+		return m.Interval
+	}
+	return nil
+}
+
+// returns the # of 5XX requests / total requests for the given interval
+type PrometheusTrigger_ErrorRateQuery struct {
+	// the service whose error rate Glooshot should monitor
+	Service *core.ResourceRef `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	// the time interval over which the error rate should be measured
+	// defaults to 1 minute
+	Interval             *time.Duration `protobuf:"bytes,2,opt,name=interval,proto3,stdduration" json:"interval,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *PrometheusTrigger_ErrorRateQuery) Reset()         { *m = PrometheusTrigger_ErrorRateQuery{} }
+func (m *PrometheusTrigger_ErrorRateQuery) String() string { return proto.CompactTextString(m) }
+func (*PrometheusTrigger_ErrorRateQuery) ProtoMessage()    {}
+func (*PrometheusTrigger_ErrorRateQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9da8418b9c75752, []int{4, 4}
+}
+func (m *PrometheusTrigger_ErrorRateQuery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PrometheusTrigger_ErrorRateQuery.Unmarshal(m, b)
+}
+func (m *PrometheusTrigger_ErrorRateQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PrometheusTrigger_ErrorRateQuery.Marshal(b, m, deterministic)
+}
+func (m *PrometheusTrigger_ErrorRateQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrometheusTrigger_ErrorRateQuery.Merge(m, src)
+}
+func (m *PrometheusTrigger_ErrorRateQuery) XXX_Size() int {
+	return xxx_messageInfo_PrometheusTrigger_ErrorRateQuery.Size(m)
+}
+func (m *PrometheusTrigger_ErrorRateQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrometheusTrigger_ErrorRateQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrometheusTrigger_ErrorRateQuery proto.InternalMessageInfo
+
+func (m *PrometheusTrigger_ErrorRateQuery) GetService() *core.ResourceRef {
+	if m != nil {
+		return m.Service
+	}
+	return nil
+}
+
+func (m *PrometheusTrigger_ErrorRateQuery) GetInterval() *time.Duration {
+	if m != nil {
+		return m.Interval
+	}
+	return nil
+}
+
+// returns the # of TCP connections to the service per second that were closed with an error for the given interval
+type PrometheusTrigger_TcpConnectionErrorsQuery struct {
+	// the service whose TCP connections Glooshot should monitor
+	Service *core.ResourceRef `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	// the time interval over which the connection errors should be measured
+	// defaults to 1 minute
+	Interval             *time.Duration `protobuf:"bytes,2,opt,name=interval,proto3,stdduration" json:"interval,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *PrometheusTrigger_TcpConnectionErrorsQuery) Reset() {
+	*m = PrometheusTrigger_TcpConnectionErrorsQuery{}
+}
+func (m *PrometheusTrigger_TcpConnectionErrorsQuery) String() string {
+	return proto.CompactTextString(m)
+}
+func (*PrometheusTrigger_TcpConnectionErrorsQuery) ProtoMessage() {}
+func (*PrometheusTrigger_TcpConnectionErrorsQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9da8418b9c75752, []int{4, 5}
+}
+func (m *PrometheusTrigger_TcpConnectionErrorsQuery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PrometheusTrigger_TcpConnectionErrorsQuery.Unmarshal(m, b)
+}
+func (m *PrometheusTrigger_TcpConnectionErrorsQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PrometheusTrigger_TcpConnectionErrorsQuery.Marshal(b, m, deterministic)
+}
+func (m *PrometheusTrigger_TcpConnectionErrorsQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrometheusTrigger_TcpConnectionErrorsQuery.Merge(m, src)
+}
+func (m *PrometheusTrigger_TcpConnectionErrorsQuery) XXX_Size() int {
+	return xxx_messageInfo_PrometheusTrigger_TcpConnectionErrorsQuery.Size(m)
+}
+func (m *PrometheusTrigger_TcpConnectionErrorsQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrometheusTrigger_TcpConnectionErrorsQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrometheusTrigger_TcpConnectionErrorsQuery proto.InternalMessageInfo
+
+func (m *PrometheusTrigger_TcpConnectionErrorsQuery) GetService() *core.ResourceRef {
+	if m != nil {
+		return m.Service
+	}
+	return nil
+}
+
+func (m *PrometheusTrigger_TcpConnectionErrorsQuery) GetInterval() *time.Duration {
+	if m != nil {
+		return m.Interval
+	}
+	return nil
+}
+
+// returns the # of container restarts reported by kube-state-metrics for the given interval
+// pods are matched by the name of the service, following the naming convention of pods created by a deployment
+type PrometheusTrigger_ContainerRestartsQuery struct {
+	// the service whose pods Glooshot should monitor
+	Service *core.ResourceRef `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	// the time interval over which the restarts should be counted
+	// defaults to 1 minute
+	Interval             *time.Duration `protobuf:"bytes,2,opt,name=interval,proto3,stdduration" json:"interval,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *PrometheusTrigger_ContainerRestartsQuery) Reset() {
+	*m = PrometheusTrigger_ContainerRestartsQuery{}
+}
+func (m *PrometheusTrigger_ContainerRestartsQuery) String() string { return proto.CompactTextString(m) }
+func (*PrometheusTrigger_ContainerRestartsQuery) ProtoMessage()    {}
+func (*PrometheusTrigger_ContainerRestartsQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9da8418b9c75752, []int{4, 6}
+}
+func (m *PrometheusTrigger_ContainerRestartsQuery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PrometheusTrigger_ContainerRestartsQuery.Unmarshal(m, b)
+}
+func (m *PrometheusTrigger_ContainerRestartsQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PrometheusTrigger_ContainerRestartsQuery.Marshal(b, m, deterministic)
+}
+func (m *PrometheusTrigger_ContainerRestartsQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrometheusTrigger_ContainerRestartsQuery.Merge(m, src)
+}
+func (m *PrometheusTrigger_ContainerRestartsQuery) XXX_Size() int {
+	return xxx_messageInfo_PrometheusTrigger_ContainerRestartsQuery.Size(m)
+}
+func (m *PrometheusTrigger_ContainerRestartsQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrometheusTrigger_ContainerRestartsQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrometheusTrigger_ContainerRestartsQuery proto.InternalMessageInfo
+
+func (m *PrometheusTrigger_ContainerRestartsQuery) GetService() *core.ResourceRef {
+	if m != nil {
+		return m.Service
+	}
+	return nil
+}
+
+func (m *PrometheusTrigger_ContainerRestartsQuery) GetInterval() *time.Duration {
+	if m != nil {
+		return m.Interval
+	}
+	return nil
+}
+
+// an SLO-based failure condition
+// the availability of the service is measured with the success rate query template for the target mesh
+// error budget burn rates are measured over a short and a long window, and the condition is met when both
+// burn rates, sustained for the duration of the experiment, would consume more than the allowed fraction of the error budget
+type ErrorBudgetTrigger struct {
+	// the service whose availability the SLO is defined for
+	Service *core.ResourceRef `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	// the availability target of the SLO, as a percentage, e.g. 99.9
+	// must be greater than 0 and less than 100
+	Target float64 `protobuf:"fixed64,2,opt,name=target,proto3" json:"target,omitempty"`
+	// the time window over which the SLO is defined
+	// defaults to 30 days
+	SloWindow *time.Duration `protobuf:"bytes,3,opt,name=slo_window,json=sloWindow,proto3,stdduration" json:"slo_window,omitempty"`
+	// the fraction of the error budget that the experiment is allowed to consume, e.g. 0.05 for 5%
+	// must be greater than 0
+	MaxBudgetFraction float64 `protobuf:"fixed64,4,opt,name=max_budget_fraction,json=maxBudgetFraction,proto3" json:"max_budget_fraction,omitempty"`
+	// the window over which the short burn rate is measured
+	// defaults to 5 minutes
+	ShortWindow *time.Duration `protobuf:"bytes,5,opt,name=short_window,json=shortWindow,proto3,stdduration" json:"short_window,omitempty"`
+	// the window over which the long burn rate is measured
+	// defaults to 1 hour
+	LongWindow           *time.Duration `protobuf:"bytes,6,opt,name=long_window,json=longWindow,proto3,stdduration" json:"long_window,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ErrorBudgetTrigger) Reset()         { *m = ErrorBudgetTrigger{} }
+func (m *ErrorBudgetTrigger) String() string { return proto.CompactTextString(m) }
+func (*ErrorBudgetTrigger) ProtoMessage()    {}
+func (*ErrorBudgetTrigger) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9da8418b9c75752, []int{5}
+}
+func (m *ErrorBudgetTrigger) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ErrorBudgetTrigger.Unmarshal(m, b)
+}
+func (m *ErrorBudgetTrigger) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ErrorBudgetTrigger.Marshal(b, m, deterministic)
+}
+func (m *ErrorBudgetTrigger) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ErrorBudgetTrigger.Merge(m, src)
+}
+func (m *ErrorBudgetTrigger) XXX_Size() int {
+	return xxx_messageInfo_ErrorBudgetTrigger.Size(m)
+}
+func (m *ErrorBudgetTrigger) XXX_DiscardUnknown() {
+	xxx_messageInfo_ErrorBudgetTrigger.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ErrorBudgetTrigger proto.InternalMessageInfo
+
+func (m *ErrorBudgetTrigger) GetService() *core.ResourceRef {
+	if m != nil {
+		return m.Service
+	}
+	return nil
+}
+
+func (m *ErrorBudgetTrigger) GetTarget() float64 {
+	if m != nil {
+		return m.Target
+	}
+	return 0
+}
+
+func (m *ErrorBudgetTrigger) GetSloWindow() *time.Duration {
+	if m != nil {
+		return m.SloWindow
+	}
+	return nil
+}
+
+func (m *ErrorBudgetTrigger) GetMaxBudgetFraction() float64 {
+	if m != nil {
+		return m.MaxBudgetFraction
+	}
+	return 0
+}
+
+func (m *ErrorBudgetTrigger) GetShortWindow() *time.Duration {
+	if m != nil {
+		return m.ShortWindow
+	}
+	return nil
+}
+
+func (m *ErrorBudgetTrigger) GetLongWindow() *time.Duration {
+	if m != nil {
+		return m.LongWindow
+	}
+	return nil
+}
+
+// combines failure conditions with boolean logic
+// the combination is evaluated every time one of its conditions receives a new sample
+// conditions which have not been sampled yet are considered unknown, and the combination is only met
+// once it evaluates to true regardless of the value of any unknown conditions
+type CompoundTrigger struct {
+	// the operator used to combine the conditions
+	Operator CompoundTrigger_Operator `protobuf:"varint,1,opt,name=operator,proto3,enum=glooshot.solo.io.CompoundTrigger_Operator" json:"operator,omitempty"`
+	// the conditions to combine, NOT requires exactly one condition
+	// names must be unique across all the failure conditions of an experiment
+	// if not provided, names will be generated from the name of the parent and the index
+	Conditions           []*FailureCondition `protobuf:"bytes,2,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *CompoundTrigger) Reset()         { *m = CompoundTrigger{} }
+func (m *CompoundTrigger) String() string { return proto.CompactTextString(m) }
+func (*CompoundTrigger) ProtoMessage()    {}
+func (*CompoundTrigger) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9da8418b9c75752, []int{6}
+}
+func (m *CompoundTrigger) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CompoundTrigger.Unmarshal(m, b)
+}
+func (m *CompoundTrigger) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CompoundTrigger.Marshal(b, m, deterministic)
+}
+func (m *CompoundTrigger) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompoundTrigger.Merge(m, src)
+}
+func (m *CompoundTrigger) XXX_Size() int {
+	return xxx_messageInfo_CompoundTrigger.Size(m)
+}
+func (m *CompoundTrigger) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompoundTrigger.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompoundTrigger proto.InternalMessageInfo
+
+func (m *CompoundTrigger) GetOperator() CompoundTrigger_Operator {
+	if m != nil {
+		return m.Operator
+	}
+	return CompoundTrigger_AND
+}
+
+func (m *CompoundTrigger) GetConditions() []*FailureCondition {
+	if m != nil {
+		return m.Conditions
+	}
+	return nil
+}
+
+// a snapshot of experiment metric values
+type Report struct {
+	// the object metadata for this resource
+	Metadata core.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata"`
+	Status   core.Status   `protobuf:"bytes,3,opt,name=status,proto3" json:"status"`
+	// Name of the experiment this report pertains to
+	Experiment *core.ResourceRef `protobuf:"bytes,4,opt,name=experiment,proto3" json:"experiment,omitempty"`
+	// the measured values of each of the failure conditions at the time the report was captured
+	FailureConditionHistory []*Report_FailureConditionHistory `protobuf:"bytes,5,rep,name=failure_condition_history,json=failureConditionHistory,proto3" json:"failure_condition_history,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{}                          `json:"-"`
+	XXX_unrecognized        []byte                            `json:"-"`
+	XXX_sizecache           int32                             `json:"-"`
+}
+
+func (m *Report) Reset()         { *m = Report{} }
+func (m *Report) String() string { return proto.CompactTextString(m) }
+func (*Report) ProtoMessage()    {}
+func (*Report) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9da8418b9c75752, []int{7}
+}
+func (m *Report) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Report.Unmarshal(m, b)
+}
+func (m *Report) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Report.Marshal(b, m, deterministic)
+}
+func (m *Report) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Report.Merge(m, src)
+}
+func (m *Report) XXX_Size() int {
+	return xxx_messageInfo_Report.Size(m)
+}
+func (m *Report) XXX_DiscardUnknown() {
+	xxx_messageInfo_Report.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Report proto.InternalMessageInfo
+
+func (m *Report) GetMetadata() core.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return core.Metadata{}
 }
 
 func (m *Report) GetStatus() core.Status {
@@ -811,7 +1748,7 @@ func (m *Report_FailureConditionSnapshot) Reset()         { *m = Report_FailureC
 func (m *Report_FailureConditionSnapshot) String() string { return proto.CompactTextString(m) }
 func (*Report_FailureConditionSnapshot) ProtoMessage()    {}
 func (*Report_FailureConditionSnapshot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b9da8418b9c75752, []int{5, 0}
+	return fileDescriptor_b9da8418b9c75752, []int{7, 0}
 }
 func (m *Report_FailureConditionSnapshot) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Report_FailureConditionSnapshot.Unmarshal(m, b)
@@ -851,16 +1788,18 @@ type Report_FailureConditionHistory struct {
 	FailureConditionName string `protobuf:"bytes,1,opt,name=failure_condition_name,json=failureConditionName,proto3" json:"failure_condition_name,omitempty"`
 	// history of all measurements of the failure condition
 	FailureConditionSnapshots []*Report_FailureConditionSnapshot `protobuf:"bytes,2,rep,name=failure_condition_snapshots,json=failureConditionSnapshots,proto3" json:"failure_condition_snapshots,omitempty"`
-	XXX_NoUnkeyedLiteral      struct{}                           `json:"-"`
-	XXX_unrecognized          []byte                             `json:"-"`
-	XXX_sizecache             int32                              `json:"-"`
+	// for error budget failure conditions, the fraction of the error budget consumed during the experiment
+	ErrorBudgetConsumed  float64  `protobuf:"fixed64,3,opt,name=error_budget_consumed,json=errorBudgetConsumed,proto3" json:"error_budget_consumed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Report_FailureConditionHistory) Reset()         { *m = Report_FailureConditionHistory{} }
 func (m *Report_FailureConditionHistory) String() string { return proto.CompactTextString(m) }
 func (*Report_FailureConditionHistory) ProtoMessage()    {}
 func (*Report_FailureConditionHistory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b9da8418b9c75752, []int{5, 1}
+	return fileDescriptor_b9da8418b9c75752, []int{7, 1}
 }
 func (m *Report_FailureConditionHistory) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Report_FailureConditionHistory.Unmarshal(m, b)
@@ -894,109 +1833,2127 @@ func (m *Report_FailureConditionHistory) GetFailureConditionSnapshots() []*Repor
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("glooshot.solo.io.ExperimentResult_State", ExperimentResult_State_name, ExperimentResult_State_value)
-	proto.RegisterType((*Experiment)(nil), "glooshot.solo.io.Experiment")
-	proto.RegisterType((*ExperimentResult)(nil), "glooshot.solo.io.ExperimentResult")
-	proto.RegisterMapType((map[string]string)(nil), "glooshot.solo.io.ExperimentResult.FailureReportEntry")
-	proto.RegisterType((*ExperimentSpec)(nil), "glooshot.solo.io.ExperimentSpec")
-	proto.RegisterType((*ExperimentSpec_InjectedFault)(nil), "glooshot.solo.io.ExperimentSpec.InjectedFault")
-	proto.RegisterType((*FailureCondition)(nil), "glooshot.solo.io.FailureCondition")
-	proto.RegisterType((*FailureCondition_Trigger)(nil), "glooshot.solo.io.FailureCondition.Trigger")
-	proto.RegisterType((*PrometheusTrigger)(nil), "glooshot.solo.io.PrometheusTrigger")
-	proto.RegisterType((*PrometheusTrigger_SuccessRateQuery)(nil), "glooshot.solo.io.PrometheusTrigger.SuccessRateQuery")
-	proto.RegisterType((*Report)(nil), "glooshot.solo.io.Report")
-	proto.RegisterType((*Report_FailureConditionSnapshot)(nil), "glooshot.solo.io.Report.FailureConditionSnapshot")
-	proto.RegisterType((*Report_FailureConditionHistory)(nil), "glooshot.solo.io.Report.FailureConditionHistory")
-}
-
-func init() {
-	proto.RegisterFile("github.com/solo-io/glooshot/api/v1/glooshot.proto", fileDescriptor_b9da8418b9c75752)
+func (m *Report_FailureConditionHistory) GetErrorBudgetConsumed() float64 {
+	if m != nil {
+		return m.ErrorBudgetConsumed
+	}
+	return 0
+}
+
+// a destination glooshot delivers the result of every experiment in the same namespace to, once the experiment concludes
+type ReportSink struct {
+	// the object metadata for this resource
+	Metadata core.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata"`
+	Status   core.Status   `protobuf:"bytes,2,opt,name=status,proto3" json:"status"`
+	// where to deliver experiment results
+	//
+	// Types that are valid to be assigned to SinkType:
+	//	*ReportSink_Webhook
+	//	*ReportSink_Slack
+	//	*ReportSink_File
+	SinkType isReportSink_SinkType `protobuf_oneof:"sink_type"`
+	// only deliver the results of failed experiments
+	FailuresOnly         bool     `protobuf:"varint,6,opt,name=failures_only,json=failuresOnly,proto3" json:"failures_only,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReportSink) Reset()         { *m = ReportSink{} }
+func (m *ReportSink) String() string { return proto.CompactTextString(m) }
+func (*ReportSink) ProtoMessage()    {}
+func (*ReportSink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9da8418b9c75752, []int{8}
+}
+func (m *ReportSink) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReportSink.Unmarshal(m, b)
+}
+func (m *ReportSink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReportSink.Marshal(b, m, deterministic)
+}
+func (m *ReportSink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReportSink.Merge(m, src)
+}
+func (m *ReportSink) XXX_Size() int {
+	return xxx_messageInfo_ReportSink.Size(m)
+}
+func (m *ReportSink) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReportSink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReportSink proto.InternalMessageInfo
+
+type isReportSink_SinkType interface {
+	isReportSink_SinkType()
+	Equal(interface{}) bool
+}
+
+type ReportSink_Webhook struct {
+	Webhook *ReportSink_WebhookSink `protobuf:"bytes,3,opt,name=webhook,proto3,oneof"`
+}
+type ReportSink_Slack struct {
+	Slack *ReportSink_SlackSink `protobuf:"bytes,4,opt,name=slack,proto3,oneof"`
+}
+type ReportSink_File struct {
+	File *ReportSink_FileSink `protobuf:"bytes,5,opt,name=file,proto3,oneof"`
+}
+
+func (*ReportSink_Webhook) isReportSink_SinkType() {}
+func (*ReportSink_Slack) isReportSink_SinkType()   {}
+func (*ReportSink_File) isReportSink_SinkType()    {}
+
+func (m *ReportSink) GetSinkType() isReportSink_SinkType {
+	if m != nil {
+		return m.SinkType
+	}
+	return nil
+}
+
+func (m *ReportSink) GetMetadata() core.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return core.Metadata{}
+}
+
+func (m *ReportSink) GetStatus() core.Status {
+	if m != nil {
+		return m.Status
+	}
+	return core.Status{}
+}
+
+func (m *ReportSink) GetWebhook() *ReportSink_WebhookSink {
+	if x, ok := m.GetSinkType().(*ReportSink_Webhook); ok {
+		return x.Webhook
+	}
+	return nil
+}
+
+func (m *ReportSink) GetSlack() *ReportSink_SlackSink {
+	if x, ok := m.GetSinkType().(*ReportSink_Slack); ok {
+		return x.Slack
+	}
+	return nil
+}
+
+func (m *ReportSink) GetFile() *ReportSink_FileSink {
+	if x, ok := m.GetSinkType().(*ReportSink_File); ok {
+		return x.File
+	}
+	return nil
+}
+
+func (m *ReportSink) GetFailuresOnly() bool {
+	if m != nil {
+		return m.FailuresOnly
+	}
+	return false
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*ReportSink) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _ReportSink_OneofMarshaler, _ReportSink_OneofUnmarshaler, _ReportSink_OneofSizer, []interface{}{
+		(*ReportSink_Webhook)(nil),
+		(*ReportSink_Slack)(nil),
+		(*ReportSink_File)(nil),
+	}
+}
+
+func _ReportSink_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*ReportSink)
+	// sink_type
+	switch x := m.SinkType.(type) {
+	case *ReportSink_Webhook:
+		_ = b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Webhook); err != nil {
+			return err
+		}
+	case *ReportSink_Slack:
+		_ = b.EncodeVarint(4<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Slack); err != nil {
+			return err
+		}
+	case *ReportSink_File:
+		_ = b.EncodeVarint(5<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.File); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("ReportSink.SinkType has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _ReportSink_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*ReportSink)
+	switch tag {
+	case 3: // sink_type.webhook
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ReportSink_WebhookSink)
+		err := b.DecodeMessage(msg)
+		m.SinkType = &ReportSink_Webhook{msg}
+		return true, err
+	case 4: // sink_type.slack
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ReportSink_SlackSink)
+		err := b.DecodeMessage(msg)
+		m.SinkType = &ReportSink_Slack{msg}
+		return true, err
+	case 5: // sink_type.file
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ReportSink_FileSink)
+		err := b.DecodeMessage(msg)
+		m.SinkType = &ReportSink_File{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _ReportSink_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*ReportSink)
+	// sink_type
+	switch x := m.SinkType.(type) {
+	case *ReportSink_Webhook:
+		s := proto.Size(x.Webhook)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ReportSink_Slack:
+		s := proto.Size(x.Slack)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ReportSink_File:
+		s := proto.Size(x.File)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type ReportSink_WebhookSink struct {
+	// the url to POST results to
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// additional headers to set on each request, e.g. for authorization
+	Headers              map[string]string `protobuf:"bytes,2,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ReportSink_WebhookSink) Reset()         { *m = ReportSink_WebhookSink{} }
+func (m *ReportSink_WebhookSink) String() string { return proto.CompactTextString(m) }
+func (*ReportSink_WebhookSink) ProtoMessage()    {}
+func (*ReportSink_WebhookSink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9da8418b9c75752, []int{8, 0}
+}
+func (m *ReportSink_WebhookSink) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReportSink_WebhookSink.Unmarshal(m, b)
+}
+func (m *ReportSink_WebhookSink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReportSink_WebhookSink.Marshal(b, m, deterministic)
+}
+func (m *ReportSink_WebhookSink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReportSink_WebhookSink.Merge(m, src)
+}
+func (m *ReportSink_WebhookSink) XXX_Size() int {
+	return xxx_messageInfo_ReportSink_WebhookSink.Size(m)
+}
+func (m *ReportSink_WebhookSink) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReportSink_WebhookSink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReportSink_WebhookSink proto.InternalMessageInfo
+
+func (m *ReportSink_WebhookSink) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *ReportSink_WebhookSink) GetHeaders() map[string]string {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+type ReportSink_SlackSink struct {
+	// the url of the incoming webhook
+	WebhookUrl string `protobuf:"bytes,1,opt,name=webhook_url,json=webhookUrl,proto3" json:"webhook_url,omitempty"`
+	// optional, overrides the default channel of the webhook
+	Channel              string   `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReportSink_SlackSink) Reset()         { *m = ReportSink_SlackSink{} }
+func (m *ReportSink_SlackSink) String() string { return proto.CompactTextString(m) }
+func (*ReportSink_SlackSink) ProtoMessage()    {}
+func (*ReportSink_SlackSink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9da8418b9c75752, []int{8, 1}
+}
+func (m *ReportSink_SlackSink) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReportSink_SlackSink.Unmarshal(m, b)
+}
+func (m *ReportSink_SlackSink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReportSink_SlackSink.Marshal(b, m, deterministic)
+}
+func (m *ReportSink_SlackSink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReportSink_SlackSink.Merge(m, src)
+}
+func (m *ReportSink_SlackSink) XXX_Size() int {
+	return xxx_messageInfo_ReportSink_SlackSink.Size(m)
+}
+func (m *ReportSink_SlackSink) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReportSink_SlackSink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReportSink_SlackSink proto.InternalMessageInfo
+
+func (m *ReportSink_SlackSink) GetWebhookUrl() string {
+	if m != nil {
+		return m.WebhookUrl
+	}
+	return ""
+}
+
+func (m *ReportSink_SlackSink) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+type ReportSink_FileSink struct {
+	// the directory results are written to, one file per experiment, named <namespace>.<name>.json
+	Directory            string   `protobuf:"bytes,1,opt,name=directory,proto3" json:"directory,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReportSink_FileSink) Reset()         { *m = ReportSink_FileSink{} }
+func (m *ReportSink_FileSink) String() string { return proto.CompactTextString(m) }
+func (*ReportSink_FileSink) ProtoMessage()    {}
+func (*ReportSink_FileSink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9da8418b9c75752, []int{8, 2}
+}
+func (m *ReportSink_FileSink) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReportSink_FileSink.Unmarshal(m, b)
+}
+func (m *ReportSink_FileSink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReportSink_FileSink.Marshal(b, m, deterministic)
+}
+func (m *ReportSink_FileSink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReportSink_FileSink.Merge(m, src)
+}
+func (m *ReportSink_FileSink) XXX_Size() int {
+	return xxx_messageInfo_ReportSink_FileSink.Size(m)
+}
+func (m *ReportSink_FileSink) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReportSink_FileSink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReportSink_FileSink proto.InternalMessageInfo
+
+func (m *ReportSink_FileSink) GetDirectory() string {
+	if m != nil {
+		return m.Directory
+	}
+	return ""
+}
+
+// a parameterized experiment, rendered into an experiment by glooshot create experiment --from-template
+type ExperimentTemplate struct {
+	// the object metadata for this resource
+	Metadata core.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata"`
+	Status   core.Status   `protobuf:"bytes,2,opt,name=status,proto3" json:"status"`
+	// the parameters which are set when rendering the template
+	Parameters []*ExperimentTemplate_Parameter `protobuf:"bytes,3,rep,name=parameters,proto3" json:"parameters,omitempty"`
+	// the spec of the rendered experiments, as yaml
+	// it is rendered as a go template, which references the value of a parameter as {{ .name }},
+	// and the namespace and name of a SERVICE_REF parameter as {{ .name.namespace }} and {{ .name.name }}
+	Spec                 string   `protobuf:"bytes,4,opt,name=spec,proto3" json:"spec,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExperimentTemplate) Reset()         { *m = ExperimentTemplate{} }
+func (m *ExperimentTemplate) String() string { return proto.CompactTextString(m) }
+func (*ExperimentTemplate) ProtoMessage()    {}
+func (*ExperimentTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9da8418b9c75752, []int{9}
+}
+func (m *ExperimentTemplate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExperimentTemplate.Unmarshal(m, b)
+}
+func (m *ExperimentTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExperimentTemplate.Marshal(b, m, deterministic)
+}
+func (m *ExperimentTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExperimentTemplate.Merge(m, src)
+}
+func (m *ExperimentTemplate) XXX_Size() int {
+	return xxx_messageInfo_ExperimentTemplate.Size(m)
+}
+func (m *ExperimentTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExperimentTemplate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExperimentTemplate proto.InternalMessageInfo
+
+func (m *ExperimentTemplate) GetMetadata() core.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return core.Metadata{}
+}
+
+func (m *ExperimentTemplate) GetStatus() core.Status {
+	if m != nil {
+		return m.Status
+	}
+	return core.Status{}
+}
+
+func (m *ExperimentTemplate) GetParameters() []*ExperimentTemplate_Parameter {
+	if m != nil {
+		return m.Parameters
+	}
+	return nil
+}
+
+func (m *ExperimentTemplate) GetSpec() string {
+	if m != nil {
+		return m.Spec
+	}
+	return ""
+}
+
+type ExperimentTemplate_Parameter struct {
+	// the name of the parameter, a go identifier such as destination or errorThreshold
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// describes the parameter to the users of the template
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// the type values of the parameter must have
+	Type ExperimentTemplate_Parameter_Type `protobuf:"varint,3,opt,name=type,proto3,enum=glooshot.solo.io.ExperimentTemplate_Parameter_Type" json:"type,omitempty"`
+	// templates cannot be rendered without values for their required parameters
+	Required bool `protobuf:"varint,4,opt,name=required,proto3" json:"required,omitempty"`
+	// optional, the value of the parameter if none is given
+	Default              string   `protobuf:"bytes,5,opt,name=default,proto3" json:"default,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExperimentTemplate_Parameter) Reset()         { *m = ExperimentTemplate_Parameter{} }
+func (m *ExperimentTemplate_Parameter) String() string { return proto.CompactTextString(m) }
+func (*ExperimentTemplate_Parameter) ProtoMessage()    {}
+func (*ExperimentTemplate_Parameter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9da8418b9c75752, []int{9, 0}
+}
+func (m *ExperimentTemplate_Parameter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExperimentTemplate_Parameter.Unmarshal(m, b)
+}
+func (m *ExperimentTemplate_Parameter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExperimentTemplate_Parameter.Marshal(b, m, deterministic)
+}
+func (m *ExperimentTemplate_Parameter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExperimentTemplate_Parameter.Merge(m, src)
+}
+func (m *ExperimentTemplate_Parameter) XXX_Size() int {
+	return xxx_messageInfo_ExperimentTemplate_Parameter.Size(m)
+}
+func (m *ExperimentTemplate_Parameter) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExperimentTemplate_Parameter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExperimentTemplate_Parameter proto.InternalMessageInfo
+
+func (m *ExperimentTemplate_Parameter) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ExperimentTemplate_Parameter) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *ExperimentTemplate_Parameter) GetType() ExperimentTemplate_Parameter_Type {
+	if m != nil {
+		return m.Type
+	}
+	return ExperimentTemplate_Parameter_STRING
+}
+
+func (m *ExperimentTemplate_Parameter) GetRequired() bool {
+	if m != nil {
+		return m.Required
+	}
+	return false
+}
+
+func (m *ExperimentTemplate_Parameter) GetDefault() string {
+	if m != nil {
+		return m.Default
+	}
+	return ""
+}
+
+// An ExperimentSuite runs several experiments, one after another or all at once, and concludes with an aggregate result.
+// The experiments of a suite are named after the suite and the name of their entry, and labelled with the name of the suite.
+type ExperimentSuite struct {
+	// the object metadata for this resource
+	Metadata core.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata"`
+	Status   core.Status   `protobuf:"bytes,2,opt,name=status,proto3" json:"status"`
+	// the experiments of the suite, in the order they are run
+	Experiments []*ExperimentSuite_Entry `protobuf:"bytes,3,rep,name=experiments,proto3" json:"experiments,omitempty"`
+	// how the experiments are run
+	Mode ExperimentSuite_Mode `protobuf:"varint,4,opt,name=mode,proto3,enum=glooshot.solo.io.ExperimentSuite_Mode" json:"mode,omitempty"`
+	// the time to wait after an experiment concluded before the next one is started
+	// not used if the mode is PARALLEL
+	CoolDown *time.Duration `protobuf:"bytes,5,opt,name=cool_down,json=coolDown,proto3,stdduration" json:"cool_down,omitempty"`
+	// the result of the latest run of the suite, set by glooshot
+	// a suite whose result is cleared is run again
+	Result               SuiteResult `protobuf:"bytes,6,opt,name=result,proto3" json:"result"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ExperimentSuite) Reset()         { *m = ExperimentSuite{} }
+func (m *ExperimentSuite) String() string { return proto.CompactTextString(m) }
+func (*ExperimentSuite) ProtoMessage()    {}
+func (*ExperimentSuite) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9da8418b9c75752, []int{10}
+}
+func (m *ExperimentSuite) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExperimentSuite.Unmarshal(m, b)
+}
+func (m *ExperimentSuite) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExperimentSuite.Marshal(b, m, deterministic)
+}
+func (m *ExperimentSuite) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExperimentSuite.Merge(m, src)
+}
+func (m *ExperimentSuite) XXX_Size() int {
+	return xxx_messageInfo_ExperimentSuite.Size(m)
+}
+func (m *ExperimentSuite) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExperimentSuite.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExperimentSuite proto.InternalMessageInfo
+
+func (m *ExperimentSuite) GetMetadata() core.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return core.Metadata{}
+}
+
+func (m *ExperimentSuite) GetStatus() core.Status {
+	if m != nil {
+		return m.Status
+	}
+	return core.Status{}
+}
+
+func (m *ExperimentSuite) GetExperiments() []*ExperimentSuite_Entry {
+	if m != nil {
+		return m.Experiments
+	}
+	return nil
+}
+
+func (m *ExperimentSuite) GetMode() ExperimentSuite_Mode {
+	if m != nil {
+		return m.Mode
+	}
+	return ExperimentSuite_SEQUENTIAL
+}
+
+func (m *ExperimentSuite) GetCoolDown() *time.Duration {
+	if m != nil {
+		return m.CoolDown
+	}
+	return nil
+}
+
+func (m *ExperimentSuite) GetResult() SuiteResult {
+	if m != nil {
+		return m.Result
+	}
+	return SuiteResult{}
+}
+
+type ExperimentSuite_Entry struct {
+	// identifies the experiment within the suite, a dns label which is unique within the suite
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the experiment is created either from a spec, or by rendering a template
+	//
+	// Types that are valid to be assigned to Source:
+	//	*ExperimentSuite_Entry_Spec
+	//	*ExperimentSuite_Entry_Template
+	Source               isExperimentSuite_Entry_Source `protobuf_oneof:"source"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
+}
+
+func (m *ExperimentSuite_Entry) Reset()         { *m = ExperimentSuite_Entry{} }
+func (m *ExperimentSuite_Entry) String() string { return proto.CompactTextString(m) }
+func (*ExperimentSuite_Entry) ProtoMessage()    {}
+func (*ExperimentSuite_Entry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9da8418b9c75752, []int{10, 0}
+}
+func (m *ExperimentSuite_Entry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExperimentSuite_Entry.Unmarshal(m, b)
+}
+func (m *ExperimentSuite_Entry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExperimentSuite_Entry.Marshal(b, m, deterministic)
+}
+func (m *ExperimentSuite_Entry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExperimentSuite_Entry.Merge(m, src)
+}
+func (m *ExperimentSuite_Entry) XXX_Size() int {
+	return xxx_messageInfo_ExperimentSuite_Entry.Size(m)
+}
+func (m *ExperimentSuite_Entry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExperimentSuite_Entry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExperimentSuite_Entry proto.InternalMessageInfo
+
+type isExperimentSuite_Entry_Source interface {
+	isExperimentSuite_Entry_Source()
+	Equal(interface{}) bool
+}
+
+type ExperimentSuite_Entry_Spec struct {
+	Spec *ExperimentSpec `protobuf:"bytes,2,opt,name=spec,proto3,oneof"`
+}
+type ExperimentSuite_Entry_Template struct {
+	Template *ExperimentSuite_TemplateRef `protobuf:"bytes,3,opt,name=template,proto3,oneof"`
+}
+
+func (*ExperimentSuite_Entry_Spec) isExperimentSuite_Entry_Source()     {}
+func (*ExperimentSuite_Entry_Template) isExperimentSuite_Entry_Source() {}
+
+func (m *ExperimentSuite_Entry) GetSource() isExperimentSuite_Entry_Source {
+	if m != nil {
+		return m.Source
+	}
+	return nil
+}
+
+func (m *ExperimentSuite_Entry) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ExperimentSuite_Entry) GetSpec() *ExperimentSpec {
+	if x, ok := m.GetSource().(*ExperimentSuite_Entry_Spec); ok {
+		return x.Spec
+	}
+	return nil
+}
+
+func (m *ExperimentSuite_Entry) GetTemplate() *ExperimentSuite_TemplateRef {
+	if x, ok := m.GetSource().(*ExperimentSuite_Entry_Template); ok {
+		return x.Template
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*ExperimentSuite_Entry) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _ExperimentSuite_Entry_OneofMarshaler, _ExperimentSuite_Entry_OneofUnmarshaler, _ExperimentSuite_Entry_OneofSizer, []interface{}{
+		(*ExperimentSuite_Entry_Spec)(nil),
+		(*ExperimentSuite_Entry_Template)(nil),
+	}
+}
+
+func _ExperimentSuite_Entry_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*ExperimentSuite_Entry)
+	// source
+	switch x := m.Source.(type) {
+	case *ExperimentSuite_Entry_Spec:
+		_ = b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Spec); err != nil {
+			return err
+		}
+	case *ExperimentSuite_Entry_Template:
+		_ = b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Template); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("ExperimentSuite_Entry.Source has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _ExperimentSuite_Entry_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*ExperimentSuite_Entry)
+	switch tag {
+	case 2: // source.spec
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ExperimentSpec)
+		err := b.DecodeMessage(msg)
+		m.Source = &ExperimentSuite_Entry_Spec{msg}
+		return true, err
+	case 3: // source.template
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ExperimentSuite_TemplateRef)
+		err := b.DecodeMessage(msg)
+		m.Source = &ExperimentSuite_Entry_Template{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _ExperimentSuite_Entry_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*ExperimentSuite_Entry)
+	// source
+	switch x := m.Source.(type) {
+	case *ExperimentSuite_Entry_Spec:
+		s := proto.Size(x.Spec)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExperimentSuite_Entry_Template:
+		s := proto.Size(x.Template)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type ExperimentSuite_TemplateRef struct {
+	// the experiment template, in the namespace of the suite if its namespace is empty
+	Template core.ResourceRef `protobuf:"bytes,1,opt,name=template,proto3" json:"template"`
+	// the values of the parameters of the template
+	Values               map[string]string `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ExperimentSuite_TemplateRef) Reset()         { *m = ExperimentSuite_TemplateRef{} }
+func (m *ExperimentSuite_TemplateRef) String() string { return proto.CompactTextString(m) }
+func (*ExperimentSuite_TemplateRef) ProtoMessage()    {}
+func (*ExperimentSuite_TemplateRef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9da8418b9c75752, []int{10, 1}
+}
+func (m *ExperimentSuite_TemplateRef) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExperimentSuite_TemplateRef.Unmarshal(m, b)
+}
+func (m *ExperimentSuite_TemplateRef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExperimentSuite_TemplateRef.Marshal(b, m, deterministic)
+}
+func (m *ExperimentSuite_TemplateRef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExperimentSuite_TemplateRef.Merge(m, src)
+}
+func (m *ExperimentSuite_TemplateRef) XXX_Size() int {
+	return xxx_messageInfo_ExperimentSuite_TemplateRef.Size(m)
+}
+func (m *ExperimentSuite_TemplateRef) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExperimentSuite_TemplateRef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExperimentSuite_TemplateRef proto.InternalMessageInfo
+
+func (m *ExperimentSuite_TemplateRef) GetTemplate() core.ResourceRef {
+	if m != nil {
+		return m.Template
+	}
+	return core.ResourceRef{}
+}
+
+func (m *ExperimentSuite_TemplateRef) GetValues() map[string]string {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+type SuiteResult struct {
+	State SuiteResult_State `protobuf:"varint,1,opt,name=state,proto3,enum=glooshot.solo.io.SuiteResult_State" json:"state,omitempty"`
+	// identifies the run of the suite, appended to the names of its experiments
+	RunId string `protobuf:"bytes,2,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	// the time the suite started
+	TimeStarted *types.Timestamp `protobuf:"bytes,3,opt,name=time_started,json=timeStarted,proto3" json:"time_started,omitempty"`
+	// the time the suite concluded
+	TimeFinished *types.Timestamp `protobuf:"bytes,4,opt,name=time_finished,json=timeFinished,proto3" json:"time_finished,omitempty"`
+	// explains why the suite was rejected
+	Message string `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	// the results of the experiments of the suite, in the order of its entries
+	Experiments          []*SuiteResult_EntryResult `protobuf:"bytes,6,rep,name=experiments,proto3" json:"experiments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *SuiteResult) Reset()         { *m = SuiteResult{} }
+func (m *SuiteResult) String() string { return proto.CompactTextString(m) }
+func (*SuiteResult) ProtoMessage()    {}
+func (*SuiteResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9da8418b9c75752, []int{11}
+}
+func (m *SuiteResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SuiteResult.Unmarshal(m, b)
+}
+func (m *SuiteResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SuiteResult.Marshal(b, m, deterministic)
+}
+func (m *SuiteResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuiteResult.Merge(m, src)
+}
+func (m *SuiteResult) XXX_Size() int {
+	return xxx_messageInfo_SuiteResult.Size(m)
+}
+func (m *SuiteResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuiteResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SuiteResult proto.InternalMessageInfo
+
+func (m *SuiteResult) GetState() SuiteResult_State {
+	if m != nil {
+		return m.State
+	}
+	return SuiteResult_Pending
+}
+
+func (m *SuiteResult) GetRunId() string {
+	if m != nil {
+		return m.RunId
+	}
+	return ""
+}
+
+func (m *SuiteResult) GetTimeStarted() *types.Timestamp {
+	if m != nil {
+		return m.TimeStarted
+	}
+	return nil
+}
+
+func (m *SuiteResult) GetTimeFinished() *types.Timestamp {
+	if m != nil {
+		return m.TimeFinished
+	}
+	return nil
+}
+
+func (m *SuiteResult) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *SuiteResult) GetExperiments() []*SuiteResult_EntryResult {
+	if m != nil {
+		return m.Experiments
+	}
+	return nil
+}
+
+type SuiteResult_EntryResult struct {
+	// the name of the entry
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the experiment created for the entry, unset while waiting
+	Experiment *core.ResourceRef             `protobuf:"bytes,2,opt,name=experiment,proto3" json:"experiment,omitempty"`
+	State      SuiteResult_EntryResult_State `protobuf:"varint,3,opt,name=state,proto3,enum=glooshot.solo.io.SuiteResult_EntryResult_State" json:"state,omitempty"`
+	// the time the experiment was created
+	TimeStarted *types.Timestamp `protobuf:"bytes,6,opt,name=time_started,json=timeStarted,proto3" json:"time_started,omitempty"`
+	// the time the experiment concluded
+	TimeFinished *types.Timestamp `protobuf:"bytes,4,opt,name=time_finished,json=timeFinished,proto3" json:"time_finished,omitempty"`
+	// explains why the experiment failed to be created
+	Message              string   `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SuiteResult_EntryResult) Reset()         { *m = SuiteResult_EntryResult{} }
+func (m *SuiteResult_EntryResult) String() string { return proto.CompactTextString(m) }
+func (*SuiteResult_EntryResult) ProtoMessage()    {}
+func (*SuiteResult_EntryResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9da8418b9c75752, []int{11, 0}
+}
+func (m *SuiteResult_EntryResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SuiteResult_EntryResult.Unmarshal(m, b)
+}
+func (m *SuiteResult_EntryResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SuiteResult_EntryResult.Marshal(b, m, deterministic)
+}
+func (m *SuiteResult_EntryResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuiteResult_EntryResult.Merge(m, src)
+}
+func (m *SuiteResult_EntryResult) XXX_Size() int {
+	return xxx_messageInfo_SuiteResult_EntryResult.Size(m)
+}
+func (m *SuiteResult_EntryResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuiteResult_EntryResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SuiteResult_EntryResult proto.InternalMessageInfo
+
+func (m *SuiteResult_EntryResult) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SuiteResult_EntryResult) GetExperiment() *core.ResourceRef {
+	if m != nil {
+		return m.Experiment
+	}
+	return nil
+}
+
+func (m *SuiteResult_EntryResult) GetState() SuiteResult_EntryResult_State {
+	if m != nil {
+		return m.State
+	}
+	return SuiteResult_EntryResult_Waiting
+}
+
+func (m *SuiteResult_EntryResult) GetTimeStarted() *types.Timestamp {
+	if m != nil {
+		return m.TimeStarted
+	}
+	return nil
+}
+
+func (m *SuiteResult_EntryResult) GetTimeFinished() *types.Timestamp {
+	if m != nil {
+		return m.TimeFinished
+	}
+	return nil
+}
+
+func (m *SuiteResult_EntryResult) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("glooshot.solo.io.ExperimentResult_State", ExperimentResult_State_name, ExperimentResult_State_value)
+	proto.RegisterEnum("glooshot.solo.io.PrometheusTrigger_ComparisonOperator", PrometheusTrigger_ComparisonOperator_name, PrometheusTrigger_ComparisonOperator_value)
+	proto.RegisterEnum("glooshot.solo.io.CompoundTrigger_Operator", CompoundTrigger_Operator_name, CompoundTrigger_Operator_value)
+	proto.RegisterEnum("glooshot.solo.io.ExperimentTemplate_Parameter_Type", ExperimentTemplate_Parameter_Type_name, ExperimentTemplate_Parameter_Type_value)
+	proto.RegisterEnum("glooshot.solo.io.ExperimentSuite_Mode", ExperimentSuite_Mode_name, ExperimentSuite_Mode_value)
+	proto.RegisterEnum("glooshot.solo.io.SuiteResult_State", SuiteResult_State_name, SuiteResult_State_value)
+	proto.RegisterEnum("glooshot.solo.io.SuiteResult_EntryResult_State", SuiteResult_EntryResult_State_name, SuiteResult_EntryResult_State_value)
+	proto.RegisterType((*Experiment)(nil), "glooshot.solo.io.Experiment")
+	proto.RegisterType((*ExperimentResult)(nil), "glooshot.solo.io.ExperimentResult")
+	proto.RegisterMapType((map[string]string)(nil), "glooshot.solo.io.ExperimentResult.FailureReportEntry")
+	proto.RegisterType((*ExperimentSpec)(nil), "glooshot.solo.io.ExperimentSpec")
+	proto.RegisterType((*ExperimentSpec_InjectedFault)(nil), "glooshot.solo.io.ExperimentSpec.InjectedFault")
+	proto.RegisterType((*FailureCondition)(nil), "glooshot.solo.io.FailureCondition")
+	proto.RegisterType((*FailureCondition_Trigger)(nil), "glooshot.solo.io.FailureCondition.Trigger")
+	proto.RegisterType((*PrometheusTrigger)(nil), "glooshot.solo.io.PrometheusTrigger")
+	proto.RegisterType((*PrometheusTrigger_Range)(nil), "glooshot.solo.io.PrometheusTrigger.Range")
+	proto.RegisterType((*PrometheusTrigger_SuccessRateQuery)(nil), "glooshot.solo.io.PrometheusTrigger.SuccessRateQuery")
+	proto.RegisterType((*PrometheusTrigger_LatencyPercentileQuery)(nil), "glooshot.solo.io.PrometheusTrigger.LatencyPercentileQuery")
+	proto.RegisterType((*PrometheusTrigger_RequestVolumeQuery)(nil), "glooshot.solo.io.PrometheusTrigger.RequestVolumeQuery")
+	proto.RegisterType((*PrometheusTrigger_ErrorRateQuery)(nil), "glooshot.solo.io.PrometheusTrigger.ErrorRateQuery")
+	proto.RegisterType((*PrometheusTrigger_TcpConnectionErrorsQuery)(nil), "glooshot.solo.io.PrometheusTrigger.TcpConnectionErrorsQuery")
+	proto.RegisterType((*PrometheusTrigger_ContainerRestartsQuery)(nil), "glooshot.solo.io.PrometheusTrigger.ContainerRestartsQuery")
+	proto.RegisterType((*ErrorBudgetTrigger)(nil), "glooshot.solo.io.ErrorBudgetTrigger")
+	proto.RegisterType((*CompoundTrigger)(nil), "glooshot.solo.io.CompoundTrigger")
+	proto.RegisterType((*Report)(nil), "glooshot.solo.io.Report")
+	proto.RegisterType((*Report_FailureConditionSnapshot)(nil), "glooshot.solo.io.Report.FailureConditionSnapshot")
+	proto.RegisterType((*Report_FailureConditionHistory)(nil), "glooshot.solo.io.Report.FailureConditionHistory")
+	proto.RegisterType((*ReportSink)(nil), "glooshot.solo.io.ReportSink")
+	proto.RegisterType((*ReportSink_WebhookSink)(nil), "glooshot.solo.io.ReportSink.WebhookSink")
+	proto.RegisterMapType((map[string]string)(nil), "glooshot.solo.io.ReportSink.WebhookSink.HeadersEntry")
+	proto.RegisterType((*ReportSink_SlackSink)(nil), "glooshot.solo.io.ReportSink.SlackSink")
+	proto.RegisterType((*ReportSink_FileSink)(nil), "glooshot.solo.io.ReportSink.FileSink")
+	proto.RegisterType((*ExperimentTemplate)(nil), "glooshot.solo.io.ExperimentTemplate")
+	proto.RegisterType((*ExperimentTemplate_Parameter)(nil), "glooshot.solo.io.ExperimentTemplate.Parameter")
+	proto.RegisterType((*ExperimentSuite)(nil), "glooshot.solo.io.ExperimentSuite")
+	proto.RegisterType((*ExperimentSuite_Entry)(nil), "glooshot.solo.io.ExperimentSuite.Entry")
+	proto.RegisterType((*ExperimentSuite_TemplateRef)(nil), "glooshot.solo.io.ExperimentSuite.TemplateRef")
+	proto.RegisterMapType((map[string]string)(nil), "glooshot.solo.io.ExperimentSuite.TemplateRef.ValuesEntry")
+	proto.RegisterType((*SuiteResult)(nil), "glooshot.solo.io.SuiteResult")
+	proto.RegisterType((*SuiteResult_EntryResult)(nil), "glooshot.solo.io.SuiteResult.EntryResult")
+}
+
+func init() {
+	proto.RegisterFile("github.com/solo-io/glooshot/api/v1/glooshot.proto", fileDescriptor_b9da8418b9c75752)
 }
 
 var fileDescriptor_b9da8418b9c75752 = []byte{
-	// 1125 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x96, 0x5d, 0x6f, 0x1b, 0x45,
-	0x17, 0xc7, 0xbb, 0xf6, 0xda, 0xae, 0x8f, 0x93, 0xd4, 0x9d, 0x46, 0xad, 0xe3, 0x47, 0xea, 0x8b,
-	0x2b, 0x3d, 0x44, 0xa8, 0xac, 0x49, 0x1a, 0x44, 0x08, 0x6f, 0x95, 0x69, 0xa2, 0x22, 0x51, 0x68,
-	0xd7, 0x05, 0x09, 0x84, 0x64, 0x6d, 0x76, 0x8f, 0xd7, 0xdb, 0xac, 0x77, 0xb6, 0x33, 0xb3, 0xa1,
-	0xb9, 0x24, 0x42, 0x5c, 0x72, 0xcd, 0x47, 0xe0, 0x92, 0xcf, 0xc0, 0x15, 0x9f, 0x81, 0x0b, 0x90,
-	0xb8, 0xe5, 0x2a, 0x17, 0xdc, 0xa3, 0x79, 0xd9, 0x75, 0x62, 0xa7, 0x8e, 0xb9, 0xe1, 0xca, 0x9e,
-	0x99, 0xf3, 0x3b, 0xe7, 0xcc, 0x7f, 0xce, 0x9c, 0x59, 0xd8, 0x08, 0x23, 0x31, 0xca, 0xf6, 0x1d,
-	0x9f, 0x8e, 0xbb, 0x9c, 0xc6, 0xf4, 0x8d, 0x88, 0x76, 0xc3, 0x98, 0x52, 0x3e, 0xa2, 0xa2, 0xeb,
-	0xa5, 0x51, 0xf7, 0x70, 0xa3, 0x18, 0x3b, 0x29, 0xa3, 0x82, 0x92, 0x66, 0x31, 0x96, 0x80, 0x13,
-	0xd1, 0xf6, 0x6a, 0x48, 0x43, 0xaa, 0x16, 0xbb, 0xf2, 0x9f, 0xb6, 0x6b, 0xdf, 0x0c, 0x29, 0x0d,
-	0x63, 0xec, 0xaa, 0xd1, 0x7e, 0x36, 0xec, 0x06, 0x19, 0xf3, 0x44, 0x44, 0x13, 0xb3, 0x7e, 0x6b,
-	0x7a, 0x5d, 0x44, 0x63, 0xe4, 0xc2, 0x1b, 0xa7, 0xc6, 0xa0, 0x7b, 0x4e, 0x6e, 0xea, 0xf7, 0x20,
-	0x2a, 0x72, 0xe3, 0xc2, 0x13, 0x19, 0x37, 0xc0, 0xc6, 0x02, 0xc0, 0x18, 0x85, 0x17, 0x78, 0xc2,
-	0x33, 0xc8, 0xbd, 0x05, 0x10, 0x86, 0xc3, 0x7f, 0x11, 0x20, 0x1f, 0xcf, 0x43, 0xb2, 0x14, 0x99,
-	0x54, 0xb1, 0x88, 0x40, 0x33, 0x11, 0x25, 0xa1, 0x46, 0x3a, 0x3f, 0x94, 0x00, 0x76, 0x5f, 0xa6,
-	0xc8, 0xa2, 0x31, 0x26, 0x82, 0x6c, 0xc3, 0xe5, 0x3c, 0xe9, 0x96, 0x75, 0xdb, 0x5a, 0x6f, 0x6c,
-	0x5e, 0x77, 0x7c, 0xca, 0x30, 0x97, 0xdf, 0x79, 0x6c, 0x56, 0x7b, 0xf6, 0xaf, 0xbf, 0xdf, 0xba,
-	0xe4, 0x16, 0xd6, 0x64, 0x13, 0xaa, 0x5a, 0x9f, 0x56, 0x59, 0x71, 0xab, 0x67, 0xb9, 0xbe, 0x5a,
-	0x33, 0x94, 0xb1, 0x24, 0x5b, 0x60, 0xf3, 0x14, 0xfd, 0x56, 0x49, 0x11, 0xb7, 0x9d, 0xe9, 0xc3,
-	0x76, 0x26, 0x99, 0xf5, 0x53, 0xf4, 0x5d, 0x65, 0x4d, 0x1e, 0x40, 0x95, 0x21, 0xcf, 0x62, 0xd1,
-	0xb2, 0x15, 0xd7, 0x99, 0xc7, 0xb9, 0xca, 0x32, 0x8f, 0xab, 0xb9, 0x9d, 0xf6, 0xf1, 0x89, 0x5d,
-	0x81, 0x32, 0xbe, 0x4c, 0x8f, 0x4f, 0xec, 0x65, 0xd2, 0xc0, 0xc2, 0x9c, 0x77, 0x7e, 0x2e, 0x43,
-	0x73, 0x1a, 0x27, 0x1f, 0x40, 0x45, 0xa6, 0x8c, 0x4a, 0x93, 0x95, 0xcd, 0xf5, 0x8b, 0x23, 0xaa,
-	0x0d, 0xa3, 0xab, 0x31, 0xf2, 0x35, 0xac, 0x0c, 0xbd, 0x28, 0xce, 0x18, 0x0e, 0x18, 0xa6, 0x94,
-	0x89, 0x56, 0xe9, 0x76, 0x79, 0xbd, 0xb1, 0xf9, 0xd6, 0x02, 0x8e, 0xf6, 0x34, 0xe8, 0x2a, 0x6e,
-	0x37, 0x11, 0xec, 0xc8, 0x5d, 0x1e, 0x9e, 0x9e, 0x23, 0xef, 0xc3, 0x92, 0x2c, 0xe7, 0x01, 0x17,
-	0x1e, 0x13, 0x18, 0x98, 0x03, 0x68, 0x3b, 0xba, 0xe6, 0x9d, 0xbc, 0xe6, 0x9d, 0x67, 0x79, 0xcd,
-	0xbb, 0x0d, 0x69, 0xdf, 0xd7, 0xe6, 0xe4, 0x43, 0x58, 0x56, 0xf8, 0x30, 0x4a, 0x22, 0x3e, 0xc2,
-	0xc0, 0xc8, 0x3a, 0x8f, 0x57, 0xf1, 0xf6, 0x8c, 0x7d, 0xfb, 0x01, 0x90, 0xd9, 0x24, 0x49, 0x13,
-	0xca, 0x07, 0x78, 0xa4, 0x14, 0xab, 0xbb, 0xf2, 0x2f, 0x59, 0x85, 0xca, 0xa1, 0x17, 0x67, 0xa8,
-	0xce, 0xbb, 0xee, 0xea, 0xc1, 0x4e, 0x69, 0xdb, 0xea, 0xbc, 0x07, 0x15, 0xa5, 0x17, 0x69, 0x40,
-	0xed, 0x09, 0x26, 0x41, 0x94, 0x84, 0xcd, 0x4b, 0x72, 0x60, 0x72, 0x6c, 0x5a, 0x04, 0xa0, 0x2a,
-	0x83, 0x60, 0xd0, 0x2c, 0x91, 0x65, 0xa8, 0xf7, 0x33, 0xdf, 0x47, 0x0c, 0x30, 0x68, 0x96, 0x3b,
-	0xdf, 0xda, 0xb0, 0x72, 0xb6, 0x52, 0xc8, 0x1e, 0x54, 0x87, 0x5e, 0x16, 0x0b, 0xde, 0xb2, 0x95,
-	0xd0, 0xce, 0x45, 0xb5, 0xe5, 0x7c, 0x9c, 0x3c, 0x47, 0x5f, 0x60, 0xb0, 0x27, 0x31, 0xd7, 0xd0,
-	0xe4, 0x29, 0x90, 0xfc, 0xe0, 0x7c, 0x9a, 0x04, 0x91, 0x6c, 0x29, 0xbc, 0x55, 0x51, 0x3e, 0xcf,
-	0xa9, 0x3b, 0x23, 0xc3, 0x47, 0xb9, 0xa9, 0x7b, 0x75, 0x38, 0x35, 0xc3, 0xc9, 0xbb, 0x70, 0x39,
-	0x6f, 0x4e, 0xad, 0xaa, 0x52, 0x7a, 0x6d, 0x46, 0xe9, 0x87, 0xc6, 0xa0, 0x67, 0xff, 0xf8, 0xc7,
-	0x2d, 0xcb, 0x2d, 0x00, 0xb2, 0x03, 0x0d, 0xe1, 0xb1, 0x10, 0xc5, 0x60, 0x8c, 0x7c, 0xd4, 0xaa,
-	0x19, 0xfe, 0xcc, 0x55, 0x73, 0x91, 0xd3, 0x8c, 0xf9, 0xe8, 0xe2, 0xd0, 0x05, 0x6d, 0xfd, 0x18,
-	0xf9, 0xa8, 0xfd, 0x9b, 0x05, 0xcb, 0x67, 0x76, 0x49, 0x7a, 0x70, 0x85, 0xb2, 0x28, 0x8c, 0x92,
-	0x01, 0x47, 0x76, 0x18, 0xf9, 0xc8, 0x5b, 0x96, 0xda, 0xda, 0x1c, 0x8f, 0x2b, 0x9a, 0xe8, 0x1b,
-	0x80, 0x7c, 0x02, 0xab, 0x01, 0x72, 0x11, 0x25, 0x2a, 0xc1, 0x89, 0xa3, 0xd2, 0x45, 0x8e, 0xae,
-	0x9d, 0xc2, 0x0a, 0x6f, 0x6f, 0x43, 0x45, 0x29, 0x6f, 0x6a, 0xf8, 0x8e, 0x53, 0xb4, 0xaf, 0x53,
-	0x1a, 0x67, 0xb1, 0xd0, 0xfb, 0x90, 0x0a, 0x6b, 0xfb, 0xce, 0xdf, 0x16, 0x34, 0xa7, 0xd5, 0x27,
-	0x04, 0xec, 0xc4, 0x1b, 0xa3, 0xa9, 0x41, 0xf5, 0x9f, 0x3c, 0x84, 0x9a, 0x60, 0x51, 0x18, 0x22,
-	0x33, 0x6d, 0xe7, 0xf5, 0x8b, 0x8f, 0xd1, 0x79, 0xa6, 0x09, 0x37, 0x47, 0xdb, 0xdf, 0x5b, 0x50,
-	0x33, 0x93, 0xe4, 0x0e, 0x34, 0xbe, 0xc1, 0xfd, 0x11, 0xa5, 0x07, 0x83, 0x8c, 0xc5, 0x3a, 0xd8,
-	0xa3, 0x4b, 0x2e, 0x98, 0xc9, 0xcf, 0x59, 0x4c, 0x76, 0x01, 0x52, 0x46, 0xc7, 0x28, 0x46, 0x98,
-	0x71, 0x13, 0xf7, 0xee, 0x6c, 0xdc, 0x27, 0x85, 0x8d, 0xf1, 0x2d, 0xdd, 0x4c, 0xc0, 0xde, 0x55,
-	0xb8, 0x92, 0x57, 0xa3, 0x49, 0xa4, 0x73, 0x5c, 0x86, 0xab, 0x33, 0x18, 0xb9, 0x0b, 0x4b, 0x7e,
-	0xc6, 0x05, 0x1d, 0x0f, 0x5e, 0x64, 0xc8, 0x8e, 0x8a, 0x9c, 0x1a, 0x7a, 0xf6, 0xa9, 0x9c, 0x24,
-	0x5f, 0xc2, 0x12, 0x97, 0xb7, 0x88, 0xf3, 0x01, 0x93, 0xbd, 0x4d, 0xa7, 0xb5, 0xb5, 0x40, 0x5a,
-	0x4e, 0x5f, 0x73, 0xae, 0x27, 0x50, 0xf9, 0x92, 0xae, 0xf9, 0x64, 0x8e, 0xbc, 0x06, 0x57, 0xc4,
-	0x88, 0x21, 0x1f, 0xd1, 0x38, 0x18, 0xe8, 0x3b, 0x2f, 0x0f, 0xd4, 0x72, 0x57, 0x8a, 0xe9, 0x2f,
-	0xe4, 0x2c, 0xe9, 0xc2, 0x35, 0x9f, 0x8e, 0x53, 0x8f, 0x45, 0x9c, 0x26, 0x03, 0x9a, 0x22, 0xf3,
-	0x04, 0x65, 0xaa, 0x03, 0xd5, 0x5d, 0x32, 0x59, 0xfa, 0xcc, 0xac, 0xb4, 0xbf, 0xb3, 0xa0, 0x39,
-	0x1d, 0x9d, 0xdc, 0x87, 0x9a, 0xa9, 0x3b, 0xf3, 0x68, 0xcd, 0x29, 0xbb, 0xdc, 0x52, 0xde, 0xc3,
-	0x28, 0x11, 0xc8, 0x0e, 0xbd, 0x78, 0xe1, 0x7b, 0x98, 0x03, 0xbd, 0x25, 0x00, 0xa5, 0xec, 0x40,
-	0x1c, 0xa5, 0xd8, 0xf9, 0xcb, 0x86, 0xaa, 0xe9, 0xc5, 0xff, 0xed, 0x03, 0xfa, 0x0e, 0xc0, 0xe4,
-	0xed, 0x32, 0x7d, 0x7b, 0x5e, 0x37, 0x98, 0x18, 0x93, 0x18, 0xd6, 0x66, 0x3a, 0xdb, 0x60, 0x14,
-	0x71, 0x41, 0xd9, 0x91, 0x69, 0x70, 0x6f, 0xce, 0x96, 0x82, 0xde, 0xe5, 0xcc, 0x05, 0x79, 0xa4,
-	0x39, 0xf7, 0xc6, 0xf0, 0xfc, 0x85, 0xf6, 0x73, 0x68, 0x4d, 0x33, 0xfd, 0xc4, 0x4b, 0xa5, 0xef,
-	0xc9, 0xb3, 0x60, 0xa9, 0x12, 0xd1, 0x03, 0xb2, 0x0d, 0xf5, 0xe2, 0x1b, 0xcd, 0x94, 0xe6, 0xbc,
-	0x17, 0x69, 0x62, 0xdc, 0xfe, 0xc5, 0x82, 0x1b, 0xaf, 0x48, 0x90, 0x6c, 0xc1, 0xf5, 0xd9, 0x5d,
-	0x9f, 0xea, 0x11, 0xab, 0xd3, 0x1b, 0xf8, 0x54, 0xf6, 0x8c, 0x17, 0xf0, 0xbf, 0x59, 0x8a, 0x9b,
-	0xfc, 0xf3, 0x56, 0xb7, 0xb1, 0xb0, 0x5a, 0xf9, 0xce, 0xdd, 0xb5, 0xe1, 0x2b, 0x56, 0xf8, 0xce,
-	0xda, 0xf1, 0x89, 0x7d, 0x59, 0x7e, 0xe8, 0x48, 0x0f, 0xc7, 0x27, 0x76, 0x9d, 0xd4, 0xf4, 0x7f,
-	0xde, 0xbb, 0xf7, 0xd3, 0x9f, 0x37, 0xad, 0xaf, 0xfe, 0x3f, 0xef, 0x63, 0x3a, 0x3d, 0x08, 0xcd,
-	0xe7, 0xde, 0x7e, 0x55, 0x89, 0x75, 0xff, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2a, 0x22, 0xaf,
-	0xcc, 0x7d, 0x0b, 0x00, 0x00,
+	// 2525 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcf, 0x6f, 0x1b, 0xc7,
+	0xf5, 0xd7, 0xf2, 0x97, 0xc8, 0x47, 0x59, 0x5a, 0x8d, 0x6c, 0x85, 0xe6, 0x37, 0xdf, 0x58, 0xa1,
+	0x53, 0xc7, 0x0d, 0x12, 0xaa, 0x56, 0xd2, 0x34, 0x56, 0xd2, 0x38, 0x94, 0xb4, 0xb2, 0xd9, 0xc8,
+	0x94, 0x3c, 0xa4, 0x12, 0xb4, 0x08, 0xb0, 0x58, 0x93, 0x43, 0x72, 0xa3, 0xe5, 0xee, 0x66, 0x66,
+	0xd7, 0xb6, 0x8e, 0x31, 0x8a, 0xa2, 0x08, 0x7a, 0xea, 0xa9, 0x40, 0xd1, 0x1e, 0x8b, 0xb6, 0x28,
+	0xd0, 0xff, 0xa0, 0x97, 0x5e, 0x8a, 0xfe, 0x03, 0x2d, 0x7a, 0x68, 0x81, 0x9e, 0x7b, 0xf1, 0xa5,
+	0xe7, 0x62, 0x7e, 0xec, 0x72, 0xf9, 0x43, 0x22, 0xd5, 0x16, 0x76, 0x4f, 0xdc, 0x99, 0x79, 0x9f,
+	0xf7, 0x1e, 0xdf, 0xbc, 0xf7, 0x66, 0xde, 0x1b, 0xb8, 0xd5, 0xb3, 0x83, 0x7e, 0xf8, 0xb0, 0xda,
+	0xf6, 0x06, 0x9b, 0xcc, 0x73, 0xbc, 0xb7, 0x6c, 0x6f, 0xb3, 0xe7, 0x78, 0x1e, 0xeb, 0x7b, 0xc1,
+	0xa6, 0xe5, 0xdb, 0x9b, 0x8f, 0x6e, 0xc5, 0xe3, 0xaa, 0x4f, 0xbd, 0xc0, 0x43, 0x7a, 0x3c, 0xe6,
+	0x80, 0xaa, 0xed, 0x95, 0x2f, 0xf7, 0xbc, 0x9e, 0x27, 0x16, 0x37, 0xf9, 0x97, 0xa4, 0x2b, 0xbf,
+	0xd2, 0xf3, 0xbc, 0x9e, 0x43, 0x36, 0xc5, 0xe8, 0x61, 0xd8, 0xdd, 0xec, 0x84, 0xd4, 0x0a, 0x6c,
+	0xcf, 0x55, 0xeb, 0xd7, 0xc6, 0xd7, 0x03, 0x7b, 0x40, 0x58, 0x60, 0x0d, 0x7c, 0x45, 0xb0, 0x39,
+	0x45, 0x37, 0xf1, 0x7b, 0x62, 0xc7, 0xba, 0xb1, 0xc0, 0x0a, 0x42, 0xa6, 0x00, 0xb7, 0xe6, 0x00,
+	0x0c, 0x48, 0x60, 0x75, 0xac, 0xc0, 0x52, 0x90, 0x37, 0xe7, 0x80, 0x50, 0xd2, 0xbd, 0x80, 0x80,
+	0x68, 0x7c, 0x1e, 0x24, 0xf4, 0x09, 0xe5, 0x56, 0x8c, 0x25, 0x78, 0x61, 0x60, 0xbb, 0x3d, 0x09,
+	0xa9, 0x7c, 0x95, 0x02, 0x30, 0x9e, 0xf8, 0x84, 0xda, 0x03, 0xe2, 0x06, 0xe8, 0x3d, 0xc8, 0x47,
+	0x4a, 0x97, 0xb4, 0x0d, 0xed, 0x66, 0x71, 0x6b, 0xbd, 0xda, 0xf6, 0x28, 0x89, 0xcc, 0x5f, 0xbd,
+	0xaf, 0x56, 0x77, 0x32, 0x7f, 0xf8, 0xeb, 0xb5, 0x05, 0x1c, 0x53, 0xa3, 0x2d, 0xc8, 0x49, 0xfb,
+	0x94, 0xd2, 0x02, 0x77, 0x79, 0x14, 0xd7, 0x14, 0x6b, 0x0a, 0xa5, 0x28, 0xd1, 0x3b, 0x90, 0x61,
+	0x3e, 0x69, 0x97, 0x52, 0x02, 0xb1, 0x51, 0x1d, 0xdf, 0xec, 0xea, 0x50, 0xb3, 0xa6, 0x4f, 0xda,
+	0x58, 0x50, 0xa3, 0x8f, 0x20, 0x47, 0x09, 0x0b, 0x9d, 0xa0, 0x94, 0x11, 0xb8, 0xca, 0x79, 0x38,
+	0x2c, 0x28, 0x23, 0xb9, 0x12, 0xb7, 0xbd, 0xfe, 0xf4, 0x59, 0x06, 0x41, 0x9a, 0x3c, 0xf1, 0x51,
+	0x91, 0xc4, 0xa4, 0xac, 0xf2, 0xbb, 0x34, 0xe8, 0xe3, 0x50, 0xf4, 0x21, 0x64, 0xb9, 0xba, 0x44,
+	0xd8, 0x63, 0x79, 0xeb, 0xe6, 0x6c, 0x69, 0xe2, 0xcf, 0x12, 0x2c, 0x61, 0xe8, 0x33, 0x58, 0xee,
+	0x5a, 0xb6, 0x13, 0x52, 0x62, 0x52, 0xe2, 0x7b, 0x34, 0x28, 0xa5, 0x36, 0xd2, 0x37, 0x8b, 0x5b,
+	0xdf, 0x9c, 0x83, 0xd1, 0xbe, 0x04, 0x62, 0x81, 0x33, 0xdc, 0x80, 0x9e, 0xe2, 0x4b, 0xdd, 0xe4,
+	0x1c, 0xfa, 0x36, 0x2c, 0x71, 0x57, 0x36, 0x59, 0x60, 0xd1, 0x80, 0x74, 0x94, 0xf1, 0xcb, 0x55,
+	0xe9, 0xef, 0xd5, 0xc8, 0xdf, 0xab, 0xad, 0xc8, 0xdf, 0x71, 0x91, 0xd3, 0x37, 0x25, 0x39, 0xba,
+	0x03, 0x97, 0x04, 0xbc, 0x6b, 0xbb, 0x36, 0xeb, 0x93, 0x4e, 0x29, 0x33, 0x13, 0x2f, 0xe4, 0xed,
+	0x2b, 0xfa, 0xf2, 0x47, 0x80, 0x26, 0x95, 0x44, 0x3a, 0xa4, 0x4f, 0xc8, 0xa9, 0xb0, 0x58, 0x01,
+	0xf3, 0x4f, 0x74, 0x19, 0xb2, 0x8f, 0x2c, 0x27, 0x24, 0x62, 0xaf, 0x0b, 0x58, 0x0e, 0xb6, 0x53,
+	0xef, 0x69, 0x95, 0xef, 0x40, 0x56, 0xd8, 0x0b, 0x15, 0x61, 0xf1, 0x88, 0xb8, 0x1d, 0xdb, 0xed,
+	0xe9, 0x0b, 0x7c, 0xa0, 0x74, 0xd4, 0x35, 0x04, 0x90, 0xe3, 0x42, 0x48, 0x47, 0x4f, 0xa1, 0x4b,
+	0x50, 0x68, 0x86, 0xed, 0x36, 0x21, 0x1d, 0xd2, 0xd1, 0xd3, 0x68, 0x09, 0xf2, 0x98, 0x7c, 0x4e,
+	0xda, 0x9c, 0x30, 0x53, 0xf9, 0x32, 0x03, 0xcb, 0xa3, 0x3e, 0x83, 0xf6, 0x21, 0xd7, 0xb5, 0x42,
+	0x27, 0x60, 0xa5, 0x8c, 0x30, 0x7b, 0x75, 0x96, 0x97, 0x55, 0xeb, 0xae, 0xe4, 0xb7, 0xcf, 0x61,
+	0x58, 0xa1, 0xd1, 0x03, 0x40, 0xd1, 0x36, 0xb6, 0x3d, 0xb7, 0x63, 0xf3, 0xe4, 0xc2, 0x4a, 0xd9,
+	0x8d, 0xf4, 0x74, 0x0f, 0x54, 0x46, 0xd9, 0x8d, 0x48, 0xf1, 0x6a, 0x77, 0x6c, 0x86, 0xa1, 0xf7,
+	0x21, 0x1f, 0xa5, 0xa9, 0x52, 0x4e, 0xd8, 0xfd, 0xea, 0x84, 0xdd, 0xf7, 0x14, 0xc1, 0x4e, 0xe6,
+	0x27, 0x7f, 0xbb, 0xa6, 0xe1, 0x18, 0x80, 0xb6, 0xa1, 0x18, 0x58, 0xb4, 0x47, 0x02, 0x73, 0x40,
+	0x58, 0xbf, 0xb4, 0xa8, 0xf0, 0x23, 0x41, 0x87, 0x09, 0xf3, 0x42, 0xda, 0x26, 0x98, 0x74, 0x31,
+	0x48, 0xea, 0xfb, 0x84, 0xf5, 0xcb, 0x7f, 0xd1, 0xe0, 0xd2, 0xc8, 0xbf, 0x44, 0x3b, 0xb0, 0xe2,
+	0x51, 0xbb, 0x67, 0xbb, 0x26, 0x23, 0xf4, 0x91, 0xdd, 0x26, 0xac, 0xa4, 0x6d, 0xa4, 0xcf, 0xe7,
+	0xb8, 0x2c, 0x11, 0x4d, 0x05, 0x40, 0x07, 0x70, 0xb9, 0x43, 0x58, 0x60, 0xbb, 0x42, 0xc1, 0x21,
+	0xa3, 0xd4, 0x2c, 0x46, 0x6b, 0x09, 0x58, 0xcc, 0xed, 0x5b, 0x90, 0x15, 0x96, 0x57, 0x1e, 0xfd,
+	0x6a, 0x35, 0x4e, 0x64, 0x09, 0x1b, 0x87, 0x4e, 0x20, 0xff, 0x07, 0xb7, 0xb0, 0xa4, 0xaf, 0x7c,
+	0x99, 0x06, 0x7d, 0xdc, 0xfa, 0x08, 0x41, 0xc6, 0xb5, 0x06, 0x44, 0x79, 0xa4, 0xf8, 0x46, 0x7b,
+	0xb0, 0x18, 0x50, 0xbb, 0xd7, 0x23, 0x54, 0x25, 0xa0, 0x37, 0x66, 0x6f, 0x63, 0xb5, 0x25, 0x11,
+	0x38, 0x82, 0x96, 0x7f, 0x9c, 0x82, 0x45, 0x35, 0x89, 0x5e, 0x85, 0xe2, 0x63, 0xf2, 0xb0, 0xef,
+	0x79, 0x27, 0x66, 0x48, 0x1d, 0x29, 0xec, 0xde, 0x02, 0x06, 0x35, 0x79, 0x4c, 0x1d, 0x64, 0x00,
+	0xf8, 0xd4, 0x1b, 0x90, 0xa0, 0x4f, 0x42, 0xa6, 0xe4, 0x5e, 0x9f, 0x94, 0x7b, 0x14, 0xd3, 0x28,
+	0xde, 0x9c, 0xcd, 0x10, 0x88, 0xea, 0xb0, 0x44, 0x28, 0xf5, 0xa8, 0xf9, 0x30, 0xec, 0xf4, 0x48,
+	0x64, 0xa4, 0xd7, 0xa6, 0xf8, 0x36, 0xa7, 0xda, 0x11, 0x44, 0x43, 0x4e, 0x45, 0x32, 0x9c, 0x45,
+	0x77, 0x20, 0xdf, 0xf6, 0x06, 0xbe, 0x17, 0xba, 0x51, 0xf4, 0xbf, 0x3a, 0xc9, 0x66, 0x57, 0x51,
+	0x0c, 0x79, 0xc4, 0xa0, 0x9d, 0x55, 0x58, 0x89, 0x22, 0x43, 0x19, 0xa5, 0xf2, 0xc7, 0x15, 0x58,
+	0x9d, 0xf8, 0x0b, 0xe8, 0x3a, 0x2c, 0xb5, 0x43, 0x16, 0x78, 0x03, 0xf3, 0x8b, 0x90, 0xd0, 0xd3,
+	0xd8, 0x3e, 0x45, 0x39, 0xfb, 0x80, 0x4f, 0xa2, 0xef, 0xc2, 0x12, 0xe3, 0xf1, 0xcd, 0x98, 0x49,
+	0x79, 0xd6, 0x95, 0x26, 0x7a, 0x67, 0x0e, 0x13, 0x55, 0x9b, 0x12, 0x87, 0xad, 0x80, 0x08, 0x5e,
+	0x9c, 0x35, 0x1b, 0xce, 0xa1, 0x13, 0x40, 0x8e, 0x15, 0x10, 0xb7, 0x7d, 0x6a, 0xfa, 0x84, 0xb6,
+	0x89, 0x1b, 0xd8, 0x0e, 0x29, 0x65, 0x85, 0x80, 0xed, 0x79, 0x04, 0x1c, 0x48, 0xf4, 0x51, 0x0c,
+	0x8e, 0xc4, 0xac, 0x3a, 0xe3, 0x2b, 0xc8, 0x84, 0x65, 0x4a, 0xbe, 0x08, 0x09, 0x0b, 0xcc, 0x47,
+	0x9e, 0x13, 0x0e, 0x88, 0x0a, 0xf1, 0x77, 0xe7, 0x11, 0x84, 0x25, 0xf2, 0x13, 0x01, 0x8c, 0x84,
+	0x5c, 0xa2, 0xc9, 0x59, 0xd4, 0x04, 0x90, 0x2e, 0x20, 0xcc, 0x24, 0xe3, 0x7f, 0x6b, 0x1e, 0xe6,
+	0xc2, 0x25, 0x92, 0x46, 0x2a, 0x90, 0x68, 0x06, 0x51, 0xb8, 0x12, 0xb4, 0x7d, 0x9e, 0xe1, 0x5c,
+	0x19, 0x55, 0xa6, 0x58, 0x63, 0xa5, 0xbc, 0xe0, 0xff, 0xc1, 0x3c, 0xfc, 0x5b, 0x6d, 0x7f, 0x37,
+	0xc6, 0x0b, 0x61, 0x2c, 0x92, 0xb4, 0x16, 0x4c, 0xae, 0xf1, 0x6d, 0x69, 0x7b, 0x6e, 0x60, 0xd9,
+	0x2e, 0xa1, 0x26, 0x25, 0xe2, 0x24, 0x63, 0xa5, 0xc2, 0xfc, 0xdb, 0xb2, 0x1b, 0xa1, 0xb1, 0x02,
+	0xc7, 0xdb, 0xd2, 0x1e, 0x5f, 0x41, 0xaf, 0xc3, 0x4a, 0xd0, 0xa7, 0x84, 0xf5, 0x3d, 0xa7, 0x63,
+	0xca, 0x13, 0x89, 0xc7, 0x8e, 0x86, 0x97, 0xe3, 0xe9, 0x4f, 0xf8, 0x2c, 0xda, 0x84, 0x35, 0xee,
+	0xe1, 0x16, 0xb5, 0x99, 0xe7, 0x9a, 0x9e, 0x4f, 0xa8, 0x15, 0x78, 0x54, 0x44, 0x48, 0x01, 0xa3,
+	0xe1, 0xd2, 0xa1, 0x5a, 0x41, 0x18, 0xf2, 0x31, 0x15, 0x88, 0xab, 0xc2, 0xbb, 0xf3, 0x29, 0x3f,
+	0xce, 0x09, 0xc7, 0x7c, 0xd0, 0x1d, 0xc8, 0x52, 0xcb, 0xed, 0x91, 0x52, 0x51, 0x58, 0xe3, 0xeb,
+	0x73, 0xf9, 0x0e, 0x07, 0x60, 0x89, 0x2b, 0xbf, 0x05, 0x59, 0x31, 0xe6, 0x27, 0xb2, 0xe3, 0x3d,
+	0x16, 0x21, 0xa7, 0x61, 0xfe, 0xc9, 0x53, 0x62, 0xdf, 0xee, 0xf5, 0x45, 0x80, 0x69, 0x58, 0x7c,
+	0x97, 0xbf, 0xaf, 0x81, 0x3e, 0x1e, 0x45, 0xe8, 0x6d, 0x58, 0x54, 0xb9, 0x5c, 0x5d, 0x09, 0xcf,
+	0x49, 0xe5, 0x11, 0x25, 0x3f, 0xdb, 0x6c, 0x37, 0x20, 0xf4, 0x91, 0xe5, 0xcc, 0x7d, 0xb6, 0x45,
+	0x80, 0xf2, 0xaf, 0x35, 0x58, 0x9f, 0x1e, 0x6b, 0xff, 0xb9, 0x32, 0xa9, 0x0b, 0x2a, 0x83, 0x5e,
+	0x01, 0x48, 0x64, 0x0b, 0xe9, 0x2c, 0x89, 0x99, 0xf2, 0x0f, 0x34, 0x40, 0x93, 0xf1, 0xfa, 0xfc,
+	0x15, 0x2d, 0x3f, 0xd5, 0x60, 0x79, 0x34, 0xb6, 0x5f, 0x80, 0x12, 0x3f, 0xd2, 0xa0, 0x74, 0x56,
+	0x02, 0x78, 0x01, 0xea, 0x7c, 0xa5, 0xc1, 0xfa, 0xf4, 0xf4, 0xf0, 0xfc, 0x95, 0xa9, 0xfc, 0x42,
+	0x03, 0x34, 0x19, 0xee, 0x68, 0x05, 0x8a, 0xc7, 0x8d, 0xe6, 0x91, 0xb1, 0x5b, 0xdf, 0xaf, 0x1b,
+	0x7b, 0xfa, 0x02, 0xbf, 0xe2, 0x1e, 0x18, 0xcd, 0xa6, 0xd9, 0xba, 0x57, 0x6b, 0xe8, 0x1a, 0x5a,
+	0x07, 0x14, 0x0f, 0xcd, 0x43, 0x6c, 0x1a, 0x0f, 0x8e, 0x6b, 0x07, 0x7a, 0x0a, 0xe9, 0xb0, 0x74,
+	0x17, 0x1b, 0xb5, 0x96, 0x81, 0x25, 0x65, 0x1a, 0x5d, 0x85, 0x2b, 0xc9, 0x99, 0x21, 0x71, 0x06,
+	0x15, 0x20, 0x2b, 0x3f, 0xb3, 0x9c, 0x7d, 0xe3, 0xb0, 0xa5, 0x56, 0x72, 0x68, 0x15, 0x2e, 0x1d,
+	0x1e, 0xb7, 0x9a, 0xf5, 0x3d, 0xc3, 0xc4, 0xb5, 0xc6, 0x5d, 0x43, 0x5f, 0xdc, 0x59, 0x02, 0x10,
+	0x27, 0xb4, 0x19, 0x9c, 0xfa, 0xa4, 0xf2, 0xa7, 0x14, 0xa0, 0xc9, 0x6b, 0xc4, 0xbf, 0x67, 0xbf,
+	0x75, 0xc8, 0xc9, 0x7b, 0xa8, 0x4a, 0x3b, 0x6a, 0x84, 0x3e, 0x04, 0x60, 0x8e, 0x67, 0x3e, 0xb6,
+	0xdd, 0x8e, 0xf7, 0xb8, 0x94, 0x9e, 0xcf, 0xb2, 0x05, 0xe6, 0x78, 0x9f, 0x0a, 0x04, 0xaa, 0xc2,
+	0xda, 0xc0, 0x7a, 0xa2, 0x6e, 0x43, 0x66, 0x97, 0x5a, 0xc2, 0xf7, 0x44, 0xb6, 0xd6, 0xf0, 0xea,
+	0xc0, 0x7a, 0x22, 0x75, 0xdf, 0x57, 0x0b, 0x68, 0x07, 0x96, 0x58, 0xdf, 0xa3, 0x41, 0x24, 0x31,
+	0x3b, 0x9f, 0xc4, 0xa2, 0x00, 0x29, 0x99, 0x1f, 0x41, 0xd1, 0xf1, 0xdc, 0x5e, 0xc4, 0x62, 0xce,
+	0x2c, 0x07, 0x1c, 0x23, 0x39, 0x54, 0x7e, 0xaf, 0xc1, 0xca, 0xd8, 0xcd, 0x0a, 0xed, 0x27, 0x8e,
+	0x11, 0x59, 0x71, 0xbe, 0x31, 0xf3, 0x3a, 0x56, 0x9d, 0x72, 0x74, 0xec, 0x00, 0x24, 0xea, 0x94,
+	0xd4, 0xdc, 0x75, 0x4a, 0x02, 0x55, 0x79, 0x0d, 0xf2, 0xb1, 0x97, 0x2e, 0x42, 0xba, 0xd6, 0xe0,
+	0xde, 0x99, 0x83, 0xd4, 0x21, 0xd6, 0x35, 0x3e, 0xd1, 0x38, 0x6c, 0xe9, 0xa9, 0xca, 0x4f, 0xb3,
+	0x90, 0x53, 0xd5, 0xe8, 0xf3, 0x6d, 0x1f, 0xdc, 0x06, 0x18, 0x56, 0xef, 0xa5, 0xcc, 0x2c, 0x27,
+	0x4c, 0x10, 0x23, 0x07, 0xae, 0x4e, 0x54, 0x73, 0x66, 0xdf, 0x66, 0x81, 0x47, 0x4f, 0x55, 0x51,
+	0xf7, 0x8d, 0x49, 0x63, 0xc9, 0x7f, 0x39, 0x61, 0xb3, 0x7b, 0x12, 0x87, 0x5f, 0xea, 0x4e, 0x5f,
+	0x28, 0x7f, 0x0e, 0xa5, 0x71, 0x4c, 0xd3, 0xb5, 0x7c, 0xce, 0x7b, 0x58, 0x18, 0xcb, 0xa3, 0x59,
+	0x0e, 0xd0, 0x7b, 0x50, 0x88, 0x3b, 0x54, 0xa5, 0xd4, 0xcc, 0x9a, 0x7c, 0x48, 0x5c, 0xfe, 0xa7,
+	0x06, 0x2f, 0x9d, 0xa1, 0x20, 0x7a, 0x07, 0xd6, 0x27, 0xff, 0x75, 0xa2, 0x2e, 0xba, 0x3c, 0xfe,
+	0x07, 0x1a, 0xbc, 0x4e, 0xfa, 0x02, 0xfe, 0x6f, 0x12, 0xc5, 0x94, 0xfe, 0x91, 0x6b, 0xdd, 0x9a,
+	0xdb, 0x5a, 0xd1, 0x3f, 0xc7, 0x57, 0xbb, 0x67, 0xac, 0x30, 0xb4, 0x05, 0x57, 0x92, 0xe5, 0x0d,
+	0x97, 0xcb, 0xc2, 0x81, 0x6a, 0x6f, 0x68, 0x78, 0x2d, 0x51, 0xbf, 0xec, 0xaa, 0xa5, 0xed, 0x2b,
+	0x4f, 0x9f, 0x65, 0x56, 0x79, 0x6b, 0x48, 0x78, 0xe2, 0xa2, 0xfc, 0x65, 0x95, 0x5f, 0x65, 0x01,
+	0xa4, 0x26, 0x4d, 0xdb, 0x3d, 0xf9, 0xaf, 0x78, 0x68, 0x6a, 0x6e, 0x0f, 0xdd, 0x83, 0x45, 0x55,
+	0xfb, 0x29, 0xb7, 0xbe, 0x79, 0x96, 0x99, 0xb8, 0x72, 0xd5, 0x4f, 0x25, 0x2d, 0xff, 0xbe, 0xb7,
+	0x80, 0x23, 0xa8, 0xe8, 0x40, 0x39, 0x56, 0xfb, 0x44, 0xb9, 0xf8, 0x8d, 0x73, 0x79, 0x34, 0x39,
+	0xa5, 0xe2, 0x20, 0x61, 0xe8, 0x7d, 0xc8, 0x74, 0x87, 0x95, 0xce, 0xd7, 0xce, 0x85, 0xef, 0xdb,
+	0x0e, 0x51, 0x68, 0x01, 0x42, 0xd7, 0x21, 0xea, 0x38, 0x31, 0xd3, 0x73, 0x9d, 0x53, 0x91, 0xe7,
+	0xf2, 0x78, 0x29, 0x9a, 0x3c, 0x74, 0x9d, 0xd3, 0xf2, 0x6f, 0x34, 0x28, 0x26, 0x94, 0xe7, 0xb7,
+	0xcd, 0xb8, 0x00, 0xc6, 0xfc, 0x13, 0x1d, 0xc2, 0x62, 0x9f, 0x58, 0x1d, 0x42, 0xd9, 0xd9, 0xed,
+	0xaf, 0xe9, 0x96, 0xa8, 0xde, 0x93, 0x38, 0xd9, 0xfe, 0x8a, 0xb8, 0x94, 0xb7, 0x61, 0x29, 0xb9,
+	0x70, 0x91, 0x96, 0x53, 0x79, 0x1f, 0x0a, 0xb1, 0x99, 0xd0, 0xb5, 0x29, 0x45, 0xfb, 0x48, 0xc9,
+	0x5e, 0x82, 0xc5, 0x76, 0xdf, 0x72, 0x5d, 0xe2, 0x28, 0x4e, 0xd1, 0xb0, 0x7c, 0x13, 0xf2, 0x91,
+	0xbd, 0xd0, 0xcb, 0x50, 0xe8, 0xd8, 0x94, 0xb4, 0x45, 0x06, 0x91, 0x4c, 0x86, 0x13, 0xdb, 0x2f,
+	0x3d, 0x7d, 0x96, 0x59, 0x83, 0x0c, 0xe3, 0x94, 0x45, 0xe5, 0x9a, 0xb6, 0x7b, 0xc2, 0x76, 0x8a,
+	0x50, 0xe0, 0x1f, 0xf2, 0xa4, 0xfd, 0x6d, 0x06, 0xd0, 0xb0, 0x19, 0xd5, 0x22, 0x03, 0x9f, 0xd7,
+	0x95, 0xcf, 0xd9, 0x67, 0x1b, 0x00, 0xbe, 0x45, 0xad, 0x01, 0x09, 0xf8, 0x66, 0xa5, 0x67, 0x37,
+	0xcd, 0x22, 0x3d, 0xab, 0x47, 0x11, 0x0c, 0x27, 0x38, 0xf0, 0x3a, 0x43, 0x34, 0x79, 0x65, 0xe5,
+	0x24, 0xbe, 0xcb, 0x3f, 0x4f, 0x41, 0x21, 0xa6, 0x9e, 0xda, 0x9c, 0xd9, 0x80, 0x62, 0x87, 0xb0,
+	0x36, 0xb5, 0x7d, 0x71, 0x90, 0x4b, 0xc3, 0x27, 0xa7, 0xd0, 0x5d, 0xc8, 0x70, 0xa3, 0x89, 0xc0,
+	0x5a, 0xde, 0x7a, 0xfb, 0x62, 0x1a, 0x56, 0x5b, 0xa7, 0x3e, 0xc1, 0x82, 0x01, 0x2a, 0x43, 0x9e,
+	0x57, 0xd6, 0x36, 0x55, 0xed, 0xcf, 0x3c, 0x8e, 0xc7, 0x7c, 0xef, 0x3b, 0x44, 0xf6, 0xa1, 0xb2,
+	0x72, 0xef, 0xd5, 0xb0, 0xf2, 0x19, 0x64, 0x38, 0x0f, 0xde, 0x9b, 0x6c, 0xb6, 0x70, 0xbd, 0x71,
+	0x57, 0x5f, 0xe0, 0x37, 0xb9, 0xa6, 0x81, 0x3f, 0xa9, 0xef, 0x1a, 0x26, 0x36, 0xf6, 0x75, 0x4d,
+	0x5c, 0xb5, 0x6a, 0xf7, 0x8d, 0xe6, 0x51, 0x6d, 0xd7, 0xd0, 0x53, 0x68, 0x19, 0xe0, 0xc8, 0xc0,
+	0xbb, 0x46, 0xa3, 0x55, 0xbb, 0x6b, 0xe8, 0x69, 0x8e, 0x6d, 0x1c, 0xdf, 0xdf, 0x31, 0xb0, 0x9e,
+	0xe1, 0x8d, 0xcc, 0xbd, 0x63, 0x5c, 0x6b, 0xd5, 0x0f, 0x1b, 0x7a, 0x76, 0xfb, 0xc6, 0xd3, 0x67,
+	0x99, 0x0a, 0xf0, 0xe6, 0x74, 0x10, 0x79, 0xc1, 0xda, 0xf0, 0xf8, 0x8a, 0xe6, 0x58, 0xe5, 0x1f,
+	0x39, 0x58, 0x49, 0xb4, 0x2f, 0x43, 0xfb, 0xb9, 0xbb, 0x4b, 0x1d, 0x92, 0x2d, 0x74, 0xe5, 0x2f,
+	0xaf, 0x9f, 0xdb, 0x64, 0xe5, 0x5a, 0x56, 0x65, 0x38, 0x27, 0xb1, 0x68, 0x1b, 0x32, 0x03, 0xaf,
+	0x43, 0xc4, 0x26, 0x2c, 0x6f, 0xdd, 0x98, 0xcd, 0xe3, 0xbe, 0xd7, 0x21, 0x58, 0x60, 0xd0, 0x07,
+	0x50, 0x68, 0x7b, 0x9e, 0x63, 0x76, 0xbc, 0xc7, 0xee, 0xbc, 0xb7, 0xb9, 0x3c, 0x47, 0xec, 0x79,
+	0x8f, 0x5d, 0xf4, 0x7e, 0xfc, 0xa4, 0x20, 0x6f, 0x71, 0xff, 0x3f, 0x29, 0x5b, 0x48, 0x9c, 0xf6,
+	0x9a, 0xc0, 0xab, 0xd5, 0xac, 0xcc, 0x41, 0xd3, 0x1c, 0xf9, 0xdd, 0x8b, 0xbd, 0x71, 0xf0, 0xbc,
+	0xcb, 0xe9, 0xd1, 0xc7, 0x90, 0x8f, 0xb6, 0x59, 0x9d, 0x1d, 0x6f, 0xcd, 0x36, 0x48, 0xe4, 0xe8,
+	0x98, 0x74, 0x79, 0x8b, 0x2e, 0x62, 0xb0, 0x93, 0x87, 0x9c, 0xbc, 0x07, 0x95, 0xff, 0xac, 0x41,
+	0x31, 0x41, 0xc5, 0x0b, 0x9a, 0x58, 0xcc, 0xac, 0x6b, 0x7c, 0xe4, 0x2f, 0xb1, 0x4b, 0x3e, 0x80,
+	0x9c, 0x48, 0xaa, 0x51, 0x4e, 0xbf, 0x7d, 0x21, 0x0d, 0xab, 0xa2, 0xd1, 0xa2, 0xf2, 0xba, 0x62,
+	0x54, 0xbe, 0x0d, 0xc5, 0xc4, 0xf4, 0x85, 0x1e, 0x12, 0xee, 0x40, 0x86, 0x3b, 0x04, 0x8f, 0xb2,
+	0xa6, 0xf1, 0xe0, 0xd8, 0x68, 0xb4, 0xea, 0xb5, 0x03, 0x7d, 0x81, 0x47, 0xd6, 0x51, 0x0d, 0xd7,
+	0x0e, 0x0e, 0x8c, 0x03, 0x5d, 0xe3, 0x35, 0x52, 0xb3, 0x75, 0x78, 0x64, 0x1e, 0x36, 0xcc, 0xfd,
+	0x3a, 0x6e, 0xb6, 0xcc, 0xfd, 0x5a, 0xfd, 0xe0, 0x18, 0x1b, 0x7a, 0x6a, 0x7b, 0xe3, 0xe9, 0xb3,
+	0xcc, 0xcb, 0x90, 0x27, 0x4f, 0x7c, 0x26, 0x02, 0x49, 0x4f, 0x38, 0x27, 0x9f, 0x60, 0x95, 0x9f,
+	0xe5, 0xa0, 0x98, 0x70, 0x04, 0x74, 0x7b, 0xf4, 0x6d, 0xe8, 0xfa, 0xb9, 0x6e, 0x33, 0xfa, 0x2c,
+	0x74, 0x05, 0x72, 0x34, 0x74, 0x4d, 0xbb, 0x13, 0xfd, 0x11, 0x1a, 0xba, 0xf5, 0xce, 0x8b, 0x7e,
+	0xcf, 0xe1, 0x09, 0x6f, 0x40, 0x18, 0xb3, 0x7a, 0x24, 0x4a, 0x78, 0x6a, 0x88, 0x3e, 0x1e, 0x0d,
+	0xf4, 0xdc, 0x46, 0x7a, 0x7a, 0x47, 0x2a, 0xf9, 0x8f, 0xe5, 0xde, 0x8a, 0xef, 0x91, 0x50, 0x2f,
+	0xff, 0x30, 0x0d, 0xc5, 0xc4, 0xe2, 0xd4, 0xc8, 0x19, 0xbd, 0xde, 0xa7, 0x2e, 0x72, 0xbd, 0x37,
+	0xa2, 0x7d, 0x91, 0x87, 0xc3, 0xe6, 0xdc, 0x5a, 0x8e, 0xee, 0xd1, 0xf8, 0x66, 0xe4, 0xfe, 0x57,
+	0x36, 0xa3, 0x52, 0x4f, 0x3c, 0x9a, 0x7d, 0x6a, 0xd9, 0x41, 0xfc, 0x68, 0x86, 0x43, 0xd7, 0xe5,
+	0x03, 0x6d, 0xf4, 0xa1, 0x2c, 0x95, 0x78, 0x43, 0x4b, 0x73, 0xba, 0xe6, 0x89, 0xed, 0xfb, 0xe2,
+	0xcd, 0xec, 0xcc, 0xf7, 0xb7, 0xb9, 0x58, 0x8d, 0xbc, 0xbf, 0xed, 0xbc, 0xf9, 0xcb, 0xbf, 0xbf,
+	0xa2, 0x7d, 0xef, 0xc6, 0x79, 0xef, 0xfc, 0xfe, 0x49, 0x4f, 0xbd, 0x44, 0x3f, 0xcc, 0x09, 0x03,
+	0xbc, 0xfd, 0xaf, 0x01, 0x00, 0x2c, 0x1e, 0xe3, 0xd3, 0x18, 0x20, 0x00, 0x00,
+}
+
+func (this *Experiment) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Experiment)
+	if !ok {
+		that2, ok := that.(Experiment)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Metadata.Equal(&that1.Metadata) {
+		return false
+	}
+	if !this.Status.Equal(&that1.Status) {
+		return false
+	}
+	if !this.Spec.Equal(that1.Spec) {
+		return false
+	}
+	if !this.Result.Equal(&that1.Result) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *ExperimentResult) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ExperimentResult)
+	if !ok {
+		that2, ok := that.(ExperimentResult)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.State != that1.State {
+		return false
+	}
+	if len(this.FailureReport) != len(that1.FailureReport) {
+		return false
+	}
+	for i := range this.FailureReport {
+		if this.FailureReport[i] != that1.FailureReport[i] {
+			return false
+		}
+	}
+	if !this.TimeStarted.Equal(that1.TimeStarted) {
+		return false
+	}
+	if !this.TimeFinished.Equal(that1.TimeFinished) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *ExperimentSpec) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ExperimentSpec)
+	if !ok {
+		that2, ok := that.(ExperimentSpec)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Faults) != len(that1.Faults) {
+		return false
+	}
+	for i := range this.Faults {
+		if !this.Faults[i].Equal(that1.Faults[i]) {
+			return false
+		}
+	}
+	if len(this.FailureConditions) != len(that1.FailureConditions) {
+		return false
+	}
+	for i := range this.FailureConditions {
+		if !this.FailureConditions[i].Equal(that1.FailureConditions[i]) {
+			return false
+		}
+	}
+	if this.Duration != nil && that1.Duration != nil {
+		if *this.Duration != *that1.Duration {
+			return false
+		}
+	} else if this.Duration != nil {
+		return false
+	} else if that1.Duration != nil {
+		return false
+	}
+	if !this.TargetMesh.Equal(that1.TargetMesh) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *ExperimentSpec_InjectedFault) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ExperimentSpec_InjectedFault)
+	if !ok {
+		that2, ok := that.(ExperimentSpec_InjectedFault)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.OriginServices) != len(that1.OriginServices) {
+		return false
+	}
+	for i := range this.OriginServices {
+		if !this.OriginServices[i].Equal(that1.OriginServices[i]) {
+			return false
+		}
+	}
+	if len(this.DestinationServices) != len(that1.DestinationServices) {
+		return false
+	}
+	for i := range this.DestinationServices {
+		if !this.DestinationServices[i].Equal(that1.DestinationServices[i]) {
+			return false
+		}
+	}
+	if !this.Fault.Equal(that1.Fault) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *FailureCondition) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FailureCondition)
+	if !ok {
+		that2, ok := that.(FailureCondition)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if !this.Trigger.Equal(that1.Trigger) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *FailureCondition_Trigger) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FailureCondition_Trigger)
+	if !ok {
+		that2, ok := that.(FailureCondition_Trigger)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if that1.FailureTrigger == nil {
+		if this.FailureTrigger != nil {
+			return false
+		}
+	} else if this.FailureTrigger == nil {
+		return false
+	} else if !this.FailureTrigger.Equal(that1.FailureTrigger) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *FailureCondition_Trigger_WebhookUrl) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FailureCondition_Trigger_WebhookUrl)
+	if !ok {
+		that2, ok := that.(FailureCondition_Trigger_WebhookUrl)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.WebhookUrl != that1.WebhookUrl {
+		return false
+	}
+	return true
+}
+func (this *FailureCondition_Trigger_Prometheus) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FailureCondition_Trigger_Prometheus)
+	if !ok {
+		that2, ok := that.(FailureCondition_Trigger_Prometheus)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Prometheus.Equal(that1.Prometheus) {
+		return false
+	}
+	return true
+}
+func (this *FailureCondition_Trigger_ErrorBudget) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FailureCondition_Trigger_ErrorBudget)
+	if !ok {
+		that2, ok := that.(FailureCondition_Trigger_ErrorBudget)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ErrorBudget.Equal(that1.ErrorBudget) {
+		return false
+	}
+	return true
+}
+func (this *FailureCondition_Trigger_Compound) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FailureCondition_Trigger_Compound)
+	if !ok {
+		that2, ok := that.(FailureCondition_Trigger_Compound)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Compound.Equal(that1.Compound) {
+		return false
+	}
+	return true
+}
+func (this *PrometheusTrigger) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PrometheusTrigger)
+	if !ok {
+		that2, ok := that.(PrometheusTrigger)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if that1.QueryType == nil {
+		if this.QueryType != nil {
+			return false
+		}
+	} else if this.QueryType == nil {
+		return false
+	} else if !this.QueryType.Equal(that1.QueryType) {
+		return false
+	}
+	if this.ThresholdValue != that1.ThresholdValue {
+		return false
+	}
+	if this.ComparisonOperator != that1.ComparisonOperator {
+		return false
+	}
+	if this.Operator != that1.Operator {
+		return false
+	}
+	if !this.Range.Equal(that1.Range) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *PrometheusTrigger_CustomQuery) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PrometheusTrigger_CustomQuery)
+	if !ok {
+		that2, ok := that.(PrometheusTrigger_CustomQuery)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.CustomQuery != that1.CustomQuery {
+		return false
+	}
+	return true
+}
+func (this *PrometheusTrigger_SuccessRate) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PrometheusTrigger_SuccessRate)
+	if !ok {
+		that2, ok := that.(PrometheusTrigger_SuccessRate)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.SuccessRate.Equal(that1.SuccessRate) {
+		return false
+	}
+	return true
+}
+func (this *PrometheusTrigger_LatencyPercentile) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PrometheusTrigger_LatencyPercentile)
+	if !ok {
+		that2, ok := that.(PrometheusTrigger_LatencyPercentile)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.LatencyPercentile.Equal(that1.LatencyPercentile) {
+		return false
+	}
+	return true
+}
+func (this *PrometheusTrigger_RequestVolume) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PrometheusTrigger_RequestVolume)
+	if !ok {
+		that2, ok := that.(PrometheusTrigger_RequestVolume)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.RequestVolume.Equal(that1.RequestVolume) {
+		return false
+	}
+	return true
+}
+func (this *PrometheusTrigger_ErrorRate) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PrometheusTrigger_ErrorRate)
+	if !ok {
+		that2, ok := that.(PrometheusTrigger_ErrorRate)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ErrorRate.Equal(that1.ErrorRate) {
+		return false
+	}
+	return true
+}
+func (this *PrometheusTrigger_TcpConnectionErrors) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PrometheusTrigger_TcpConnectionErrors)
+	if !ok {
+		that2, ok := that.(PrometheusTrigger_TcpConnectionErrors)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.TcpConnectionErrors.Equal(that1.TcpConnectionErrors) {
+		return false
+	}
+	return true
+}
+func (this *PrometheusTrigger_ContainerRestarts) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PrometheusTrigger_ContainerRestarts)
+	if !ok {
+		that2, ok := that.(PrometheusTrigger_ContainerRestarts)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ContainerRestarts.Equal(that1.ContainerRestarts) {
+		return false
+	}
+	return true
+}
+func (this *PrometheusTrigger_Range) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PrometheusTrigger_Range)
+	if !ok {
+		that2, ok := that.(PrometheusTrigger_Range)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Low != that1.Low {
+		return false
+	}
+	if this.High != that1.High {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *PrometheusTrigger_SuccessRateQuery) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PrometheusTrigger_SuccessRateQuery)
+	if !ok {
+		that2, ok := that.(PrometheusTrigger_SuccessRateQuery)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Service.Equal(that1.Service) {
+		return false
+	}
+	if this.Interval != nil && that1.Interval != nil {
+		if *this.Interval != *that1.Interval {
+			return false
+		}
+	} else if this.Interval != nil {
+		return false
+	} else if that1.Interval != nil {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *PrometheusTrigger_LatencyPercentileQuery) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PrometheusTrigger_LatencyPercentileQuery)
+	if !ok {
+		that2, ok := that.(PrometheusTrigger_LatencyPercentileQuery)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Service.Equal(that1.Service) {
+		return false
+	}
+	if this.Interval != nil && that1.Interval != nil {
+		if *this.Interval != *that1.Interval {
+			return false
+		}
+	} else if this.Interval != nil {
+		return false
+	} else if that1.Interval != nil {
+		return false
+	}
+	if this.Percentile != that1.Percentile {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *PrometheusTrigger_RequestVolumeQuery) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PrometheusTrigger_RequestVolumeQuery)
+	if !ok {
+		that2, ok := that.(PrometheusTrigger_RequestVolumeQuery)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Service.Equal(that1.Service) {
+		return false
+	}
+	if this.Interval != nil && that1.Interval != nil {
+		if *this.Interval != *that1.Interval {
+			return false
+		}
+	} else if this.Interval != nil {
+		return false
+	} else if that1.Interval != nil {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *PrometheusTrigger_ErrorRateQuery) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PrometheusTrigger_ErrorRateQuery)
+	if !ok {
+		that2, ok := that.(PrometheusTrigger_ErrorRateQuery)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Service.Equal(that1.Service) {
+		return false
+	}
+	if this.Interval != nil && that1.Interval != nil {
+		if *this.Interval != *that1.Interval {
+			return false
+		}
+	} else if this.Interval != nil {
+		return false
+	} else if that1.Interval != nil {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *PrometheusTrigger_TcpConnectionErrorsQuery) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PrometheusTrigger_TcpConnectionErrorsQuery)
+	if !ok {
+		that2, ok := that.(PrometheusTrigger_TcpConnectionErrorsQuery)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Service.Equal(that1.Service) {
+		return false
+	}
+	if this.Interval != nil && that1.Interval != nil {
+		if *this.Interval != *that1.Interval {
+			return false
+		}
+	} else if this.Interval != nil {
+		return false
+	} else if that1.Interval != nil {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *PrometheusTrigger_ContainerRestartsQuery) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PrometheusTrigger_ContainerRestartsQuery)
+	if !ok {
+		that2, ok := that.(PrometheusTrigger_ContainerRestartsQuery)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Service.Equal(that1.Service) {
+		return false
+	}
+	if this.Interval != nil && that1.Interval != nil {
+		if *this.Interval != *that1.Interval {
+			return false
+		}
+	} else if this.Interval != nil {
+		return false
+	} else if that1.Interval != nil {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *ErrorBudgetTrigger) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ErrorBudgetTrigger)
+	if !ok {
+		that2, ok := that.(ErrorBudgetTrigger)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Service.Equal(that1.Service) {
+		return false
+	}
+	if this.Target != that1.Target {
+		return false
+	}
+	if this.SloWindow != nil && that1.SloWindow != nil {
+		if *this.SloWindow != *that1.SloWindow {
+			return false
+		}
+	} else if this.SloWindow != nil {
+		return false
+	} else if that1.SloWindow != nil {
+		return false
+	}
+	if this.MaxBudgetFraction != that1.MaxBudgetFraction {
+		return false
+	}
+	if this.ShortWindow != nil && that1.ShortWindow != nil {
+		if *this.ShortWindow != *that1.ShortWindow {
+			return false
+		}
+	} else if this.ShortWindow != nil {
+		return false
+	} else if that1.ShortWindow != nil {
+		return false
+	}
+	if this.LongWindow != nil && that1.LongWindow != nil {
+		if *this.LongWindow != *that1.LongWindow {
+			return false
+		}
+	} else if this.LongWindow != nil {
+		return false
+	} else if that1.LongWindow != nil {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *CompoundTrigger) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CompoundTrigger)
+	if !ok {
+		that2, ok := that.(CompoundTrigger)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Operator != that1.Operator {
+		return false
+	}
+	if len(this.Conditions) != len(that1.Conditions) {
+		return false
+	}
+	for i := range this.Conditions {
+		if !this.Conditions[i].Equal(that1.Conditions[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *Report) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Report)
+	if !ok {
+		that2, ok := that.(Report)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Metadata.Equal(&that1.Metadata) {
+		return false
+	}
+	if !this.Status.Equal(&that1.Status) {
+		return false
+	}
+	if !this.Experiment.Equal(that1.Experiment) {
+		return false
+	}
+	if len(this.FailureConditionHistory) != len(that1.FailureConditionHistory) {
+		return false
+	}
+	for i := range this.FailureConditionHistory {
+		if !this.FailureConditionHistory[i].Equal(that1.FailureConditionHistory[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *Report_FailureConditionSnapshot) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Report_FailureConditionSnapshot)
+	if !ok {
+		that2, ok := that.(Report_FailureConditionSnapshot)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
+	if !this.Timestamp.Equal(that1.Timestamp) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
 }
-
-func (this *Experiment) Equal(that interface{}) bool {
+func (this *Report_FailureConditionHistory) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Experiment)
+	that1, ok := that.(*Report_FailureConditionHistory)
 	if !ok {
-		that2, ok := that.(Experiment)
+		that2, ok := that.(Report_FailureConditionHistory)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1008,16 +3965,18 @@ func (this *Experiment) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.Metadata.Equal(&that1.Metadata) {
+	if this.FailureConditionName != that1.FailureConditionName {
 		return false
 	}
-	if !this.Status.Equal(&that1.Status) {
+	if len(this.FailureConditionSnapshots) != len(that1.FailureConditionSnapshots) {
 		return false
 	}
-	if !this.Spec.Equal(that1.Spec) {
-		return false
+	for i := range this.FailureConditionSnapshots {
+		if !this.FailureConditionSnapshots[i].Equal(that1.FailureConditionSnapshots[i]) {
+			return false
+		}
 	}
-	if !this.Result.Equal(&that1.Result) {
+	if this.ErrorBudgetConsumed != that1.ErrorBudgetConsumed {
 		return false
 	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
@@ -1025,14 +3984,14 @@ func (this *Experiment) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *ExperimentResult) Equal(that interface{}) bool {
+func (this *ReportSink) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ExperimentResult)
+	that1, ok := that.(*ReportSink)
 	if !ok {
-		that2, ok := that.(ExperimentResult)
+		that2, ok := that.(ReportSink)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1044,21 +4003,22 @@ func (this *ExperimentResult) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.State != that1.State {
+	if !this.Metadata.Equal(&that1.Metadata) {
 		return false
 	}
-	if len(this.FailureReport) != len(that1.FailureReport) {
+	if !this.Status.Equal(&that1.Status) {
 		return false
 	}
-	for i := range this.FailureReport {
-		if this.FailureReport[i] != that1.FailureReport[i] {
+	if that1.SinkType == nil {
+		if this.SinkType != nil {
 			return false
 		}
-	}
-	if !this.TimeStarted.Equal(that1.TimeStarted) {
+	} else if this.SinkType == nil {
+		return false
+	} else if !this.SinkType.Equal(that1.SinkType) {
 		return false
 	}
-	if !this.TimeFinished.Equal(that1.TimeFinished) {
+	if this.FailuresOnly != that1.FailuresOnly {
 		return false
 	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
@@ -1066,14 +4026,14 @@ func (this *ExperimentResult) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *ExperimentSpec) Equal(that interface{}) bool {
+func (this *ReportSink_Webhook) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ExperimentSpec)
+	that1, ok := that.(*ReportSink_Webhook)
 	if !ok {
-		that2, ok := that.(ExperimentSpec)
+		that2, ok := that.(ReportSink_Webhook)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1085,47 +4045,67 @@ func (this *ExperimentSpec) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.Faults) != len(that1.Faults) {
+	if !this.Webhook.Equal(that1.Webhook) {
 		return false
 	}
-	for i := range this.Faults {
-		if !this.Faults[i].Equal(that1.Faults[i]) {
+	return true
+}
+func (this *ReportSink_Slack) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ReportSink_Slack)
+	if !ok {
+		that2, ok := that.(ReportSink_Slack)
+		if ok {
+			that1 = &that2
+		} else {
 			return false
 		}
 	}
-	if len(this.FailureConditions) != len(that1.FailureConditions) {
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
-	for i := range this.FailureConditions {
-		if !this.FailureConditions[i].Equal(that1.FailureConditions[i]) {
-			return false
-		}
+	if !this.Slack.Equal(that1.Slack) {
+		return false
 	}
-	if this.Duration != nil && that1.Duration != nil {
-		if *this.Duration != *that1.Duration {
+	return true
+}
+func (this *ReportSink_File) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ReportSink_File)
+	if !ok {
+		that2, ok := that.(ReportSink_File)
+		if ok {
+			that1 = &that2
+		} else {
 			return false
 		}
-	} else if this.Duration != nil {
-		return false
-	} else if that1.Duration != nil {
-		return false
 	}
-	if !this.TargetMesh.Equal(that1.TargetMesh) {
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+	if !this.File.Equal(that1.File) {
 		return false
 	}
 	return true
 }
-func (this *ExperimentSpec_InjectedFault) Equal(that interface{}) bool {
+func (this *ReportSink_WebhookSink) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ExperimentSpec_InjectedFault)
+	that1, ok := that.(*ReportSink_WebhookSink)
 	if !ok {
-		that2, ok := that.(ExperimentSpec_InjectedFault)
+		that2, ok := that.(ReportSink_WebhookSink)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1137,38 +4117,30 @@ func (this *ExperimentSpec_InjectedFault) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.OriginServices) != len(that1.OriginServices) {
+	if this.Url != that1.Url {
 		return false
 	}
-	for i := range this.OriginServices {
-		if !this.OriginServices[i].Equal(that1.OriginServices[i]) {
-			return false
-		}
-	}
-	if len(this.DestinationServices) != len(that1.DestinationServices) {
+	if len(this.Headers) != len(that1.Headers) {
 		return false
 	}
-	for i := range this.DestinationServices {
-		if !this.DestinationServices[i].Equal(that1.DestinationServices[i]) {
+	for i := range this.Headers {
+		if this.Headers[i] != that1.Headers[i] {
 			return false
 		}
 	}
-	if !this.Fault.Equal(that1.Fault) {
-		return false
-	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
 	return true
 }
-func (this *FailureCondition) Equal(that interface{}) bool {
+func (this *ReportSink_SlackSink) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*FailureCondition)
+	that1, ok := that.(*ReportSink_SlackSink)
 	if !ok {
-		that2, ok := that.(FailureCondition)
+		that2, ok := that.(ReportSink_SlackSink)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1180,10 +4152,10 @@ func (this *FailureCondition) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Name != that1.Name {
+	if this.WebhookUrl != that1.WebhookUrl {
 		return false
 	}
-	if !this.Trigger.Equal(that1.Trigger) {
+	if this.Channel != that1.Channel {
 		return false
 	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
@@ -1191,14 +4163,14 @@ func (this *FailureCondition) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *FailureCondition_Trigger) Equal(that interface{}) bool {
+func (this *ReportSink_FileSink) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*FailureCondition_Trigger)
+	that1, ok := that.(*ReportSink_FileSink)
 	if !ok {
-		that2, ok := that.(FailureCondition_Trigger)
+		that2, ok := that.(ReportSink_FileSink)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1210,13 +4182,7 @@ func (this *FailureCondition_Trigger) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if that1.FailureTrigger == nil {
-		if this.FailureTrigger != nil {
-			return false
-		}
-	} else if this.FailureTrigger == nil {
-		return false
-	} else if !this.FailureTrigger.Equal(that1.FailureTrigger) {
+	if this.Directory != that1.Directory {
 		return false
 	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
@@ -1224,14 +4190,14 @@ func (this *FailureCondition_Trigger) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *FailureCondition_Trigger_WebhookUrl) Equal(that interface{}) bool {
+func (this *ExperimentTemplate) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*FailureCondition_Trigger_WebhookUrl)
+	that1, ok := that.(*ExperimentTemplate)
 	if !ok {
-		that2, ok := that.(FailureCondition_Trigger_WebhookUrl)
+		that2, ok := that.(ExperimentTemplate)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1243,19 +4209,36 @@ func (this *FailureCondition_Trigger_WebhookUrl) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.WebhookUrl != that1.WebhookUrl {
+	if !this.Metadata.Equal(&that1.Metadata) {
+		return false
+	}
+	if !this.Status.Equal(&that1.Status) {
+		return false
+	}
+	if len(this.Parameters) != len(that1.Parameters) {
+		return false
+	}
+	for i := range this.Parameters {
+		if !this.Parameters[i].Equal(that1.Parameters[i]) {
+			return false
+		}
+	}
+	if this.Spec != that1.Spec {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
 	return true
 }
-func (this *FailureCondition_Trigger_Prometheus) Equal(that interface{}) bool {
+func (this *ExperimentTemplate_Parameter) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*FailureCondition_Trigger_Prometheus)
+	that1, ok := that.(*ExperimentTemplate_Parameter)
 	if !ok {
-		that2, ok := that.(FailureCondition_Trigger_Prometheus)
+		that2, ok := that.(ExperimentTemplate_Parameter)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1267,19 +4250,34 @@ func (this *FailureCondition_Trigger_Prometheus) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.Prometheus.Equal(that1.Prometheus) {
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if this.Required != that1.Required {
+		return false
+	}
+	if this.Default != that1.Default {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
 	return true
 }
-func (this *PrometheusTrigger) Equal(that interface{}) bool {
+func (this *ExperimentSuite) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*PrometheusTrigger)
+	that1, ok := that.(*ExperimentSuite)
 	if !ok {
-		that2, ok := that.(PrometheusTrigger)
+		that2, ok := that.(ExperimentSuite)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1291,19 +4289,33 @@ func (this *PrometheusTrigger) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if that1.QueryType == nil {
-		if this.QueryType != nil {
+	if !this.Metadata.Equal(&that1.Metadata) {
+		return false
+	}
+	if !this.Status.Equal(&that1.Status) {
+		return false
+	}
+	if len(this.Experiments) != len(that1.Experiments) {
+		return false
+	}
+	for i := range this.Experiments {
+		if !this.Experiments[i].Equal(that1.Experiments[i]) {
 			return false
 		}
-	} else if this.QueryType == nil {
-		return false
-	} else if !this.QueryType.Equal(that1.QueryType) {
+	}
+	if this.Mode != that1.Mode {
 		return false
 	}
-	if this.ThresholdValue != that1.ThresholdValue {
+	if this.CoolDown != nil && that1.CoolDown != nil {
+		if *this.CoolDown != *that1.CoolDown {
+			return false
+		}
+	} else if this.CoolDown != nil {
+		return false
+	} else if that1.CoolDown != nil {
 		return false
 	}
-	if this.ComparisonOperator != that1.ComparisonOperator {
+	if !this.Result.Equal(&that1.Result) {
 		return false
 	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
@@ -1311,14 +4323,14 @@ func (this *PrometheusTrigger) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *PrometheusTrigger_CustomQuery) Equal(that interface{}) bool {
+func (this *ExperimentSuite_Entry) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*PrometheusTrigger_CustomQuery)
+	that1, ok := that.(*ExperimentSuite_Entry)
 	if !ok {
-		that2, ok := that.(PrometheusTrigger_CustomQuery)
+		that2, ok := that.(ExperimentSuite_Entry)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1330,19 +4342,31 @@ func (this *PrometheusTrigger_CustomQuery) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.CustomQuery != that1.CustomQuery {
+	if this.Name != that1.Name {
+		return false
+	}
+	if that1.Source == nil {
+		if this.Source != nil {
+			return false
+		}
+	} else if this.Source == nil {
+		return false
+	} else if !this.Source.Equal(that1.Source) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
 	return true
 }
-func (this *PrometheusTrigger_SuccessRate) Equal(that interface{}) bool {
+func (this *ExperimentSuite_Entry_Spec) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*PrometheusTrigger_SuccessRate)
+	that1, ok := that.(*ExperimentSuite_Entry_Spec)
 	if !ok {
-		that2, ok := that.(PrometheusTrigger_SuccessRate)
+		that2, ok := that.(ExperimentSuite_Entry_Spec)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1354,19 +4378,19 @@ func (this *PrometheusTrigger_SuccessRate) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.SuccessRate.Equal(that1.SuccessRate) {
+	if !this.Spec.Equal(that1.Spec) {
 		return false
 	}
 	return true
 }
-func (this *PrometheusTrigger_SuccessRateQuery) Equal(that interface{}) bool {
+func (this *ExperimentSuite_Entry_Template) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*PrometheusTrigger_SuccessRateQuery)
+	that1, ok := that.(*ExperimentSuite_Entry_Template)
 	if !ok {
-		that2, ok := that.(PrometheusTrigger_SuccessRateQuery)
+		that2, ok := that.(ExperimentSuite_Entry_Template)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1378,31 +4402,19 @@ func (this *PrometheusTrigger_SuccessRateQuery) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.Service.Equal(that1.Service) {
-		return false
-	}
-	if this.Interval != nil && that1.Interval != nil {
-		if *this.Interval != *that1.Interval {
-			return false
-		}
-	} else if this.Interval != nil {
-		return false
-	} else if that1.Interval != nil {
-		return false
-	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+	if !this.Template.Equal(that1.Template) {
 		return false
 	}
 	return true
 }
-func (this *Report) Equal(that interface{}) bool {
+func (this *ExperimentSuite_TemplateRef) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Report)
+	that1, ok := that.(*ExperimentSuite_TemplateRef)
 	if !ok {
-		that2, ok := that.(Report)
+		that2, ok := that.(ExperimentSuite_TemplateRef)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1414,20 +4426,14 @@ func (this *Report) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.Metadata.Equal(&that1.Metadata) {
-		return false
-	}
-	if !this.Status.Equal(&that1.Status) {
-		return false
-	}
-	if !this.Experiment.Equal(that1.Experiment) {
+	if !this.Template.Equal(&that1.Template) {
 		return false
 	}
-	if len(this.FailureConditionHistory) != len(that1.FailureConditionHistory) {
+	if len(this.Values) != len(that1.Values) {
 		return false
 	}
-	for i := range this.FailureConditionHistory {
-		if !this.FailureConditionHistory[i].Equal(that1.FailureConditionHistory[i]) {
+	for i := range this.Values {
+		if this.Values[i] != that1.Values[i] {
 			return false
 		}
 	}
@@ -1436,14 +4442,14 @@ func (this *Report) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *Report_FailureConditionSnapshot) Equal(that interface{}) bool {
+func (this *SuiteResult) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Report_FailureConditionSnapshot)
+	that1, ok := that.(*SuiteResult)
 	if !ok {
-		that2, ok := that.(Report_FailureConditionSnapshot)
+		that2, ok := that.(SuiteResult)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1455,25 +4461,42 @@ func (this *Report_FailureConditionSnapshot) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Value != that1.Value {
+	if this.State != that1.State {
 		return false
 	}
-	if !this.Timestamp.Equal(that1.Timestamp) {
+	if this.RunId != that1.RunId {
+		return false
+	}
+	if !this.TimeStarted.Equal(that1.TimeStarted) {
+		return false
+	}
+	if !this.TimeFinished.Equal(that1.TimeFinished) {
+		return false
+	}
+	if this.Message != that1.Message {
+		return false
+	}
+	if len(this.Experiments) != len(that1.Experiments) {
 		return false
 	}
+	for i := range this.Experiments {
+		if !this.Experiments[i].Equal(that1.Experiments[i]) {
+			return false
+		}
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
 	return true
 }
-func (this *Report_FailureConditionHistory) Equal(that interface{}) bool {
+func (this *SuiteResult_EntryResult) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Report_FailureConditionHistory)
+	that1, ok := that.(*SuiteResult_EntryResult)
 	if !ok {
-		that2, ok := that.(Report_FailureConditionHistory)
+		that2, ok := that.(SuiteResult_EntryResult)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1485,16 +4508,23 @@ func (this *Report_FailureConditionHistory) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.FailureConditionName != that1.FailureConditionName {
+	if this.Name != that1.Name {
 		return false
 	}
-	if len(this.FailureConditionSnapshots) != len(that1.FailureConditionSnapshots) {
+	if !this.Experiment.Equal(that1.Experiment) {
 		return false
 	}
-	for i := range this.FailureConditionSnapshots {
-		if !this.FailureConditionSnapshots[i].Equal(that1.FailureConditionSnapshots[i]) {
-			return false
-		}
+	if this.State != that1.State {
+		return false
+	}
+	if !this.TimeStarted.Equal(that1.TimeStarted) {
+		return false
+	}
+	if !this.TimeFinished.Equal(that1.TimeFinished) {
+		return false
+	}
+	if this.Message != that1.Message {
+		return false
 	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
@@ -3,21 +3,27 @@ package checker
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/solo-io/glooshot/pkg/checker/metrics"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
 
 	"go.uber.org/zap"
 
 	v1 "github.com/solo-io/glooshot/pkg/api/v1"
+	"github.com/solo-io/glooshot/pkg/lifecycle"
 	"github.com/solo-io/glooshot/pkg/promquery"
+	"github.com/solo-io/glooshot/pkg/resultreporter"
+	"github.com/solo-io/glooshot/pkg/utils"
 	"github.com/solo-io/go-utils/contextutils"
 	"github.com/solo-io/go-utils/errors"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	sgv1 "github.com/solo-io/supergloo/pkg/api/v1"
 )
 
 type ExperimentChecker interface {
@@ -28,19 +34,27 @@ type checker struct {
 	promCache            promquery.QueryPubSub
 	experiments          v1.ExperimentClient
 	reports              v1.ReportClient
+	meshes               sgv1.MeshClient
+	meshNamespace        string
+	resultReporter       resultreporter.Reporter
+	observer             lifecycle.Observer
 	queryResultHistories map[string]*v1.Report_FailureConditionHistory
 	snapshotLock         sync.RWMutex
 }
 
-func NewChecker(queries promquery.QueryPubSub, experiments v1.ExperimentClient, reports v1.ReportClient) *checker {
+// resultReporter is optional, if provided it is used to deliver the result of each experiment once it concludes
+// observer is optional, if provided it is notified of breached failure conditions and concluded experiments
+func NewChecker(queries promquery.QueryPubSub, experiments v1.ExperimentClient, reports v1.ReportClient, meshes sgv1.MeshClient, meshNamespace string, resultReporter resultreporter.Reporter, observer lifecycle.Observer) *checker {
 	return &checker{promCache: queries,
 		experiments:          experiments,
 		reports:              reports,
+		meshes:               meshes,
+		meshNamespace:        meshNamespace,
+		resultReporter:       resultReporter,
+		observer:             lifecycle.OrNoop(observer),
 		queryResultHistories: make(map[string]*v1.Report_FailureConditionHistory)}
 }
 
-var defaultDuration = time.Minute * 10
-
 type failureReport map[string]string
 
 // actively track the failure conditions for an experiment
@@ -59,37 +73,40 @@ func (c *checker) MonitorExperiment(ctx context.Context, experiment *v1.Experime
 	if experiment.Spec == nil {
 		logger.Infof("short-circuiting monitor, experiment %v does not specify a failure condition", experiment.Metadata.Ref())
 		return c.reportResult(ctx, experiment.Metadata.Ref(), failureReport{
-			"failure_type": "invalid_config",
-			"message":      "no failure conditions specified",
+			utils.FailureReportKeyType: "invalid_config",
+			"message":                  "no failure conditions specified",
 		})
 	}
 	logger.Infof("beginning monitoring of experiment %v", experiment.Metadata.Ref())
+	var templates metrics.Templates
+	getTemplates := func() metrics.Templates {
+		if templates == nil {
+			templates = c.queryTemplates(ctx, experiment)
+		}
+		return templates
+	}
+	totalDuration := utils.ExperimentDuration(experiment)
 	for _, fc := range experiment.Spec.FailureConditions {
-		switch trigger := fc.Trigger.FailureTrigger.(type) {
-		case *v1.FailureCondition_Trigger_Prometheus:
-			queryString, comparisonOperator, threshold, err := getPromQuerySpecs(trigger.Prometheus)
+		fc := fc
+		switch fc.Trigger.FailureTrigger.(type) {
+		case *v1.FailureCondition_Trigger_Prometheus, *v1.FailureCondition_Trigger_ErrorBudget:
+			watch, logField, err := c.leafWatch(ctx, fc, getTemplates, totalDuration)
 			if err != nil {
 				return err
 			}
 
-			go func() {
-				failure, err := c.pollUntilFailure(ctx, fc.Name, promquery.Query(queryString), comparisonOperator, threshold)
-				if err != nil {
-					logger.Errorw("failure while polling prometheus", zap.Error(err), zap.String("query", queryString))
-					return
-				}
-
-				if failure == nil {
-					logger.Debug("polling cancelled")
-					return
-				}
-
-				select {
-				case <-ctx.Done():
-					return
-				case firstFailure <- failure:
-				}
-			}()
+			go c.sendFirstFailure(ctx, firstFailure, fc.Name, logField, func() (failureReport, error) {
+				return pollUntilFailure(watch)
+			})
+		case *v1.FailureCondition_Trigger_Compound:
+			leaves := make(map[string]conditionWatch)
+			if err := c.compoundLeafWatches(ctx, fc, getTemplates, totalDuration, leaves); err != nil {
+				return err
+			}
+
+			go c.sendFirstFailure(ctx, firstFailure, fc.Name, zap.String("condition", fc.Name), func() (failureReport, error) {
+				return pollCompoundUntilFailure(ctx, fc, leaves)
+			})
 		}
 	}
 
@@ -98,54 +115,197 @@ func (c *checker) MonitorExperiment(ctx context.Context, experiment *v1.Experime
 		return err
 	}
 
+	// publish the measurements while the experiment runs, so its progress can be followed
+	// stopped before the final report is written so the writes do not conflict
+	progressCtx, stopProgress := context.WithCancel(ctx)
+	progressDone := make(chan struct{})
+	go func() {
+		defer close(progressDone)
+		c.publishProgress(progressCtx, experiment)
+	}()
+	defer func() {
+		stopProgress()
+		<-progressDone
+	}()
+
 	var report failureReport
 	select {
 	case <-ctx.Done():
 		return nil
 	case failure := <-firstFailure:
 		report = failure
+		event := lifecycle.NewEvent(lifecycle.ExperimentConditionBreached, experiment,
+			fmt.Sprintf("failure condition breached: %v", failure[utils.FailureReportKeyType]))
+		event.Details = failure
+		c.observer.Observe(ctx, event)
 	case <-time.After(experimentDuration):
 		// nil report means experiment passed
 	}
+	stopProgress()
+	<-progressDone
 	return c.reportResult(ctx, experiment.Metadata.Ref(), report)
 }
 
-func getPromQuerySpecs(promTrigger *v1.PrometheusTrigger) (string, string, float64, error) {
-	comparisonOperator := promTrigger.ComparisonOperator
-	if comparisonOperator == "" {
-		comparisonOperator = "<"
+// polls until a failure occurs, then sends it unless monitoring was cancelled in the meantime
+// the failure is attributed to the named failure condition
+func (c *checker) sendFirstFailure(ctx context.Context, firstFailure chan<- failureReport, fcName string, logField zap.Field, poll func() (failureReport, error)) {
+	logger := contextutils.LoggerFrom(ctx)
+	failure, err := poll()
+	if err != nil {
+		logger.Errorw("failure while polling prometheus", zap.Error(err), logField)
+		return
 	}
-	var queryString string
-	switch query := promTrigger.QueryType.(type) {
-	case *v1.PrometheusTrigger_SuccessRate:
-		var err error
-		queryString, err = generateQuery(query.SuccessRate)
+
+	if failure == nil {
+		logger.Debug("polling cancelled")
+		return
+	}
+	if fcName != "" {
+		failure[utils.FailureReportKeyCondition] = fcName
+	}
+
+	select {
+	case <-ctx.Done():
+		return
+	case firstFailure <- failure:
+	}
+}
+
+// the query templates depend on the type of mesh the experiment targets
+// istio templates are used if the mesh cannot be determined
+func (c *checker) queryTemplates(ctx context.Context, experiment *v1.Experiment) metrics.Templates {
+	if c.meshes == nil {
+		return metrics.IstioTemplates
+	}
+	mesh, err := utils.GetTargetMesh(ctx, c.meshes, c.meshNamespace, experiment.Spec.TargetMesh)
+	if err != nil {
+		contextutils.LoggerFrom(ctx).Warnw("could not determine target mesh, defaulting to istio query templates",
+			zap.Error(err),
+			"experiment", experiment.Metadata.Ref())
+		return metrics.IstioTemplates
+	}
+	return metrics.TemplatesForMesh(mesh)
+}
+
+// invoked with the evaluation of a failure condition on every new sample
+// returning true stops the watch
+type sampleHandler func(met bool, report failureReport) bool
+
+// watches a failure condition until the handler returns true or the context is cancelled
+type conditionWatch func(handle sampleHandler) error
+
+// returns the watch for a failure condition with a prometheus or error budget trigger
+func (c *checker) leafWatch(ctx context.Context, fc *v1.FailureCondition, getTemplates func() metrics.Templates, experimentDuration time.Duration) (conditionWatch, zap.Field, error) {
+	if fc.Trigger == nil {
+		return nil, zap.Skip(), errors.Errorf("failure condition %v has no trigger", fc.Name)
+	}
+	switch trigger := fc.Trigger.FailureTrigger.(type) {
+	case *v1.FailureCondition_Trigger_Prometheus:
+		var templates metrics.Templates
+		if _, custom := trigger.Prometheus.QueryType.(*v1.PrometheusTrigger_CustomQuery); !custom {
+			templates = getTemplates()
+		}
+		queryString, comparison, err := getPromQuerySpecs(trigger.Prometheus, templates)
 		if err != nil {
-			return "", "", 0, errors.Wrapf(err, "invalid success rate query params")
+			return nil, zap.Skip(), err
 		}
+		return func(handle sampleHandler) error {
+			return c.watchThreshold(ctx, fc.Name, promquery.Query(queryString), comparison, handle)
+		}, zap.String("query", queryString), nil
+	case *v1.FailureCondition_Trigger_ErrorBudget:
+		spec, err := getErrorBudgetSpec(trigger.ErrorBudget, getTemplates())
+		if err != nil {
+			return nil, zap.Skip(), err
+		}
+		return func(handle sampleHandler) error {
+			return c.watchErrorBudget(ctx, fc.Name, spec, experimentDuration, handle)
+		}, zap.String("query", string(spec.shortQuery)), nil
+	}
+	return nil, zap.Skip(), errors.Errorf("unsupported trigger type %T for failure condition %v", fc.Trigger.FailureTrigger, fc.Name)
+}
+
+func getPromQuerySpecs(promTrigger *v1.PrometheusTrigger, templates metrics.Templates) (string, *Comparison, error) {
+	comparison, err := GetComparison(promTrigger)
+	if err != nil {
+		return "", nil, err
+	}
+	queryString, err := generateQuery(promTrigger, templates)
+	if err != nil {
+		return "", nil, err
+	}
+	return queryString, comparison, nil
+}
+
+var defaultInterval = time.Minute
+var defaultPercentile = 99.0
+
+func generateQuery(promTrigger *v1.PrometheusTrigger, templates metrics.Templates) (string, error) {
+	switch query := promTrigger.QueryType.(type) {
 	case *v1.PrometheusTrigger_CustomQuery:
-		queryString = query.CustomQuery
+		return query.CustomQuery, nil
+	case *v1.PrometheusTrigger_SuccessRate:
+		if err := validateService(query.SuccessRate.Service); err != nil {
+			return "", errors.Wrapf(err, "invalid success rate query params")
+		}
+		svc := query.SuccessRate.Service
+		return templates.SuccessRate(svc.Namespace, svc.Name, intervalOrDefault(query.SuccessRate.Interval)), nil
+	case *v1.PrometheusTrigger_LatencyPercentile:
+		if err := validateService(query.LatencyPercentile.Service); err != nil {
+			return "", errors.Wrapf(err, "invalid latency percentile query params")
+		}
+		percentile := defaultPercentile
+		if query.LatencyPercentile.Percentile != 0 {
+			percentile = query.LatencyPercentile.Percentile
+		}
+		if percentile <= 0 || percentile >= 100 {
+			return "", errors.Errorf("invalid latency percentile query params: percentile must be between 0 and 100, got %v", percentile)
+		}
+		svc := query.LatencyPercentile.Service
+		return templates.LatencyPercentile(svc.Namespace, svc.Name, percentile, intervalOrDefault(query.LatencyPercentile.Interval)), nil
+	case *v1.PrometheusTrigger_RequestVolume:
+		if err := validateService(query.RequestVolume.Service); err != nil {
+			return "", errors.Wrapf(err, "invalid request volume query params")
+		}
+		svc := query.RequestVolume.Service
+		return templates.RequestVolume(svc.Namespace, svc.Name, intervalOrDefault(query.RequestVolume.Interval)), nil
+	case *v1.PrometheusTrigger_ErrorRate:
+		if err := validateService(query.ErrorRate.Service); err != nil {
+			return "", errors.Wrapf(err, "invalid error rate query params")
+		}
+		svc := query.ErrorRate.Service
+		return templates.ErrorRate(svc.Namespace, svc.Name, intervalOrDefault(query.ErrorRate.Interval)), nil
+	case *v1.PrometheusTrigger_TcpConnectionErrors:
+		if err := validateService(query.TcpConnectionErrors.Service); err != nil {
+			return "", errors.Wrapf(err, "invalid tcp connection errors query params")
+		}
+		svc := query.TcpConnectionErrors.Service
+		return templates.TcpConnectionErrors(svc.Namespace, svc.Name, intervalOrDefault(query.TcpConnectionErrors.Interval)), nil
+	case *v1.PrometheusTrigger_ContainerRestarts:
+		if err := validateService(query.ContainerRestarts.Service); err != nil {
+			return "", errors.Wrapf(err, "invalid container restarts query params")
+		}
+		svc := query.ContainerRestarts.Service
+		return metrics.ContainerRestartsQuery(svc.Namespace, svc.Name, intervalOrDefault(query.ContainerRestarts.Interval)), nil
 	}
-	threshold := promTrigger.ThresholdValue
-	return queryString, comparisonOperator, threshold, nil
+	return "", errors.Errorf("no query specified for prometheus trigger")
 }
 
-func generateQuery(query *v1.PrometheusTrigger_SuccessRateQuery) (string, error) {
-	if query.Service == nil {
-		return "", errors.Errorf("service cannot be nil")
+func validateService(service *core.ResourceRef) error {
+	if service == nil {
+		return errors.Errorf("service cannot be nil")
 	}
-	interval := time.Minute
-	if query.Interval != nil {
-		interval = *query.Interval
+	return nil
+}
+
+func intervalOrDefault(interval *time.Duration) time.Duration {
+	if interval == nil {
+		return defaultInterval
 	}
-	return metrics.IstioSuccessRateQuery(query.Service.Namespace, query.Service.Name, interval), nil
+	return *interval
 }
 
 func getRemainingDuration(experiment *v1.Experiment) (time.Duration, error) {
-	experimentDuration := defaultDuration
-	if experiment.Spec.Duration != nil {
-		experimentDuration = *experiment.Spec.Duration
-	}
+	experimentDuration := utils.ExperimentDuration(experiment)
 
 	// need to calculate the remaining duration in the event glooshot
 	// was restarted during an experiment
@@ -161,44 +321,38 @@ func getRemainingDuration(experiment *v1.Experiment) (time.Duration, error) {
 	return experimentDuration - elapsedTime, nil
 }
 
-func (c *checker) pollUntilFailure(ctx context.Context, fcName string, query promquery.Query, comparisonOperator string, threshold float64) (failureReport, error) {
+// watches the condition until it is met, returns a nil report if the watch was cancelled first
+func pollUntilFailure(watch conditionWatch) (failureReport, error) {
+	var failure failureReport
+	err := watch(func(met bool, report failureReport) bool {
+		if met {
+			failure = report
+		}
+		return met
+	})
+	return failure, err
+}
+
+func (c *checker) watchThreshold(ctx context.Context, fcName string, query promquery.Query, comparison *Comparison, handle sampleHandler) error {
 	values := c.promCache.Subscribe(query)
 	defer c.promCache.Unsubscribe(query, values)
 	for {
 		select {
 		case <-ctx.Done():
 			// context cancelled, gracefully shut down
-			return nil, nil
+			return nil
 		case val, ok := <-values:
 			if !ok {
-				return nil, errors.Errorf("unexpected close of query subscription")
+				return errors.Errorf("unexpected close of query subscription")
 			}
 			c.storeQueryValue(fcName, val)
-			if exceededThreshold(float64(val), threshold, comparisonOperator) {
-				return failureReport{
-					"failure_type":        "value_exceeded_threshold",
-					"value":               fmt.Sprintf("%v", val),
-					"threshold":           fmt.Sprintf("%v", threshold),
-					"comparison_operator": comparisonOperator,
-				}, nil
+			if handle(comparison.Met(float64(val)), comparison.report(float64(val))) {
+				return nil
 			}
 		}
 	}
 }
 
-func exceededThreshold(val, threshold float64, comparisonOperator string) bool {
-	switch comparisonOperator {
-	case ">":
-		return val > threshold
-	case ">=":
-		return val >= threshold
-	case "<=":
-		return val <= threshold
-
-	}
-	return val < threshold
-}
-
 func (c *checker) reportResult(ctx context.Context, targetExperiment core.ResourceRef, report failureReport) error {
 	experiment, err := c.experiments.Read(targetExperiment.Namespace, targetExperiment.Name, clients.ReadOpts{Ctx: ctx})
 	if err != nil {
@@ -223,15 +377,25 @@ func (c *checker) reportResult(ctx context.Context, targetExperiment core.Resour
 	}
 
 	contextutils.LoggerFrom(ctx).Infow("reported experiment result", zap.Any("result", experiment.Result))
+	c.observer.Observe(ctx, lifecycle.NewEvent(lifecycle.ExperimentConcluded, experiment,
+		fmt.Sprintf("experiment %v", strings.ToLower(experiment.Result.State.String()))))
 
 	// just log reporting errors, don't propagate
-	reportErr := c.produceReport(ctx, experiment)
+	written, reportErr := c.produceReport(ctx, experiment)
 	if reportErr != nil {
 		contextutils.LoggerFrom(ctx).Warnw("error while producing report",
-			zap.Error(err),
+			zap.Error(reportErr),
 			"experiment", experiment.Metadata.Name,
 			"namespace", experiment.Metadata.Namespace)
 	}
+	if c.resultReporter != nil {
+		if err := c.resultReporter.ReportResult(ctx, experiment, written); err != nil {
+			contextutils.LoggerFrom(ctx).Warnw("error while delivering result to report sinks",
+				zap.Error(err),
+				"experiment", experiment.Metadata.Name,
+				"namespace", experiment.Metadata.Namespace)
+		}
+	}
 	return nil
 }
 
@@ -256,22 +420,49 @@ func (c *checker) storeQueryValue(fcName string, val promquery.Result) {
 	c.queryResultHistories[fcName] = history
 }
 
-func (c *checker) produceReport(ctx context.Context, exp *v1.Experiment) error {
+func (c *checker) storeErrorBudgetConsumed(fcName string, consumed float64) {
+	c.snapshotLock.Lock()
+	defer c.snapshotLock.Unlock()
+	history, ok := c.queryResultHistories[fcName]
+	if !ok {
+		history = &v1.Report_FailureConditionHistory{
+			FailureConditionName: fcName,
+		}
+	}
+	history.ErrorBudgetConsumed = consumed
+	c.queryResultHistories[fcName] = history
+}
+
+func (c *checker) produceReport(ctx context.Context, exp *v1.Experiment) (*v1.Report, error) {
+	return c.writeReport(ctx, c.currentReport(ctx, exp, true))
+}
+
+// the report of the measurements taken so far
+// warnMissing logs a warning for each failure condition which has not been measured yet
+func (c *checker) currentReport(ctx context.Context, exp *v1.Experiment, warnMissing bool) *v1.Report {
 	c.snapshotLock.Lock()
 	defer c.snapshotLock.Unlock()
 	var histories []*v1.Report_FailureConditionHistory
 	if exp.Spec != nil {
-		for _, fc := range exp.Spec.FailureConditions {
+		for _, fc := range utils.FlattenFailureConditions(exp.Spec.FailureConditions) {
+			if utils.CompoundTrigger(fc) != nil {
+				// compound conditions have no measurements of their own
+				continue
+			}
 			fcHistory, ok := c.queryResultHistories[fc.Name]
-			if !ok {
+			if !ok && warnMissing {
 				contextutils.LoggerFrom(ctx).Warnw("no measurement history for failure condition found",
 					"failureCondition", fc.Name)
 			}
+			if ok {
+				// copied, the watches keep appending to the history while the report is written
+				fcHistory = proto.Clone(fcHistory).(*v1.Report_FailureConditionHistory)
+			}
 			histories = append(histories, fcHistory)
 		}
 	}
 	expRef := exp.Metadata.Ref()
-	report := &v1.Report{
+	return &v1.Report{
 		Metadata: core.Metadata{
 			Namespace: exp.Metadata.Namespace,
 			Name:      exp.Metadata.Name,
@@ -279,7 +470,33 @@ func (c *checker) produceReport(ctx context.Context, exp *v1.Experiment) error {
 		Experiment:              &expRef,
 		FailureConditionHistory: histories,
 	}
-	_, err := c.reports.Write(report, clients.WriteOpts{})
+}
+
+// writes the report, replacing the report of a previous run or the progress published while the experiment ran
+func (c *checker) writeReport(ctx context.Context, report *v1.Report) (*v1.Report, error) {
+	existing, err := c.reports.Read(report.Metadata.Namespace, report.Metadata.Name, clients.ReadOpts{Ctx: ctx})
+	if err == nil {
+		report.Metadata.ResourceVersion = existing.Metadata.ResourceVersion
+	}
+	return c.reports.Write(report, clients.WriteOpts{Ctx: ctx, OverwriteExisting: true})
+}
+
+var progressInterval = time.Second * 15
 
-	return err
+// periodically writes the measurements taken so far to the report of the experiment until the context is cancelled
+func (c *checker) publishProgress(ctx context.Context, exp *v1.Experiment) {
+	ticker := time.NewTicker(progressInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := c.writeReport(ctx, c.currentReport(ctx, exp, false)); err != nil {
+				contextutils.LoggerFrom(ctx).Debugw("could not publish experiment progress",
+					zap.Error(err),
+					"experiment", exp.Metadata.Ref())
+			}
+		}
+	}
 }
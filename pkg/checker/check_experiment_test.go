@@ -2,6 +2,9 @@ package checker_test
 
 import (
 	"context"
+	"math"
+	"strings"
+	"sync/atomic"
 	"time"
 
 	. "github.com/onsi/ginkgo"
@@ -12,6 +15,8 @@ import (
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	sgv1 "github.com/solo-io/supergloo/pkg/api/v1"
 
 	. "github.com/solo-io/glooshot/pkg/checker"
 )
@@ -20,6 +25,7 @@ var _ = Describe("CheckExperiment", func() {
 	var (
 		experiments v1.ExperimentClient
 		reports     v1.ReportClient
+		meshes      sgv1.MeshClient
 		checker     ExperimentChecker
 		prom        *mockPromClient
 		q1, q2      = "physics1", "astronomy2"
@@ -31,7 +37,9 @@ var _ = Describe("CheckExperiment", func() {
 		experiments, err = v1.NewExperimentClient(&factory.MemoryResourceClientFactory{Cache: memory.NewInMemoryResourceCache()})
 		reports, err = v1.NewReportClient(&factory.MemoryResourceClientFactory{Cache: memory.NewInMemoryResourceCache()})
 		Expect(err).NotTo(HaveOccurred())
-		checker = NewChecker(queries, experiments, reports)
+		meshes, err = sgv1.NewMeshClient(&factory.MemoryResourceClientFactory{Cache: memory.NewInMemoryResourceCache()})
+		Expect(err).NotTo(HaveOccurred())
+		checker = NewChecker(queries, experiments, reports, meshes, "", nil, nil)
 	})
 
 	Context("failure condition met", func() {
@@ -170,6 +178,246 @@ var _ = Describe("CheckExperiment", func() {
 			}))
 		})
 	})
+	Context("no requests received", func() {
+		It("does not meet inequality conditions", func() {
+			prom.nextValue = func(query string) model.SampleValue {
+				// queries return no data while the service receives no requests
+				return model.SampleValue(math.NaN())
+			}
+			experiment := v1.NewExperiment("albert", "einstein")
+			duration := time.Second / 2
+			experiment.Spec = &v1.ExperimentSpec{
+				FailureConditions: []*v1.FailureCondition{
+					{
+						Trigger: &v1.FailureCondition_Trigger{
+							FailureTrigger: &v1.FailureCondition_Trigger_Prometheus{
+								Prometheus: &v1.PrometheusTrigger{
+									QueryType: &v1.PrometheusTrigger_CustomQuery{
+										CustomQuery: q1,
+									},
+									ThresholdValue: 1,
+									Operator:       v1.PrometheusTrigger_NOT_EQUAL,
+								},
+							},
+						},
+					},
+				},
+				Duration: &duration,
+			}
+			experiment.Result.TimeStarted = TimeProto(time.Now())
+
+			experiment, err := experiments.Write(experiment, clients.WriteOpts{})
+			Expect(err).NotTo(HaveOccurred())
+
+			go func() {
+				defer GinkgoRecover()
+				err := checker.MonitorExperiment(context.TODO(), experiment)
+				Expect(err).NotTo(HaveOccurred())
+			}()
+
+			Eventually(func() (*v1.ExperimentResult, error) {
+				exp, err := experiments.Read(experiment.Metadata.Namespace, experiment.Metadata.Name, clients.ReadOpts{})
+				if err != nil {
+					return nil, err
+				}
+				exp.Result.TimeStarted = nil
+				exp.Result.TimeFinished = nil
+				return &exp.Result, nil
+			}, time.Second*3).Should(Equal(&v1.ExperimentResult{
+				State: v1.ExperimentResult_Succeeded,
+			}))
+		})
+	})
+	Context("error budget exceeded", func() {
+		budgetExperiment := func() *v1.Experiment {
+			experiment := v1.NewExperiment("albert", "einstein")
+			duration := time.Second * 10
+			sloWindow := time.Second
+			experiment.Spec = &v1.ExperimentSpec{
+				FailureConditions: []*v1.FailureCondition{
+					{
+						Name: "budget",
+						Trigger: &v1.FailureCondition_Trigger{
+							FailureTrigger: &v1.FailureCondition_Trigger_ErrorBudget{
+								ErrorBudget: &v1.ErrorBudgetTrigger{
+									Service:           &core.ResourceRef{Name: "reviews", Namespace: "bookinfo"},
+									Target:            99.9,
+									SloWindow:         &sloWindow,
+									MaxBudgetFraction: 0.5,
+								},
+							},
+						},
+					},
+				},
+				Duration: &duration,
+			}
+			experiment.Result.TimeStarted = TimeProto(time.Now())
+
+			experiment, err := experiments.Write(experiment, clients.WriteOpts{})
+			Expect(err).NotTo(HaveOccurred())
+
+			go func() {
+				defer GinkgoRecover()
+				err := checker.MonitorExperiment(context.TODO(), experiment)
+				Expect(err).NotTo(HaveOccurred())
+			}()
+
+			Eventually(func() (string, error) {
+				exp, err := experiments.Read(experiment.Metadata.Namespace, experiment.Metadata.Name, clients.ReadOpts{})
+				if err != nil {
+					return "", err
+				}
+				if exp.Result.State != v1.ExperimentResult_Failed {
+					return "", nil
+				}
+				return exp.Result.FailureReport["failure_type"], nil
+			}, time.Second*3).Should(Equal("error_budget_exceeded"))
+			return experiment
+		}
+		budgetHistory := func(experiment *v1.Experiment) *v1.Report_FailureConditionHistory {
+			var history *v1.Report_FailureConditionHistory
+			Eventually(func() (int, error) {
+				report, err := reports.Read(experiment.Metadata.Namespace, experiment.Metadata.Name, clients.ReadOpts{})
+				if err != nil {
+					return 0, err
+				}
+				Expect(report.FailureConditionHistory).To(HaveLen(1))
+				history = report.FailureConditionHistory[0]
+				Expect(history.FailureConditionName).To(Equal("budget"))
+				return len(history.FailureConditionSnapshots), nil
+			}, time.Second*3).Should(BeNumerically(">", 0))
+			return history
+		}
+
+		It("sets the experiment state to failed and records the consumed budget", func() {
+			var longSamples int32
+			prom.nextValue = func(query string) model.SampleValue {
+				// the long window only degrades after a few samples, so budget is consumed before the condition is met
+				if strings.Contains(query, "[3600s]") && atomic.AddInt32(&longSamples, 1) <= 5 {
+					return 1
+				}
+				// 10% of requests fail
+				return 0.9
+			}
+			history := budgetHistory(budgetExperiment())
+			Expect(history.ErrorBudgetConsumed).To(BeNumerically(">", 0))
+		})
+
+		It("skips the samples of windows without requests", func() {
+			var shortSamples int32
+			prom.nextValue = func(query string) model.SampleValue {
+				// the success rate is not a number while the service receives no requests
+				if strings.Contains(query, "[300s]") && atomic.AddInt32(&shortSamples, 1) <= 5 {
+					return model.SampleValue(math.NaN())
+				}
+				return 0.9
+			}
+			history := budgetHistory(budgetExperiment())
+			Expect(math.IsNaN(history.ErrorBudgetConsumed)).To(BeFalse())
+			for _, snapshot := range history.FailureConditionSnapshots {
+				Expect(math.IsNaN(snapshot.Value)).To(BeFalse())
+			}
+		})
+	})
+	Context("compound condition met", func() {
+		It("sets the experiment state to failed and reports the state of each condition", func() {
+			prom.nextValue = func(query string) model.SampleValue {
+				switch query {
+				case "errors":
+					return 0.1
+				case "volume":
+					return 20
+				}
+				return 0
+			}
+			customQuery := func(name, query string, threshold float64) *v1.FailureCondition {
+				return &v1.FailureCondition{
+					Name: name,
+					Trigger: &v1.FailureCondition_Trigger{
+						FailureTrigger: &v1.FailureCondition_Trigger_Prometheus{
+							Prometheus: &v1.PrometheusTrigger{
+								QueryType:          &v1.PrometheusTrigger_CustomQuery{CustomQuery: query},
+								ThresholdValue:     threshold,
+								ComparisonOperator: ">",
+							},
+						},
+					},
+				}
+			}
+			experiment := v1.NewExperiment("albert", "einstein")
+			duration := time.Second * 10
+			experiment.Spec = &v1.ExperimentSpec{
+				FailureConditions: []*v1.FailureCondition{
+					{
+						Name: "errors-under-load",
+						Trigger: &v1.FailureCondition_Trigger{
+							FailureTrigger: &v1.FailureCondition_Trigger_Compound{
+								Compound: &v1.CompoundTrigger{
+									Operator: v1.CompoundTrigger_AND,
+									Conditions: []*v1.FailureCondition{
+										customQuery("error-rate", "errors", 0.05),
+										customQuery("request-volume", "volume", 10),
+										{
+											Name: "not-idle",
+											Trigger: &v1.FailureCondition_Trigger{
+												FailureTrigger: &v1.FailureCondition_Trigger_Compound{
+													Compound: &v1.CompoundTrigger{
+														Operator:   v1.CompoundTrigger_NOT,
+														Conditions: []*v1.FailureCondition{customQuery("idle", "volume", 100)},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				Duration: &duration,
+			}
+			experiment.Result.TimeStarted = TimeProto(time.Now())
+
+			experiment, err := experiments.Write(experiment, clients.WriteOpts{})
+			Expect(err).NotTo(HaveOccurred())
+
+			go func() {
+				defer GinkgoRecover()
+				err := checker.MonitorExperiment(context.TODO(), experiment)
+				Expect(err).NotTo(HaveOccurred())
+			}()
+
+			var failureReport map[string]string
+			Eventually(func() (v1.ExperimentResult_State, error) {
+				exp, err := experiments.Read(experiment.Metadata.Namespace, experiment.Metadata.Name, clients.ReadOpts{})
+				if err != nil {
+					return 0, err
+				}
+				failureReport = exp.Result.FailureReport
+				return exp.Result.State, nil
+			}, time.Second*3).Should(Equal(v1.ExperimentResult_Failed))
+			Expect(failureReport).To(Equal(map[string]string{
+				"failure_type":             "compound_condition_met",
+				"failure_condition":        "errors-under-load",
+				"condition.error-rate":     "met",
+				"condition.request-volume": "met",
+				"condition.idle":           "not_met",
+				"met_conditions":           "error-rate,request-volume",
+			}))
+
+			Eventually(func() ([]string, error) {
+				report, err := reports.Read(experiment.Metadata.Namespace, experiment.Metadata.Name, clients.ReadOpts{})
+				if err != nil {
+					return nil, err
+				}
+				var names []string
+				for _, history := range report.FailureConditionHistory {
+					names = append(names, history.FailureConditionName)
+				}
+				return names, nil
+			}, time.Second*3).Should(Equal([]string{"error-rate", "request-volume", "idle"}))
+		})
+	})
 })
 
 type mockPromClient struct {
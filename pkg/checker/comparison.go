@@ -0,0 +1,64 @@
+package checker
+
+import (
+	"fmt"
+	"math"
+
+	v1 "github.com/solo-io/glooshot/pkg/api/v1"
+	"github.com/solo-io/glooshot/pkg/utils"
+)
+
+// Comparison decides whether an observed metric value meets a prometheus failure condition
+type Comparison struct {
+	utils.Comparison
+}
+
+// GetComparison resolves the comparison of a prometheus trigger
+func GetComparison(trigger *v1.PrometheusTrigger) (*Comparison, error) {
+	resolved, err := utils.GetComparison(trigger)
+	if err != nil {
+		return nil, err
+	}
+	return &Comparison{Comparison: *resolved}, nil
+}
+
+// Met returns true if the value meets the failure condition
+// queries return NaN while the service receives no requests, which meets no condition
+func (c *Comparison) Met(val float64) bool {
+	if math.IsNaN(val) {
+		return false
+	}
+	switch c.Operator {
+	case v1.PrometheusTrigger_LESS_THAN_OR_EQUAL:
+		return val <= c.Threshold
+	case v1.PrometheusTrigger_GREATER_THAN:
+		return val > c.Threshold
+	case v1.PrometheusTrigger_GREATER_THAN_OR_EQUAL:
+		return val >= c.Threshold
+	case v1.PrometheusTrigger_EQUAL:
+		return val == c.Threshold
+	case v1.PrometheusTrigger_NOT_EQUAL:
+		return val != c.Threshold
+	case v1.PrometheusTrigger_OUTSIDE_RANGE:
+		return val < c.Low || val > c.High
+	}
+	return val < c.Threshold
+}
+
+func (c *Comparison) report(val float64) failureReport {
+	report := failureReport{
+		utils.FailureReportKeyType: "value_exceeded_threshold",
+		"value":                    fmt.Sprintf("%v", val),
+	}
+	if c.Operator == v1.PrometheusTrigger_OUTSIDE_RANGE {
+		report["comparison_operator"] = "outside_range"
+		report["range_low"] = fmt.Sprintf("%v", c.Low)
+		report["range_high"] = fmt.Sprintf("%v", c.High)
+		return report
+	}
+	if symbol, ok := c.Symbol(); ok {
+		report["comparison_operator"] = symbol
+	}
+	report["threshold"] = fmt.Sprintf("%v", c.Threshold)
+	return report
+}
@@ -0,0 +1,81 @@
+package checker_test
+
+import (
+	"math"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/glooshot/pkg/api/v1"
+
+	. "github.com/solo-io/glooshot/pkg/checker"
+)
+
+var _ = Describe("Comparison", func() {
+	met := func(trigger *v1.PrometheusTrigger, val float64) bool {
+		comparison, err := GetComparison(trigger)
+		Expect(err).NotTo(HaveOccurred())
+		return comparison.Met(val)
+	}
+
+	It("defaults to less than", func() {
+		trigger := &v1.PrometheusTrigger{ThresholdValue: 50}
+		Expect(met(trigger, 49)).To(BeTrue())
+		Expect(met(trigger, 50)).To(BeFalse())
+	})
+
+	It("supports the string operators for backwards compatibility", func() {
+		Expect(met(&v1.PrometheusTrigger{ThresholdValue: 50, ComparisonOperator: ">="}, 50)).To(BeTrue())
+		Expect(met(&v1.PrometheusTrigger{ThresholdValue: 50, ComparisonOperator: "=="}, 50)).To(BeTrue())
+		Expect(met(&v1.PrometheusTrigger{ThresholdValue: 50, ComparisonOperator: "!="}, 50)).To(BeFalse())
+		Expect(met(&v1.PrometheusTrigger{ThresholdValue: 50, ComparisonOperator: "!="}, 51)).To(BeTrue())
+	})
+
+	It("supports equality and inequality operators", func() {
+		Expect(met(&v1.PrometheusTrigger{ThresholdValue: 0, Operator: v1.PrometheusTrigger_EQUAL}, 0)).To(BeTrue())
+		Expect(met(&v1.PrometheusTrigger{ThresholdValue: 0, Operator: v1.PrometheusTrigger_EQUAL}, 1)).To(BeFalse())
+		Expect(met(&v1.PrometheusTrigger{ThresholdValue: 0, Operator: v1.PrometheusTrigger_NOT_EQUAL}, 1)).To(BeTrue())
+	})
+
+	It("is met when the value falls outside the range", func() {
+		trigger := &v1.PrometheusTrigger{
+			Operator: v1.PrometheusTrigger_OUTSIDE_RANGE,
+			Range:    &v1.PrometheusTrigger_Range{Low: 10, High: 20},
+		}
+		Expect(met(trigger, 9)).To(BeTrue())
+		Expect(met(trigger, 10)).To(BeFalse())
+		Expect(met(trigger, 20)).To(BeFalse())
+		Expect(met(trigger, 21)).To(BeTrue())
+	})
+
+	It("is never met by values of queries without data", func() {
+		for operator := range v1.PrometheusTrigger_ComparisonOperator_name {
+			trigger := &v1.PrometheusTrigger{
+				Operator: v1.PrometheusTrigger_ComparisonOperator(operator),
+				Range:    &v1.PrometheusTrigger_Range{Low: 10, High: 20},
+			}
+			Expect(met(trigger, math.NaN())).To(BeFalse(), trigger.Operator.String())
+		}
+	})
+
+	It("rejects unknown string operators", func() {
+		_, err := GetComparison(&v1.PrometheusTrigger{ComparisonOperator: "=>"})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("rejects conflicting operators", func() {
+		_, err := GetComparison(&v1.PrometheusTrigger{ComparisonOperator: ">", Operator: v1.PrometheusTrigger_LESS_THAN})
+		Expect(err).To(HaveOccurred())
+		_, err = GetComparison(&v1.PrometheusTrigger{ComparisonOperator: ">", Operator: v1.PrometheusTrigger_GREATER_THAN})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("rejects missing or inverted ranges", func() {
+		_, err := GetComparison(&v1.PrometheusTrigger{Operator: v1.PrometheusTrigger_OUTSIDE_RANGE})
+		Expect(err).To(HaveOccurred())
+		_, err = GetComparison(&v1.PrometheusTrigger{
+			Operator: v1.PrometheusTrigger_OUTSIDE_RANGE,
+			Range:    &v1.PrometheusTrigger_Range{Low: 20, High: 10},
+		})
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,149 @@
+package checker
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	v1 "github.com/solo-io/glooshot/pkg/api/v1"
+	"github.com/solo-io/glooshot/pkg/checker/metrics"
+	"github.com/solo-io/glooshot/pkg/utils"
+	"github.com/solo-io/go-utils/errors"
+)
+
+// validates the compound condition and collects the watches of all its leaf conditions, keyed by name
+func (c *checker) compoundLeafWatches(ctx context.Context, fc *v1.FailureCondition, getTemplates func() metrics.Templates, experimentDuration time.Duration, leaves map[string]conditionWatch) error {
+	compound := utils.CompoundTrigger(fc)
+	if compound == nil {
+		if _, exists := leaves[fc.Name]; exists {
+			return errors.Errorf("duplicate failure condition names are not allowed, found multiple with name %v", fc.Name)
+		}
+		watch, _, err := c.leafWatch(ctx, fc, getTemplates, experimentDuration)
+		if err != nil {
+			return errors.Wrapf(err, "invalid condition %v in compound condition", fc.Name)
+		}
+		leaves[fc.Name] = watch
+		return nil
+	}
+	switch compound.Operator {
+	case v1.CompoundTrigger_NOT:
+		if len(compound.Conditions) != 1 {
+			return errors.Errorf("compound condition %v: NOT requires exactly one condition, got %v", fc.Name, len(compound.Conditions))
+		}
+	default:
+		if len(compound.Conditions) == 0 {
+			return errors.Errorf("compound condition %v: %v requires at least one condition", fc.Name, compound.Operator)
+		}
+	}
+	for _, child := range compound.Conditions {
+		if err := c.compoundLeafWatches(ctx, child, getTemplates, experimentDuration, leaves); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// the latest evaluation of a leaf condition
+type leafUpdate struct {
+	name string
+	met  bool
+}
+
+// watches all the leaves of the compound condition, re-evaluating it on every sample until it is met
+func pollCompoundUntilFailure(ctx context.Context, fc *v1.FailureCondition, leaves map[string]conditionWatch) (failureReport, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	updates := make(chan leafUpdate)
+	errs := make(chan error, len(leaves))
+	for name, watch := range leaves {
+		name, watch := name, watch
+		go func() {
+			err := watch(func(met bool, _ failureReport) bool {
+				select {
+				case <-ctx.Done():
+					return true
+				case updates <- leafUpdate{name: name, met: met}:
+				}
+				return false
+			})
+			if err != nil {
+				errs <- err
+			}
+		}()
+	}
+
+	states := make(map[string]bool)
+	for {
+		select {
+		case <-ctx.Done():
+			// context cancelled, gracefully shut down
+			return nil, nil
+		case err := <-errs:
+			return nil, err
+		case update := <-updates:
+			states[update.name] = update.met
+			if met, known := evaluateCondition(fc, states); known && met {
+				return compoundFailureReport(leaves, states), nil
+			}
+		}
+	}
+}
+
+// evaluates the condition against the latest states of its leaves
+// known is false if the result depends on leaves which have not been sampled yet
+func evaluateCondition(fc *v1.FailureCondition, states map[string]bool) (met bool, known bool) {
+	compound := utils.CompoundTrigger(fc)
+	if compound == nil {
+		met, known = states[fc.Name]
+		return met, known
+	}
+	switch compound.Operator {
+	case v1.CompoundTrigger_NOT:
+		met, known = evaluateCondition(compound.Conditions[0], states)
+		return !met, known
+	case v1.CompoundTrigger_OR:
+		allKnown := true
+		for _, child := range compound.Conditions {
+			met, known := evaluateCondition(child, states)
+			if known && met {
+				return true, true
+			}
+			allKnown = allKnown && known
+		}
+		return false, allKnown
+	}
+	allKnown := true
+	for _, child := range compound.Conditions {
+		met, known := evaluateCondition(child, states)
+		if known && !met {
+			return false, true
+		}
+		allKnown = allKnown && known
+	}
+	return allKnown, allKnown
+}
+
+// reports the state of every leaf condition at the time the compound condition was met
+func compoundFailureReport(leaves map[string]conditionWatch, states map[string]bool) failureReport {
+	report := failureReport{
+		utils.FailureReportKeyType: "compound_condition_met",
+	}
+	var metConditions []string
+	for name := range leaves {
+		met, known := states[name]
+		switch {
+		case !known:
+			report["condition."+name] = "unknown"
+		case met:
+			report["condition."+name] = "met"
+			metConditions = append(metConditions, name)
+		default:
+			report["condition."+name] = "not_met"
+		}
+	}
+	sort.Strings(metConditions)
+	report["met_conditions"] = strings.Join(metConditions, ",")
+	return report
+}
@@ -0,0 +1,139 @@
+package checker
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	v1 "github.com/solo-io/glooshot/pkg/api/v1"
+	"github.com/solo-io/glooshot/pkg/checker/metrics"
+	"github.com/solo-io/glooshot/pkg/promquery"
+	"github.com/solo-io/glooshot/pkg/utils"
+	"github.com/solo-io/go-utils/errors"
+)
+
+var (
+	defaultShortWindow = time.Minute * 5
+	defaultLongWindow  = time.Hour
+)
+
+// the queries and parameters needed to evaluate an error budget trigger
+type errorBudgetSpec struct {
+	shortQuery        promquery.Query
+	longQuery         promquery.Query
+	allowedErrorRate  float64
+	sloWindow         time.Duration
+	maxBudgetFraction float64
+}
+
+func getErrorBudgetSpec(trigger *v1.ErrorBudgetTrigger, templates metrics.Templates) (*errorBudgetSpec, error) {
+	if err := validateService(trigger.Service); err != nil {
+		return nil, errors.Wrapf(err, "invalid error budget params")
+	}
+	if trigger.Target <= 0 || trigger.Target >= 100 {
+		return nil, errors.Errorf("invalid error budget params: target must be between 0 and 100, got %v", trigger.Target)
+	}
+	if trigger.MaxBudgetFraction <= 0 {
+		return nil, errors.Errorf("invalid error budget params: max budget fraction must be greater than 0, got %v", trigger.MaxBudgetFraction)
+	}
+	shortWindow := defaultShortWindow
+	if trigger.ShortWindow != nil {
+		shortWindow = *trigger.ShortWindow
+	}
+	longWindow := defaultLongWindow
+	if trigger.LongWindow != nil {
+		longWindow = *trigger.LongWindow
+	}
+	svc := trigger.Service
+	return &errorBudgetSpec{
+		shortQuery:        promquery.Query(templates.SuccessRate(svc.Namespace, svc.Name, shortWindow)),
+		longQuery:         promquery.Query(templates.SuccessRate(svc.Namespace, svc.Name, longWindow)),
+		allowedErrorRate:  1 - trigger.Target/100,
+		sloWindow:         utils.SloWindow(trigger),
+		maxBudgetFraction: trigger.MaxBudgetFraction,
+	}, nil
+}
+
+// the rate at which the error budget is consumed, relative to the rate that would exactly exhaust it over the slo window
+func (s *errorBudgetSpec) burnRate(successRate promquery.Result) float64 {
+	return (1 - float64(successRate)) / s.allowedErrorRate
+}
+
+// the fraction of the error budget consumed by burning at the given rate for the given duration
+func (s *errorBudgetSpec) budgetFraction(burnRate float64, duration time.Duration) float64 {
+	return burnRate * float64(duration) / float64(s.sloWindow)
+}
+
+// watch the short and long window success rates of the service, the condition is met once both burn rates project
+// a consumption of more than the allowed fraction of the error budget over the experiment's duration
+func (c *checker) watchErrorBudget(ctx context.Context, fcName string, spec *errorBudgetSpec, experimentDuration time.Duration, handle sampleHandler) error {
+	shortValues := c.promCache.Subscribe(spec.shortQuery)
+	defer c.promCache.Unsubscribe(spec.shortQuery, shortValues)
+	longValues := c.promCache.Subscribe(spec.longQuery)
+	defer c.promCache.Unsubscribe(spec.longQuery, longValues)
+
+	var (
+		shortBurnRate, longBurnRate float64
+		haveShort, haveLong         bool
+		consumed                    float64
+		lastSample                  time.Time
+	)
+	for {
+		select {
+		case <-ctx.Done():
+			// context cancelled, gracefully shut down
+			return nil
+		case val, ok := <-shortValues:
+			if !ok {
+				return errors.Errorf("unexpected close of query subscription")
+			}
+			if math.IsNaN(float64(val)) {
+				// the service received no requests in the window, so no budget was consumed
+				continue
+			}
+			shortBurnRate = spec.burnRate(val)
+			haveShort = true
+
+			// the budget consumed between samples is approximated with the short window burn rate
+			now := time.Now()
+			if !lastSample.IsZero() {
+				consumed += spec.budgetFraction(shortBurnRate, now.Sub(lastSample))
+			}
+			lastSample = now
+			c.storeQueryValue(fcName, promquery.Result(shortBurnRate))
+			c.storeErrorBudgetConsumed(fcName, consumed)
+		case val, ok := <-longValues:
+			if !ok {
+				return errors.Errorf("unexpected close of query subscription")
+			}
+			if math.IsNaN(float64(val)) {
+				continue
+			}
+			longBurnRate = spec.burnRate(val)
+			haveLong = true
+		}
+		if !haveShort || !haveLong {
+			continue
+		}
+		projected := spec.budgetFraction(minFloat(shortBurnRate, longBurnRate), experimentDuration)
+		report := failureReport{
+			utils.FailureReportKeyType:     "error_budget_exceeded",
+			"short_window_burn_rate":       fmt.Sprintf("%v", shortBurnRate),
+			"long_window_burn_rate":        fmt.Sprintf("%v", longBurnRate),
+			"projected_budget_consumption": fmt.Sprintf("%v", projected),
+			"max_budget_fraction":          fmt.Sprintf("%v", spec.maxBudgetFraction),
+			"error_budget_consumed":        fmt.Sprintf("%v", consumed),
+		}
+		if handle(projected > spec.maxBudgetFraction, report) {
+			return nil
+		}
+	}
+}
+
+func minFloat(a, b float64) float64 {
+	if a < b {
+		return a
+	}
+	return b
+}
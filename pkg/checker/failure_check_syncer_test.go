@@ -16,6 +16,7 @@ import (
 	"github.com/solo-io/glooshot/pkg/promquery"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
+	sgv1 "github.com/solo-io/supergloo/pkg/api/v1"
 
 	. "github.com/solo-io/glooshot/pkg/checker"
 )
@@ -25,6 +26,7 @@ var _ = Describe("FailureCheckSyncer", func() {
 	var (
 		experiments v1.ExperimentClient
 		reports     v1.ReportClient
+		meshes      sgv1.MeshClient
 		checker     ExperimentChecker
 		prom        *mockPromClient
 	)
@@ -38,7 +40,9 @@ var _ = Describe("FailureCheckSyncer", func() {
 		experiments, err = v1.NewExperimentClient(&factory.MemoryResourceClientFactory{Cache: memory.NewInMemoryResourceCache()})
 		reports, err = v1.NewReportClient(&factory.MemoryResourceClientFactory{Cache: memory.NewInMemoryResourceCache()})
 		Expect(err).NotTo(HaveOccurred())
-		checker = NewChecker(queries, experiments, reports)
+		meshes, err = sgv1.NewMeshClient(&factory.MemoryResourceClientFactory{Cache: memory.NewInMemoryResourceCache()})
+		Expect(err).NotTo(HaveOccurred())
+		checker = NewChecker(queries, experiments, reports, meshes, "", nil, nil)
 	})
 
 	It("does not leak goroutines", func() {
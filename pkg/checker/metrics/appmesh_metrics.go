@@ -0,0 +1,110 @@
+package metrics
+
+import (
+	"fmt"
+	"time"
+)
+
+// query templates for the envoy metrics exported by App Mesh sidecars
+// App Mesh names the upstream clusters of a virtual node after the service and its namespace,
+// so the destination is matched against the envoy cluster name
+
+func AppMeshSuccessRateQuery(namespace, name string, interval time.Duration) string {
+	return fmt.Sprintf(`
+scalar(
+	sum(
+		rate(
+			envoy_cluster_upstream_rq_xx{
+				envoy_response_code_class!="5",
+				%v
+			}[%v]
+		)
+	)
+		/
+	sum(
+		rate(
+			envoy_cluster_upstream_rq_total{
+				%v
+			}[%v]
+		)
+	)
+)
+`, appMeshDestination(namespace, name), promDuration(interval), appMeshDestination(namespace, name), promDuration(interval))
+}
+
+// envoy only exports the 5xx series once an upstream returned a server error, until then the error rate is 0
+func AppMeshErrorRateQuery(namespace, name string, interval time.Duration) string {
+	return fmt.Sprintf(`
+scalar(
+	(
+		sum(
+			rate(
+				envoy_cluster_upstream_rq_xx{
+					envoy_response_code_class="5",
+					%v
+				}[%v]
+			)
+		)
+		or vector(0)
+	)
+		/
+	sum(
+		rate(
+			envoy_cluster_upstream_rq_total{
+				%v
+			}[%v]
+		)
+	)
+)
+`, appMeshDestination(namespace, name), promDuration(interval), appMeshDestination(namespace, name), promDuration(interval))
+}
+
+func AppMeshRequestVolumeQuery(namespace, name string, interval time.Duration) string {
+	return fmt.Sprintf(`
+scalar(
+	sum(
+		rate(
+			envoy_cluster_upstream_rq_total{
+				%v
+			}[%v]
+		)
+	)
+)
+`, appMeshDestination(namespace, name), promDuration(interval))
+}
+
+// envoy reports upstream request time in milliseconds
+func AppMeshLatencyPercentileQuery(namespace, name string, percentile float64, interval time.Duration) string {
+	return fmt.Sprintf(`
+scalar(
+	histogram_quantile(
+		%v,
+		sum(
+			rate(
+				envoy_cluster_upstream_rq_time_bucket{
+					%v
+				}[%v]
+			)
+		) by (le)
+	)
+)
+`, percentile/100, appMeshDestination(namespace, name), promDuration(interval))
+}
+
+func AppMeshTcpConnectionErrorsQuery(namespace, name string, interval time.Duration) string {
+	return fmt.Sprintf(`
+scalar(
+	sum(
+		rate(
+			envoy_cluster_upstream_cx_connect_fail{
+				%v
+			}[%v]
+		)
+	)
+)
+`, appMeshDestination(namespace, name), promDuration(interval))
+}
+
+func appMeshDestination(namespace, name string) string {
+	return fmt.Sprintf(`envoy_cluster_name=~"cds_egress_.*_%v-%v_.*"`, name, namespace)
+}
@@ -6,26 +6,111 @@ import (
 )
 
 // query templates for well known metrics
+// every template evaluates to a scalar, as only scalar results are supported by the query pubsub
 
 func IstioSuccessRateQuery(namespace, name string, interval time.Duration) string {
 	return fmt.Sprintf(`
-sum(
-	rate (
-		istio_requests_total{
-			response_code!~"5.*",
-			destination_service_namespace="%v"
-			destination_service_name="%v",
-		}[%v]
+scalar(
+	sum(
+		rate(
+			istio_requests_total{
+				response_code!~"5.*",
+				%v
+			}[%v]
+		)
+	)
+		/
+	sum(
+		rate(
+			istio_requests_total{
+				%v
+			}[%v]
+		)
+	)
+)
+`, istioDestination(namespace, name), promDuration(interval), istioDestination(namespace, name), promDuration(interval))
+}
+
+func IstioErrorRateQuery(namespace, name string, interval time.Duration) string {
+	return fmt.Sprintf(`
+scalar(
+	sum(
+		rate(
+			istio_requests_total{
+				response_code=~"5.*",
+				%v
+			}[%v]
+		)
+	)
+		/
+	sum(
+		rate(
+			istio_requests_total{
+				%v
+			}[%v]
+		)
 	)
 )
-	/
-sum(
-	rate(
-		istio_requests_total{
-			destination_service_namespace="%v"
-			destination_service_name="%v",
-		}[%v]
+`, istioDestination(namespace, name), promDuration(interval), istioDestination(namespace, name), promDuration(interval))
+}
+
+func IstioRequestVolumeQuery(namespace, name string, interval time.Duration) string {
+	return fmt.Sprintf(`
+scalar(
+	sum(
+		rate(
+			istio_requests_total{
+				%v
+			}[%v]
+		)
 	)
 )
-`, namespace, name, interval, namespace, name, interval)
+`, istioDestination(namespace, name), promDuration(interval))
+}
+
+// percentile is expressed out of 100, e.g. 99 for the p99 latency
+func IstioLatencyPercentileQuery(namespace, name string, percentile float64, interval time.Duration) string {
+	return fmt.Sprintf(`
+scalar(
+	histogram_quantile(
+		%v,
+		sum(
+			rate(
+				istio_request_duration_seconds_bucket{
+					%v
+				}[%v]
+			)
+		) by (le)
+	) * 1000
+)
+`, percentile/100, istioDestination(namespace, name), promDuration(interval))
+}
+
+// connections closed with a response flag other than "-" were terminated by an error
+func IstioTcpConnectionErrorsQuery(namespace, name string, interval time.Duration) string {
+	return fmt.Sprintf(`
+scalar(
+	sum(
+		rate(
+			istio_tcp_connections_closed_total{
+				response_flags!="-",
+				%v
+			}[%v]
+		)
+	)
+)
+`, istioDestination(namespace, name), promDuration(interval))
+}
+
+func istioDestination(namespace, name string) string {
+	return fmt.Sprintf(`destination_service_namespace="%v", destination_service_name="%v"`, namespace, name)
+}
+
+// prometheus does not understand go's duration format (e.g. 1m0s), so express intervals in whole seconds
+func promDuration(interval time.Duration) string {
+	seconds := int64(interval / time.Second)
+	if seconds < 1 {
+		seconds = 1
+	}
+	return fmt.Sprintf("%vs", seconds)
 }
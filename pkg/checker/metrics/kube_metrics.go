@@ -0,0 +1,24 @@
+package metrics
+
+import (
+	"fmt"
+	"time"
+)
+
+// query templates for metrics exported by kube-state-metrics
+
+// pods are matched by prefix, which covers pods created by a deployment or statefulset named after the service
+func ContainerRestartsQuery(namespace, name string, interval time.Duration) string {
+	return fmt.Sprintf(`
+scalar(
+	sum(
+		increase(
+			kube_pod_container_status_restarts_total{
+				namespace="%v",
+				pod=~"%v-.*",
+			}[%v]
+		)
+	)
+)
+`, namespace, name, promDuration(interval))
+}
@@ -0,0 +1,107 @@
+package metrics
+
+import (
+	"fmt"
+	"time"
+)
+
+// query templates for the metrics exported by the linkerd2 proxy
+// outbound metrics are used, as they carry the labels of the destination service
+
+func LinkerdSuccessRateQuery(namespace, name string, interval time.Duration) string {
+	return fmt.Sprintf(`
+scalar(
+	sum(
+		rate(
+			response_total{
+				classification="success",
+				%v
+			}[%v]
+		)
+	)
+		/
+	sum(
+		rate(
+			response_total{
+				%v
+			}[%v]
+		)
+	)
+)
+`, linkerdDestination(namespace, name), promDuration(interval), linkerdDestination(namespace, name), promDuration(interval))
+}
+
+func LinkerdErrorRateQuery(namespace, name string, interval time.Duration) string {
+	return fmt.Sprintf(`
+scalar(
+	sum(
+		rate(
+			response_total{
+				status_code=~"5.*",
+				%v
+			}[%v]
+		)
+	)
+		/
+	sum(
+		rate(
+			response_total{
+				%v
+			}[%v]
+		)
+	)
+)
+`, linkerdDestination(namespace, name), promDuration(interval), linkerdDestination(namespace, name), promDuration(interval))
+}
+
+func LinkerdRequestVolumeQuery(namespace, name string, interval time.Duration) string {
+	return fmt.Sprintf(`
+scalar(
+	sum(
+		rate(
+			request_total{
+				%v
+			}[%v]
+		)
+	)
+)
+`, linkerdDestination(namespace, name), promDuration(interval))
+}
+
+// linkerd already reports latency in milliseconds
+func LinkerdLatencyPercentileQuery(namespace, name string, percentile float64, interval time.Duration) string {
+	return fmt.Sprintf(`
+scalar(
+	histogram_quantile(
+		%v,
+		sum(
+			rate(
+				response_latency_ms_bucket{
+					%v
+				}[%v]
+			)
+		) by (le)
+	)
+)
+`, percentile/100, linkerdDestination(namespace, name), promDuration(interval))
+}
+
+// connections closed with an errno were terminated by an error
+func LinkerdTcpConnectionErrorsQuery(namespace, name string, interval time.Duration) string {
+	return fmt.Sprintf(`
+scalar(
+	sum(
+		rate(
+			tcp_close_total{
+				errno!="",
+				%v
+			}[%v]
+		)
+	)
+)
+`, linkerdDestination(namespace, name), promDuration(interval))
+}
+
+func linkerdDestination(namespace, name string) string {
+	return fmt.Sprintf(`direction="outbound", dst_namespace="%v", dst_service="%v"`, namespace, name)
+}
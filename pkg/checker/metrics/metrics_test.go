@@ -0,0 +1,84 @@
+package metrics_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	sgv1 "github.com/solo-io/supergloo/pkg/api/v1"
+
+	. "github.com/solo-io/glooshot/pkg/checker/metrics"
+)
+
+var _ = Describe("Query templates", func() {
+	const (
+		namespace = "bookinfo"
+		name      = "reviews"
+	)
+	destination := `destination_service_namespace="bookinfo", destination_service_name="reviews"`
+
+	It("wraps every template in a scalar", func() {
+		for _, query := range []string{
+			IstioSuccessRateQuery(namespace, name, time.Minute),
+			IstioErrorRateQuery(namespace, name, time.Minute),
+			IstioRequestVolumeQuery(namespace, name, time.Minute),
+			IstioLatencyPercentileQuery(namespace, name, 99, time.Minute),
+			IstioTcpConnectionErrorsQuery(namespace, name, time.Minute),
+			ContainerRestartsQuery(namespace, name, time.Minute),
+		} {
+			Expect(query).To(HavePrefix("\nscalar("))
+			Expect(query).To(ContainSubstring("[60s]"))
+		}
+	})
+
+	It("targets the destination service", func() {
+		Expect(IstioSuccessRateQuery(namespace, name, time.Minute)).To(ContainSubstring(destination))
+		Expect(IstioErrorRateQuery(namespace, name, time.Minute)).To(ContainSubstring(`response_code=~"5.*"`))
+		Expect(IstioTcpConnectionErrorsQuery(namespace, name, time.Minute)).To(ContainSubstring(`response_flags!="-"`))
+	})
+
+	It("converts the latency percentile to a quantile", func() {
+		query := IstioLatencyPercentileQuery(namespace, name, 90, time.Second*30)
+		Expect(query).To(ContainSubstring("histogram_quantile(\n\t\t0.9,"))
+		Expect(query).To(ContainSubstring("istio_request_duration_seconds_bucket"))
+		Expect(query).To(ContainSubstring("[30s]"))
+	})
+
+	It("matches pods by service name for container restarts", func() {
+		query := ContainerRestartsQuery(namespace, name, time.Minute*5)
+		Expect(query).To(ContainSubstring(`namespace="bookinfo"`))
+		Expect(query).To(ContainSubstring(`pod=~"reviews-.*"`))
+		Expect(query).To(ContainSubstring("[300s]"))
+	})
+})
+
+var _ = Describe("TemplatesForMesh", func() {
+	It("defaults to istio", func() {
+		Expect(TemplatesForMesh(nil)).To(Equal(IstioTemplates))
+		Expect(TemplatesForMesh(&sgv1.Mesh{})).To(Equal(IstioTemplates))
+		Expect(TemplatesForMesh(&sgv1.Mesh{MeshType: &sgv1.Mesh_Istio{Istio: &sgv1.IstioMesh{}}})).To(Equal(IstioTemplates))
+	})
+
+	It("selects the templates for the mesh type", func() {
+		Expect(TemplatesForMesh(&sgv1.Mesh{MeshType: &sgv1.Mesh_Linkerd{Linkerd: &sgv1.LinkerdMesh{}}})).To(Equal(LinkerdTemplates))
+		Expect(TemplatesForMesh(&sgv1.Mesh{MeshType: &sgv1.Mesh_AwsAppMesh{AwsAppMesh: &sgv1.AwsAppMesh{}}})).To(Equal(AppMeshTemplates))
+	})
+
+	It("renders mesh specific metric names and labels", func() {
+		linkerd := LinkerdTemplates.SuccessRate("bookinfo", "reviews", time.Minute)
+		Expect(linkerd).To(ContainSubstring("response_total"))
+		Expect(linkerd).To(ContainSubstring(`dst_namespace="bookinfo", dst_service="reviews"`))
+
+		appMesh := AppMeshTemplates.RequestVolume("bookinfo", "reviews", time.Minute)
+		Expect(appMesh).To(ContainSubstring("envoy_cluster_upstream_rq_total"))
+		Expect(appMesh).To(ContainSubstring("reviews-bookinfo"))
+
+		appMeshSuccess := AppMeshTemplates.SuccessRate("bookinfo", "reviews", time.Minute)
+		Expect(appMeshSuccess).To(ContainSubstring(`envoy_response_code_class!="5"`))
+		Expect(appMeshSuccess).NotTo(ContainSubstring("1 -"))
+		Expect(AppMeshTemplates.ErrorRate("bookinfo", "reviews", time.Minute)).To(ContainSubstring("or vector(0)"))
+
+		istio := IstioTemplates.LatencyPercentile("bookinfo", "reviews", 50, time.Minute)
+		Expect(istio).To(Equal(IstioLatencyPercentileQuery("bookinfo", "reviews", 50, time.Minute)))
+	})
+})
@@ -0,0 +1,103 @@
+package metrics
+
+import (
+	"time"
+
+	sgv1 "github.com/solo-io/supergloo/pkg/api/v1"
+)
+
+// Templates renders the well known queries for the metrics exposed by a particular mesh
+type Templates interface {
+	SuccessRate(namespace, name string, interval time.Duration) string
+	ErrorRate(namespace, name string, interval time.Duration) string
+	RequestVolume(namespace, name string, interval time.Duration) string
+	LatencyPercentile(namespace, name string, percentile float64, interval time.Duration) string
+	TcpConnectionErrors(namespace, name string, interval time.Duration) string
+}
+
+// returns the templates matching the type of the given mesh
+// defaults to istio if the mesh is nil or of an unknown type
+func TemplatesForMesh(mesh *sgv1.Mesh) Templates {
+	if mesh == nil {
+		return IstioTemplates
+	}
+	switch mesh.MeshType.(type) {
+	case *sgv1.Mesh_Linkerd:
+		return LinkerdTemplates
+	case *sgv1.Mesh_AwsAppMesh:
+		return AppMeshTemplates
+	}
+	return IstioTemplates
+}
+
+var IstioTemplates Templates = istioTemplates{}
+
+type istioTemplates struct{}
+
+func (istioTemplates) SuccessRate(namespace, name string, interval time.Duration) string {
+	return IstioSuccessRateQuery(namespace, name, interval)
+}
+
+func (istioTemplates) ErrorRate(namespace, name string, interval time.Duration) string {
+	return IstioErrorRateQuery(namespace, name, interval)
+}
+
+func (istioTemplates) RequestVolume(namespace, name string, interval time.Duration) string {
+	return IstioRequestVolumeQuery(namespace, name, interval)
+}
+
+func (istioTemplates) LatencyPercentile(namespace, name string, percentile float64, interval time.Duration) string {
+	return IstioLatencyPercentileQuery(namespace, name, percentile, interval)
+}
+
+func (istioTemplates) TcpConnectionErrors(namespace, name string, interval time.Duration) string {
+	return IstioTcpConnectionErrorsQuery(namespace, name, interval)
+}
+
+var LinkerdTemplates Templates = linkerdTemplates{}
+
+type linkerdTemplates struct{}
+
+func (linkerdTemplates) SuccessRate(namespace, name string, interval time.Duration) string {
+	return LinkerdSuccessRateQuery(namespace, name, interval)
+}
+
+func (linkerdTemplates) ErrorRate(namespace, name string, interval time.Duration) string {
+	return LinkerdErrorRateQuery(namespace, name, interval)
+}
+
+func (linkerdTemplates) RequestVolume(namespace, name string, interval time.Duration) string {
+	return LinkerdRequestVolumeQuery(namespace, name, interval)
+}
+
+func (linkerdTemplates) LatencyPercentile(namespace, name string, percentile float64, interval time.Duration) string {
+	return LinkerdLatencyPercentileQuery(namespace, name, percentile, interval)
+}
+
+func (linkerdTemplates) TcpConnectionErrors(namespace, name string, interval time.Duration) string {
+	return LinkerdTcpConnectionErrorsQuery(namespace, name, interval)
+}
+
+var AppMeshTemplates Templates = appMeshTemplates{}
+
+type appMeshTemplates struct{}
+
+func (appMeshTemplates) SuccessRate(namespace, name string, interval time.Duration) string {
+	return AppMeshSuccessRateQuery(namespace, name, interval)
+}
+
+func (appMeshTemplates) ErrorRate(namespace, name string, interval time.Duration) string {
+	return AppMeshErrorRateQuery(namespace, name, interval)
+}
+
+func (appMeshTemplates) RequestVolume(namespace, name string, interval time.Duration) string {
+	return AppMeshRequestVolumeQuery(namespace, name, interval)
+}
+
+func (appMeshTemplates) LatencyPercentile(namespace, name string, percentile float64, interval time.Duration) string {
+	return AppMeshLatencyPercentileQuery(namespace, name, percentile, interval)
+}
+
+func (appMeshTemplates) TcpConnectionErrors(namespace, name string, interval time.Duration) string {
+	return AppMeshTcpConnectionErrorsQuery(namespace, name, interval)
+}
@@ -5,10 +5,20 @@ import (
 
 	"github.com/solo-io/glooshot/pkg/cli/cmd/register"
 
+	"github.com/solo-io/glooshot/pkg/cli/cmd/check"
+	"github.com/solo-io/glooshot/pkg/cli/cmd/config"
+	"github.com/solo-io/glooshot/pkg/cli/cmd/convertexp"
 	"github.com/solo-io/glooshot/pkg/cli/cmd/create"
 	"github.com/solo-io/glooshot/pkg/cli/cmd/deleteexp"
+	"github.com/solo-io/glooshot/pkg/cli/cmd/describe"
+	"github.com/solo-io/glooshot/pkg/cli/cmd/exportreports"
 	"github.com/solo-io/glooshot/pkg/cli/cmd/get"
 	"github.com/solo-io/glooshot/pkg/cli/cmd/initexp"
+	"github.com/solo-io/glooshot/pkg/cli/cmd/report"
+	"github.com/solo-io/glooshot/pkg/cli/cmd/rerun"
+	"github.com/solo-io/glooshot/pkg/cli/cmd/runscenario"
+	"github.com/solo-io/glooshot/pkg/cli/cmd/runsuite"
+	"github.com/solo-io/glooshot/pkg/cli/cmd/watchexp"
 
 	"github.com/solo-io/glooshot/pkg/cli/options"
 
@@ -25,6 +35,9 @@ func App(ctx context.Context, version string) *cobra.Command {
 		Use:     "glooshot",
 		Short:   "CLI for glooshot",
 		Version: version,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			return options.ApplyConfig(&o, cmd)
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return nil
 		},
@@ -33,12 +46,31 @@ func App(ctx context.Context, version string) *cobra.Command {
 	app.AddCommand(
 		register.Cmd(&o),
 		create.Cmd(&o),
+		create.ApplyCmd(&o),
+		convertexp.Cmd(&o),
 		deleteexp.Cmd(&o),
+		rerun.Cmd(&o),
+		runsuite.Cmd(&o),
+		runscenario.Cmd(&o),
 		get.Cmd(&o),
+		describe.Cmd(&o),
+		watchexp.Cmd(&o),
+		exportreports.Cmd(&o),
+		report.Cmd(&o),
 		initexp.Cmd(&o),
+		initexp.UpgradeCmd(&o),
+		initexp.UninstallCmd(&o),
+		check.Cmd(&o),
+		config.Cmd(&o),
 		completionCmd(),
 	)
 	pflags := app.PersistentFlags()
 	pflags.BoolVarP(&o.Top.Interactive, "interactive", "i", false, "use interactive mode")
+	pflags.StringVarP(&o.Top.Output, "output", "o", "", "output format: table|wide|yaml|json, defaults to table")
+	options.AnnotateConfigKey(pflags, "output", options.ConfigKeyOutput)
+	pflags.StringVar(&o.Top.ConfigFile, "config", o.Top.ConfigFile, "config file providing default values for flags")
+	pflags.StringVar(&o.Top.Kubeconfig, "kubeconfig", "", "path to the kubeconfig file, defaults to $KUBECONFIG or ~/.kube/config")
+	pflags.StringVar(&o.Top.KubeContext, "context", "", "name of the kubeconfig context to use, defaults to the current context")
+	options.AnnotateConfigKey(pflags, "context", options.ConfigKeyKubeContext)
 	return app
 }
@@ -0,0 +1,241 @@
+package check
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/api"
+	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	v1 "github.com/solo-io/glooshot/pkg/api/v1"
+	"github.com/solo-io/glooshot/pkg/cli/gsutil"
+	"github.com/solo-io/glooshot/pkg/promquery"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/kube/crd"
+	sgv1 "github.com/solo-io/supergloo/pkg/api/v1"
+	authv1 "k8s.io/api/authorization/v1"
+	kubeerrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// the service account the operator runs as
+	operatorServiceAccount = "glooshot-serviceaccount"
+	// the deployment of supergloo, in whichever namespace it was installed to
+	superglooDeployment = "supergloo"
+	// the number of istio request series, which is zero or NaN if prometheus does not scrape the mesh
+	istioMetricsQuery = "scalar(count(istio_requests_total))"
+)
+
+// a check of the checklist, run returns the detail printed along with the outcome
+type check struct {
+	name string
+	// how to fix the issue if the check fails
+	hint string
+	run  func() (string, error)
+}
+
+// returned by checks which do not apply, they neither pass nor fail
+type skipped string
+
+func (s skipped) Error() string {
+	return string(s)
+}
+
+// the resources served for the glooshot crds
+func glooshotResources() []schema.GroupVersionResource {
+	var resources []schema.GroupVersionResource
+	for _, c := range []crd.Crd{v1.ExperimentCrd, v1.ReportCrd, v1.ReportSinkCrd, v1.ExperimentTemplateCrd, v1.ExperimentSuiteCrd} {
+		resources = append(resources, schema.GroupVersionResource{Group: c.Group, Version: c.Version, Resource: c.Plural})
+	}
+	return resources
+}
+
+// the supergloo resources glooshot reads and writes
+func superglooResources() []schema.GroupVersionResource {
+	return []schema.GroupVersionResource{
+		{Group: sgv1.MeshCrd.Group, Version: sgv1.MeshCrd.Version, Resource: sgv1.MeshCrd.Plural},
+		{Group: sgv1.RoutingRuleCrd.Group, Version: sgv1.RoutingRuleCrd.Version, Resource: sgv1.RoutingRuleCrd.Plural},
+	}
+}
+
+// returns the resources which are not served by the cluster
+func missingResources(kube kubernetes.Interface, resources []schema.GroupVersionResource) ([]string, error) {
+	served := make(map[schema.GroupVersion]map[string]bool)
+	var missing []string
+	for _, res := range resources {
+		gv := res.GroupVersion()
+		if _, ok := served[gv]; !ok {
+			served[gv] = make(map[string]bool)
+			list, err := kube.Discovery().ServerResourcesForGroupVersion(gv.String())
+			if err != nil && !kubeerrs.IsNotFound(err) {
+				return nil, errors.Wrapf(err, "discovering the resources of %v", gv)
+			}
+			if list != nil {
+				for _, r := range list.APIResources {
+					served[gv][r.Name] = true
+				}
+			}
+		}
+		if !served[gv][res.Resource] {
+			missing = append(missing, res.GroupResource().String())
+		}
+	}
+	return missing, nil
+}
+
+func crdsRegistered(kube kubernetes.Interface) check {
+	return check{
+		name: "glooshot CRDs are registered",
+		hint: "install glooshot with glooshot init, or register the CRDs with glooshot register",
+		run: func() (string, error) {
+			missing, err := missingResources(kube, glooshotResources())
+			if err != nil {
+				return "", err
+			}
+			if len(missing) > 0 {
+				return "", errors.Errorf("not registered: %v", strings.Join(missing, ", "))
+			}
+			return fmt.Sprintf("%v resources served", len(glooshotResources())), nil
+		},
+	}
+}
+
+func operatorRunning(kube kubernetes.Interface, namespace string) check {
+	return check{
+		name: "the glooshot operator is running",
+		hint: fmt.Sprintf("install glooshot with glooshot init -n %v, or inspect its pods with "+
+			"kubectl -n %v describe deployment %v", namespace, namespace, gsutil.OperatorDeployment),
+		run: func() (string, error) {
+			deployment, err := kube.AppsV1().Deployments(namespace).Get(gsutil.OperatorDeployment, metav1.GetOptions{})
+			if kubeerrs.IsNotFound(err) {
+				return "", errors.Errorf("deployment %v.%v not found", namespace, gsutil.OperatorDeployment)
+			}
+			if err != nil {
+				return "", errors.Wrapf(err, "reading deployment %v.%v", namespace, gsutil.OperatorDeployment)
+			}
+			ready, status := gsutil.DeploymentReady(deployment)
+			if !ready {
+				return "", errors.Errorf("deployment %v.%v is not ready: %v", namespace, gsutil.OperatorDeployment, status)
+			}
+			return status, nil
+		},
+	}
+}
+
+func superglooInstalled(kube kubernetes.Interface) check {
+	return check{
+		name: "supergloo is installed",
+		hint: "install supergloo with supergloo init, glooshot injects its faults through supergloo routing rules",
+		run: func() (string, error) {
+			missing, err := missingResources(kube, superglooResources())
+			if err != nil {
+				return "", err
+			}
+			if len(missing) > 0 {
+				return "", errors.Errorf("not registered: %v", strings.Join(missing, ", "))
+			}
+			deployments, err := kube.AppsV1().Deployments(metav1.NamespaceAll).List(metav1.ListOptions{})
+			if err != nil {
+				return "", errors.Wrapf(err, "listing deployments")
+			}
+			for _, deployment := range deployments.Items {
+				if deployment.Name == superglooDeployment {
+					return fmt.Sprintf("deployment %v.%v found", deployment.Namespace, deployment.Name), nil
+				}
+			}
+			return "", errors.Errorf("the supergloo CRDs are registered but no %v deployment was found", superglooDeployment)
+		},
+	}
+}
+
+func meshExists(ctx context.Context, meshes sgv1.MeshClient, namespaces []string) check {
+	return check{
+		name: "a mesh is registered",
+		hint: "install a mesh with supergloo install, or register an existing one with supergloo register",
+		run: func() (string, error) {
+			var names []string
+			for _, ns := range namespaces {
+				list, err := meshes.List(ns, clients.ListOpts{Ctx: ctx})
+				if err != nil {
+					return "", errors.Wrapf(err, "listing meshes")
+				}
+				for _, mesh := range list {
+					names = append(names, fmt.Sprintf("%v.%v", mesh.Metadata.Namespace, mesh.Metadata.Name))
+				}
+			}
+			if len(names) == 0 {
+				return "", errors.Errorf("no meshes found")
+			}
+			return strings.Join(names, ", "), nil
+		},
+	}
+}
+
+func prometheusReachable(ctx context.Context, prometheusUrl string) check {
+	return check{
+		name: "prometheus is reachable and has istio metrics",
+		hint: "pass the url of the prometheus scraping the mesh with --prometheus-url, or set it with " +
+			"glooshot config set prometheusUrl URL",
+		run: func() (string, error) {
+			if prometheusUrl == "" {
+				return "", skipped("no prometheus url configured")
+			}
+			promClient, err := api.NewClient(api.Config{Address: prometheusUrl})
+			if err != nil {
+				return "", errors.Wrapf(err, "connecting to prometheus")
+			}
+			return istioMetrics(ctx, promv1.NewAPI(promClient))
+		},
+	}
+}
+
+func istioMetrics(ctx context.Context, promApi promquery.QueryClient) (string, error) {
+	series, err := promquery.QueryScalar(ctx, promApi, promquery.Query(istioMetricsQuery))
+	if err != nil {
+		return "", errors.Wrapf(err, "querying prometheus")
+	}
+	if math.IsNaN(float64(series)) || series == 0 {
+		return "", errors.Errorf("prometheus has no istio_requests_total series, it does not scrape the istio telemetry")
+	}
+	return fmt.Sprintf("%v istio_requests_total series", series), nil
+}
+
+func routingRulesWritable(kube kubernetes.Interface, namespace string) check {
+	user := fmt.Sprintf("system:serviceaccount:%v:%v", namespace, operatorServiceAccount)
+	return check{
+		name: "the operator may write routing rules",
+		hint: fmt.Sprintf("install glooshot with rbac.create=true, or grant %v all verbs on "+
+			"routingrules.supergloo.solo.io in a cluster role", user),
+		run: func() (string, error) {
+			var denied []string
+			for _, verb := range []string{"create", "update", "delete"} {
+				review := &authv1.SubjectAccessReview{
+					Spec: authv1.SubjectAccessReviewSpec{
+						User: user,
+						ResourceAttributes: &authv1.ResourceAttributes{
+							Group:    sgv1.RoutingRuleCrd.Group,
+							Resource: sgv1.RoutingRuleCrd.Plural,
+							Verb:     verb,
+						},
+					},
+				}
+				review, err := kube.AuthorizationV1().SubjectAccessReviews().Create(review)
+				if err != nil {
+					return "", errors.Wrapf(err, "reviewing the access of %v", user)
+				}
+				if !review.Status.Allowed {
+					denied = append(denied, verb)
+				}
+			}
+			if len(denied) > 0 {
+				return "", errors.Errorf("%v may not %v routing rules", user, strings.Join(denied, ", "))
+			}
+			return fmt.Sprintf("%v may create, update and delete routing rules", user), nil
+		},
+	}
+}
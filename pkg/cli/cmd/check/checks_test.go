@@ -0,0 +1,199 @@
+package check
+
+import (
+	"bytes"
+	"context"
+	"math"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"github.com/prometheus/common/model"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	sgv1 "github.com/solo-io/supergloo/pkg/api/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	authv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	ktesting "k8s.io/client-go/testing"
+)
+
+// answers every query with the same value
+type scalarPromClient model.SampleValue
+
+func (c scalarPromClient) Query(ctx context.Context, query string, ts time.Time) (model.Value, error) {
+	return &model.Scalar{Value: model.SampleValue(c)}, nil
+}
+
+var _ = Describe("Checks", func() {
+
+	var kube *fake.Clientset
+
+	BeforeEach(func() {
+		kube = fake.NewSimpleClientset()
+	})
+
+	serve := func(resources ...schema.GroupVersionResource) {
+		discovery := kube.Discovery().(*fakediscovery.FakeDiscovery)
+		byGroupVersion := make(map[string]*metav1.APIResourceList)
+		for _, res := range resources {
+			gv := res.GroupVersion().String()
+			if byGroupVersion[gv] == nil {
+				byGroupVersion[gv] = &metav1.APIResourceList{GroupVersion: gv}
+				discovery.Resources = append(discovery.Resources, byGroupVersion[gv])
+			}
+			byGroupVersion[gv].APIResources = append(byGroupVersion[gv].APIResources, metav1.APIResource{Name: res.Resource})
+		}
+	}
+
+	deployment := func(namespace, name string, available int32) *appsv1.Deployment {
+		replicas := int32(1)
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+			Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+			Status:     appsv1.DeploymentStatus{Replicas: 1, UpdatedReplicas: 1, AvailableReplicas: available},
+		}
+	}
+
+	Context("crds", func() {
+		It("passes if all glooshot resources are served", func() {
+			serve(glooshotResources()...)
+			_, err := crdsRegistered(kube).run()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("lists the resources which are not served", func() {
+			serve(schema.GroupVersionResource{Group: "glooshot.solo.io", Version: "v1", Resource: "experiments"})
+			_, err := crdsRegistered(kube).run()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("reports.glooshot.solo.io"))
+			Expect(err.Error()).NotTo(ContainSubstring("experiments.glooshot.solo.io"))
+		})
+	})
+
+	Context("operator", func() {
+		It("passes once the deployment is ready", func() {
+			_, err := kube.AppsV1().Deployments("glooshot").Create(deployment("glooshot", "glooshot", 1))
+			Expect(err).NotTo(HaveOccurred())
+			detail, err := operatorRunning(kube, "glooshot").run()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(detail).To(Equal("1 of 1 replicas updated, 1 available"))
+		})
+
+		It("fails if the deployment is not ready", func() {
+			_, err := kube.AppsV1().Deployments("glooshot").Create(deployment("glooshot", "glooshot", 0))
+			Expect(err).NotTo(HaveOccurred())
+			_, err = operatorRunning(kube, "glooshot").run()
+			Expect(err).To(MatchError("deployment glooshot.glooshot is not ready: 1 of 1 replicas updated, 0 available"))
+		})
+
+		It("fails if glooshot is not installed in the namespace", func() {
+			_, err := operatorRunning(kube, "other").run()
+			Expect(err).To(MatchError("deployment other.glooshot not found"))
+		})
+	})
+
+	Context("supergloo", func() {
+		BeforeEach(func() {
+			serve(
+				schema.GroupVersionResource{Group: "supergloo.solo.io", Version: "v1", Resource: "meshes"},
+				schema.GroupVersionResource{Group: "supergloo.solo.io", Version: "v1", Resource: "routingrules"},
+			)
+		})
+
+		It("passes if the crds are served and supergloo is deployed", func() {
+			_, err := kube.AppsV1().Deployments("supergloo-system").Create(deployment("supergloo-system", "supergloo", 1))
+			Expect(err).NotTo(HaveOccurred())
+			detail, err := superglooInstalled(kube).run()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(detail).To(Equal("deployment supergloo-system.supergloo found"))
+		})
+
+		It("fails if supergloo is not deployed", func() {
+			_, err := superglooInstalled(kube).run()
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("meshes", func() {
+		var meshes sgv1.MeshClient
+
+		BeforeEach(func() {
+			var err error
+			meshes, err = sgv1.NewMeshClient(&factory.MemoryResourceClientFactory{Cache: memory.NewInMemoryResourceCache()})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("lists the meshes", func() {
+			_, err := meshes.Write(&sgv1.Mesh{Metadata: core.Metadata{Namespace: "supergloo-system", Name: "istio"}}, clients.WriteOpts{})
+			Expect(err).NotTo(HaveOccurred())
+			detail, err := meshExists(context.TODO(), meshes, []string{"default", "supergloo-system"}).run()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(detail).To(Equal("supergloo-system.istio"))
+		})
+
+		It("fails without meshes", func() {
+			_, err := meshExists(context.TODO(), meshes, []string{"default", "supergloo-system"}).run()
+			Expect(err).To(MatchError("no meshes found"))
+		})
+	})
+
+	Context("prometheus", func() {
+		It("is skipped without a url", func() {
+			_, err := prometheusReachable(context.TODO(), "").run()
+			Expect(err).To(BeAssignableToTypeOf(skipped("")))
+		})
+
+		It("passes if there are istio series", func() {
+			detail, err := istioMetrics(context.TODO(), scalarPromClient(12))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(detail).To(Equal("12 istio_requests_total series"))
+		})
+
+		It("fails if there are no istio series", func() {
+			_, err := istioMetrics(context.TODO(), scalarPromClient(math.NaN()))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("rbac", func() {
+		It("lists the verbs which are denied", func() {
+			kube.PrependReactor("create", "subjectaccessreviews", func(action ktesting.Action) (bool, runtime.Object, error) {
+				review := action.(ktesting.CreateAction).GetObject().(*authv1.SubjectAccessReview)
+				Expect(review.Spec.User).To(Equal("system:serviceaccount:glooshot:glooshot-serviceaccount"))
+				Expect(review.Spec.ResourceAttributes.Group).To(Equal("supergloo.solo.io"))
+				review.Status.Allowed = review.Spec.ResourceAttributes.Verb == "create"
+				return true, review, nil
+			})
+			_, err := routingRulesWritable(kube, "glooshot").run()
+			Expect(err).To(MatchError("system:serviceaccount:glooshot:glooshot-serviceaccount may not update, delete routing rules"))
+		})
+	})
+
+	Context("checklist", func() {
+		It("prints the outcome of each check and counts the failures", func() {
+			buf := &bytes.Buffer{}
+			failed := runChecks(buf, []check{
+				{name: "passes", run: func() (string, error) { return "fine", nil }},
+				{name: "fails", hint: "fix it", run: func() (string, error) { return "", errors.Errorf("broken") }},
+				{name: "skips", hint: "configure it", run: func() (string, error) { return "", skipped("not configured") }},
+			})
+			Expect(failed).To(Equal(1))
+			Expect(buf.String()).To(Equal(`[PASS] passes: fine
+[FAIL] fails: broken
+       hint: fix it
+[SKIP] skips: not configured
+       hint: configure it
+
+1 passed, 1 failed, 1 skipped
+`))
+		})
+	})
+})
@@ -0,0 +1,70 @@
+package check
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/solo-io/glooshot/pkg/cli/options"
+	"github.com/spf13/cobra"
+)
+
+func Cmd(o *options.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check",
+		Short: "check that glooshot and its dependencies are installed and working",
+		Long: "Verifies that the glooshot CRDs are registered, the operator is running, supergloo is installed and " +
+			"manages at least one mesh, the prometheus given with --prometheus-url is reachable and has istio metrics, " +
+			"and that the operator may write routing rules. Prints a checklist with a hint for each failed check, and " +
+			"fails if any check failed.",
+		RunE: func(c *cobra.Command, args []string) error {
+			return doCheck(o)
+		},
+	}
+	flags := cmd.Flags()
+	flags.StringVarP(&o.Check.InstallNamespace, "namespace", "n", "glooshot", "namespace glooshot is installed in")
+	flags.StringVar(&o.Check.PrometheusUrl, "prometheus-url", "", "url of the prometheus scraping the mesh, "+
+		"the check is skipped if empty")
+	options.AnnotateConfigKey(flags, "prometheus-url", options.ConfigKeyPrometheusUrl)
+	return cmd
+}
+
+func doCheck(o *options.Options) error {
+	kube := o.Clients.KubeClient()
+	checks := []check{
+		crdsRegistered(kube),
+		operatorRunning(kube, o.Check.InstallNamespace),
+		superglooInstalled(kube),
+		meshExists(o.Ctx, o.Clients.MeshClient(), options.GetNamespaces(o)),
+		prometheusReachable(o.Ctx, o.Check.PrometheusUrl),
+		routingRulesWritable(kube, o.Check.InstallNamespace),
+	}
+	if failed := runChecks(os.Stdout, checks); failed > 0 {
+		return errors.Errorf("%v of %v checks failed", failed, len(checks))
+	}
+	return nil
+}
+
+// prints the outcome of each check, with its hint if it failed, and returns the number of failed checks
+func runChecks(w io.Writer, checks []check) int {
+	var passed, failed, skippedChecks int
+	for _, c := range checks {
+		detail, err := c.run()
+		switch err.(type) {
+		case nil:
+			passed++
+			fmt.Fprintf(w, "[PASS] %v: %v\n", c.name, detail)
+		case skipped:
+			skippedChecks++
+			fmt.Fprintf(w, "[SKIP] %v: %v\n", c.name, err)
+			fmt.Fprintf(w, "       hint: %v\n", c.hint)
+		default:
+			failed++
+			fmt.Fprintf(w, "[FAIL] %v: %v\n", c.name, err)
+			fmt.Fprintf(w, "       hint: %v\n", c.hint)
+		}
+	}
+	fmt.Fprintf(w, "\n%v passed, %v failed, %v skipped\n", passed, failed, skippedChecks)
+	return failed
+}
@@ -0,0 +1,86 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/pkg/errors"
+	"github.com/solo-io/glooshot/pkg/cli/options"
+	"github.com/spf13/cobra"
+)
+
+func Cmd(o *options.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "view and modify the glooshot config file",
+		Long: fmt.Sprintf("the config file (%v by default, see --config) provides default values for glooshot commands. "+
+			"Flags given on the command line override it. Supported keys: %v",
+			o.Top.ConfigFile, strings.Join(options.ConfigKeys(), ", ")),
+	}
+	// replaces the loading of the root command, so that the config commands can report a malformed config file
+	var configErr error
+	cmd.PersistentPreRunE = func(c *cobra.Command, args []string) error {
+		config, err := options.ReadConfig(o.Top.ConfigFile)
+		if err != nil {
+			configErr = err
+			return nil
+		}
+		o.Top.Config = *config
+		return nil
+	}
+	cmd.AddCommand(
+		viewCmd(o, &configErr),
+		setCmd(o, &configErr),
+	)
+	return cmd
+}
+
+func viewCmd(o *options.Options, configErr *error) *cobra.Command {
+	return &cobra.Command{
+		Use:   "view",
+		Short: "print the config file",
+		RunE: func(c *cobra.Command, args []string) error {
+			if *configErr != nil {
+				return *configErr
+			}
+			b, err := yaml.Marshal(o.Top.Config)
+			if err != nil {
+				return err
+			}
+			fmt.Print(string(b))
+			return nil
+		},
+	}
+}
+
+func setCmd(o *options.Options, configErr *error) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set KEY VALUE",
+		Short: "set a value of the config file, an empty value unsets it",
+		Long: "set a value of the config file, an empty value unsets it. " +
+			"defaultFailureConditions is given as a yaml list, e.g. glooshot config set defaultFailureConditions \"$(cat conditions.yaml)\"",
+		Args: cobra.ExactArgs(2),
+		RunE: func(c *cobra.Command, args []string) error {
+			if *configErr != nil {
+				if !o.Config.Force {
+					return errors.Wrapf(*configErr, "fix or remove the config file, or pass --force to replace it "+
+						"with a config file with only %v set", args[0])
+				}
+				fmt.Fprintf(os.Stderr, "replacing %v, its other values are dropped\n", o.Top.ConfigFile)
+			}
+			config := o.Top.Config
+			if err := config.Set(args[0], args[1]); err != nil {
+				return err
+			}
+			if err := options.WriteConfig(o.Top.ConfigFile, &config); err != nil {
+				return errors.Wrapf(err, "could not write config file")
+			}
+			fmt.Printf("set %v in %v\n", args[0], o.Top.ConfigFile)
+			return nil
+		},
+	}
+	cmd.Flags().BoolVar(&o.Config.Force, "force", false, "replace a config file which cannot be read, dropping its other values")
+	return cmd
+}
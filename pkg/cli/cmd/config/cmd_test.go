@@ -0,0 +1,62 @@
+package config_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/glooshot/pkg/cli/cmd/config"
+	"github.com/solo-io/glooshot/pkg/cli/options"
+)
+
+var _ = Describe("Config", func() {
+
+	var (
+		dir string
+		o   options.Options
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "glooshot-config")
+		Expect(err).NotTo(HaveOccurred())
+		o = options.InitialOptions(context.Background())
+		o.Top.ConfigFile = filepath.Join(dir, "config.yaml")
+		Expect(ioutil.WriteFile(o.Top.ConfigFile, []byte("namespace: [chaos"), 0644)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	run := func(args ...string) error {
+		cmd := config.Cmd(&o)
+		cmd.SetArgs(args)
+		return cmd.Execute()
+	}
+
+	It("leaves a malformed config file unchanged", func() {
+		err := run("set", options.ConfigKeyNamespace, "chaos")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("--force"))
+		b, err := ioutil.ReadFile(o.Top.ConfigFile)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(b)).To(Equal("namespace: [chaos"))
+	})
+
+	It("replaces a malformed config file if forced to", func() {
+		Expect(run("set", options.ConfigKeyNamespace, "chaos", "--force")).To(Succeed())
+		read, err := options.ReadConfig(o.Top.ConfigFile)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(read.Namespace).To(Equal("chaos"))
+	})
+
+	It("reports why a malformed config file cannot be viewed", func() {
+		err := run("view")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("invalid config file"))
+	})
+})
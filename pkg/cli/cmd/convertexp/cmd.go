@@ -0,0 +1,104 @@
+package convertexp
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/solo-io/glooshot/pkg/cli/convert"
+	"github.com/solo-io/glooshot/pkg/cli/manifest"
+	"github.com/solo-io/glooshot/pkg/cli/options"
+	"github.com/solo-io/glooshot/pkg/cli/printer"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/spf13/cobra"
+)
+
+func Cmd(o *options.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "convert",
+		Short: "convert the faults of other chaos tools into glooshot experiments",
+		Long: fmt.Sprintf("converts the network and http faults of Chaos Mesh resources or Litmus chaos engines into "+
+			"glooshot experiments, which are printed as yaml accepted by glooshot create experiment -f. Delays and aborts "+
+			"are mapped onto fault injections, and the pods selected by the faults onto the upstreams routing to them, "+
+			"which are read from the cluster. Fields which could not be mapped are reported on stderr. "+
+			"The format is one of %v.", strings.Join(convert.Formats, "|")),
+		RunE: func(c *cobra.Command, args []string) error {
+			return doConvert(o)
+		},
+	}
+	flags := cmd.Flags()
+	flags.StringVar(&o.Convert.From, "from", "", fmt.Sprintf("format of the faults, one of %v", strings.Join(convert.Formats, "|")))
+	flags.StringVarP(&o.Convert.File, "file", "f", "", "file containing the faults, - reads them from stdin")
+	return cmd
+}
+
+func doConvert(o *options.Options) error {
+	if o.Convert.From == "" {
+		return errors.Errorf("specify the format of the faults with --from")
+	}
+	if o.Convert.File == "" {
+		return errors.Errorf("specify the file containing the faults with -f")
+	}
+	var (
+		content []byte
+		err     error
+	)
+	if o.Convert.File == manifest.Stdin {
+		content, err = ioutil.ReadAll(os.Stdin)
+	} else {
+		content, err = ioutil.ReadFile(o.Convert.File)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "could not read faults")
+	}
+	upstreams, err := listUpstreams(o)
+	if err != nil {
+		return err
+	}
+	result, err := convert.Convert(content, o.Convert.From, upstreams)
+	if err != nil {
+		return err
+	}
+	for _, unmapped := range result.Unmapped {
+		fmt.Fprintf(os.Stderr, "could not map %v\n", unmapped)
+	}
+	if len(result.Experiments) == 0 {
+		return errors.Errorf("no experiments were converted")
+	}
+	var docs []string
+	for _, exp := range result.Experiments {
+		doc, err := printer.ExperimentYaml(exp)
+		if err != nil {
+			return err
+		}
+		docs = append(docs, string(doc))
+	}
+	fmt.Print(strings.Join(docs, "---\n"))
+	return nil
+}
+
+// lists the kubernetes upstreams of all namespaces along with the pods they route to
+func listUpstreams(o *options.Options) ([]convert.Upstream, error) {
+	var upstreams []convert.Upstream
+	for _, ns := range options.GetNamespaces(o) {
+		list, err := o.Clients.UpstreamClient().List(ns, clients.ListOpts{Ctx: o.Ctx})
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not list upstreams")
+		}
+		for _, us := range list {
+			kube := us.UpstreamSpec.GetKube()
+			if kube == nil {
+				continue
+			}
+			upstreams = append(upstreams, convert.Upstream{
+				Ref:       us.Metadata.Ref(),
+				Namespace: kube.ServiceNamespace,
+				Port:      kube.ServicePort,
+				Selector:  kube.Selector,
+			})
+		}
+	}
+	return upstreams, nil
+}
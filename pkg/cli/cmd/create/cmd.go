@@ -1,14 +1,29 @@
 package create
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
+	"os"
 
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/api"
+	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
 	v1 "github.com/solo-io/glooshot/pkg/api/v1"
+	"github.com/solo-io/glooshot/pkg/cli/flagutils"
+	"github.com/solo-io/glooshot/pkg/cli/manifest"
 	"github.com/solo-io/glooshot/pkg/cli/options"
-	"github.com/solo-io/go-utils/protoutils"
+	"github.com/solo-io/glooshot/pkg/cli/printer"
+	"github.com/solo-io/glooshot/pkg/cli/wizard"
+	"github.com/solo-io/glooshot/pkg/exptemplate"
+	"github.com/solo-io/glooshot/pkg/promquery"
+	"github.com/solo-io/glooshot/pkg/utils"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	skerrors "github.com/solo-io/solo-kit/pkg/errors"
 	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func Cmd(o *options.Options) *cobra.Command {
@@ -24,34 +39,288 @@ func Cmd(o *options.Options) *cobra.Command {
 
 func createExperimentsCmd(o *options.Options) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "experiment",
-		Short:   "create a glooshot experiment",
+		Use:   "experiment",
+		Short: "create a glooshot experiment",
+		Long: "creates glooshot experiments from a file, a directory or stdin, or with --interactive walks through the " +
+			"specification of an experiment, previews it and creates it or saves it to a file",
 		Aliases: options.ExperimentAliases,
 		RunE: func(c *cobra.Command, args []string) error {
 			return doCreateExperiments(o, c, args)
 		},
 	}
+	addManifestFlags(cmd, o)
 	pflags := cmd.PersistentFlags()
-	pflags.StringVarP(&o.Create.CreateFile, "file", "f", "",
-		"name of file containing the specification of the resource to be created")
+	pflags.StringVar(&o.Create.FromTemplate, "from-template", "",
+		"name of an experiment template in the namespace to render the experiment from")
+	pflags.StringArrayVar(&o.Create.Set, "set", nil,
+		"value of a parameter of the template, as key=value, may be repeated")
+	return cmd
+}
+
+// ApplyCmd creates experiments, or updates them if they exist
+func ApplyCmd(o *options.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: "create or update glooshot experiments",
+		Long: "creates the experiments of a file, a directory or stdin, or updates their specification if they exist. " +
+			"The results of updated experiments are kept.",
+		RunE: func(c *cobra.Command, args []string) error {
+			return doApplyExperiments(o, c, args)
+		},
+	}
+	addManifestFlags(cmd, o)
 	return cmd
 }
 
+func addManifestFlags(cmd *cobra.Command, o *options.Options) {
+	pflags := cmd.PersistentFlags()
+	pflags.StringVarP(&o.Create.CreateFile, "file", "f", "",
+		"file or directory containing the experiments, in yaml or json, or - to read them from stdin")
+	flagutils.AddMetadataFlags(pflags, &o.Metadata)
+}
+
 func doCreateExperiments(o *options.Options, cmd *cobra.Command, args []string) error {
+	if o.Create.FromTemplate != "" {
+		return createExperimentFromTemplate(o, args)
+	}
+	if o.Top.Interactive && o.Create.CreateFile == "" {
+		return createExperimentInteractively(o, args)
+	}
+	exps, err := readExperiments(o, cmd, args)
+	if err != nil {
+		return err
+	}
+	for _, exp := range exps {
+		if err := createExperiment(o, exp); err != nil {
+			return err
+		}
+		fmt.Printf("experiment %v.%v created\n", exp.Metadata.Namespace, exp.Metadata.Name)
+	}
+	return nil
+}
+
+func doApplyExperiments(o *options.Options, cmd *cobra.Command, args []string) error {
+	exps, err := readExperiments(o, cmd, args)
+	if err != nil {
+		return err
+	}
+	for _, exp := range exps {
+		if err := prepareExperiment(o, exp); err != nil {
+			return err
+		}
+		existing, err := o.Clients.ExpClient().Read(exp.Metadata.Namespace, exp.Metadata.Name, clients.ReadOpts{Ctx: o.Ctx})
+		if err != nil && !skerrors.IsNotExist(err) {
+			return err
+		}
+		result := "created"
+		if existing != nil {
+			exp.Metadata.ResourceVersion = existing.Metadata.ResourceVersion
+			exp.Result = existing.Result
+			result = "configured"
+		}
+		if _, err := o.Clients.ExpClient().Write(exp, clients.WriteOpts{Ctx: o.Ctx, OverwriteExisting: existing != nil}); err != nil {
+			return err
+		}
+		fmt.Printf("experiment %v.%v %v\n", exp.Metadata.Namespace, exp.Metadata.Name, result)
+	}
+	return nil
+}
+
+// reads the experiments of --file, with the metadata overridden by --name (or the first arg) and --namespace
+// experiments without a namespace are created in the default namespace
+func readExperiments(o *options.Options, cmd *cobra.Command, args []string) ([]*v1.Experiment, error) {
 	if o.Create.CreateFile == "" {
-		return fmt.Errorf("no experiment specification file provided")
+		return nil, errors.Errorf("no experiment specification file provided, use -f FILE, or -f - to read from stdin")
+	}
+	if o.Metadata.Name == "" && len(args) > 0 {
+		o.Metadata.Name = args[0]
+	}
+	exps, err := manifest.ReadExperiments(o.Create.CreateFile, os.Stdin)
+	if err != nil {
+		return nil, err
+	}
+	if len(exps) == 0 {
+		return nil, errors.Errorf("no experiments found in %v", o.Create.CreateFile)
+	}
+	if o.Metadata.Name != "" && len(exps) > 1 {
+		return nil, errors.Errorf("a name can only be given for a single experiment, found %v experiments", len(exps))
+	}
+	overrideNamespace := cmd.Flags().Changed("namespace")
+	for _, exp := range exps {
+		if o.Metadata.Name != "" {
+			exp.Metadata.Name = o.Metadata.Name
+		}
+		if overrideNamespace || exp.Metadata.Namespace == "" {
+			exp.Metadata.Namespace = o.Metadata.Namespace
+		}
+	}
+	return exps, nil
+}
+
+func createExperimentFromTemplate(o *options.Options, args []string) error {
+	if o.Create.CreateFile != "" {
+		return errors.Errorf("only one of --file and --from-template can be given")
+	}
+	if err := options.MetadataArgsParse(o, args, true); err != nil {
+		return err
+	}
+	values, err := exptemplate.ParseValues(o.Create.Set)
+	if err != nil {
+		return err
+	}
+	tmpl, err := o.Clients.ExperimentTemplateClient().Read(o.Metadata.Namespace, o.Create.FromTemplate, clients.ReadOpts{Ctx: o.Ctx})
+	if err != nil {
+		return errors.Wrapf(err, "could not read experiment template")
+	}
+	exp, err := exptemplate.Render(tmpl, core.Metadata{Namespace: o.Metadata.Namespace, Name: o.Metadata.Name}, values)
+	if err != nil {
+		return errors.Wrapf(err, "could not render experiment template %v", o.Create.FromTemplate)
+	}
+	if err := createExperiment(o, exp); err != nil {
+		return err
+	}
+	fmt.Printf("experiment %v.%v created from template %v\n", exp.Metadata.Namespace, exp.Metadata.Name, o.Create.FromTemplate)
+	return nil
+}
+
+func createExperiment(o *options.Options, exp *v1.Experiment) error {
+	if err := prepareExperiment(o, exp); err != nil {
+		return err
+	}
+	_, err := o.Clients.ExpClient().Write(exp, clients.WriteOpts{Ctx: o.Ctx, OverwriteExisting: false})
+	return err
+}
+
+// applies the defaults of the config file and validates the queries of the experiment
+func prepareExperiment(o *options.Options, exp *v1.Experiment) error {
+	if err := applyConfigDefaults(o.Top.Config, exp); err != nil {
+		return err
 	}
-	content, err := ioutil.ReadFile(o.Create.CreateFile)
+	if o.Top.Config.PrometheusUrl != "" {
+		if err := validateQueries(o.Ctx, o.Top.Config.PrometheusUrl, exp); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// what to do with the experiment once the wizard completes
+const (
+	actionCreate        = "create it"
+	actionSave          = "save it to a file"
+	actionCreateAndSave = "create it and save it to a file"
+	actionCancel        = "discard it"
+)
+
+func createExperimentInteractively(o *options.Options, args []string) error {
+	if err := options.MetadataArgsParse(o, args, true); err != nil {
+		return err
+	}
+	choices, err := wizardChoices(o)
 	if err != nil {
 		return err
 	}
-	exp := &v1.Experiment{}
-	if err := protoutils.UnmarshalYaml(content, exp); err != nil {
+	prompter := wizard.NewSurveyPrompter()
+	exp, err := wizard.NewExperiment(prompter, o.Metadata, choices)
+	if err != nil {
 		return err
 	}
-	_, err = o.Clients.ExpClient().Write(exp, clients.WriteOpts{OverwriteExisting: false})
+	doc, err := printer.ExperimentYaml(exp)
 	if err != nil {
 		return err
 	}
+	fmt.Printf("\n%s\n", doc)
+
+	action, err := prompter.Select("what would you like to do with the experiment?",
+		[]string{actionCreate, actionSave, actionCreateAndSave, actionCancel}, actionCreate)
+	if err != nil {
+		return err
+	}
+	if action == actionSave || action == actionCreateAndSave {
+		file, err := prompter.Input("file to save the experiment to", exp.Metadata.Name+".yaml", nil)
+		if err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(file, doc, 0644); err != nil {
+			return errors.Wrapf(err, "could not save experiment")
+		}
+		fmt.Printf("saved experiment to %v\n", file)
+	}
+	if action == actionCreate || action == actionCreateAndSave {
+		if err := createExperiment(o, exp); err != nil {
+			return err
+		}
+		fmt.Printf("experiment %v.%v created\n", exp.Metadata.Namespace, exp.Metadata.Name)
+	}
+	return nil
+}
+
+// lists the meshes, upstreams and services of all namespaces for the wizard to offer
+func wizardChoices(o *options.Options) (wizard.Choices, error) {
+	var choices wizard.Choices
+	for _, ns := range options.GetNamespaces(o) {
+		meshes, err := o.Clients.MeshClient().List(ns, clients.ListOpts{Ctx: o.Ctx})
+		if err != nil {
+			return choices, errors.Wrapf(err, "could not list meshes")
+		}
+		for _, mesh := range meshes {
+			ref := mesh.Metadata.Ref()
+			choices.Meshes = append(choices.Meshes, &ref)
+		}
+		upstreams, err := o.Clients.UpstreamClient().List(ns, clients.ListOpts{Ctx: o.Ctx})
+		if err != nil {
+			return choices, errors.Wrapf(err, "could not list upstreams")
+		}
+		for _, us := range upstreams {
+			ref := us.Metadata.Ref()
+			choices.Upstreams = append(choices.Upstreams, &ref)
+		}
+	}
+	services, err := o.Clients.KubeClient().CoreV1().Services(metav1.NamespaceAll).List(metav1.ListOptions{})
+	if err != nil {
+		return choices, errors.Wrapf(err, "could not list services")
+	}
+	for _, svc := range services.Items {
+		choices.Services = append(choices.Services, &core.ResourceRef{Namespace: svc.Namespace, Name: svc.Name})
+	}
+	return choices, nil
+}
+
+// fills in the target mesh and failure conditions of the experiment from the config file, if it does not specify them
+func applyConfigDefaults(config options.Config, exp *v1.Experiment) error {
+	if exp.Spec == nil {
+		exp.Spec = &v1.ExperimentSpec{}
+	}
+	if exp.Spec.TargetMesh == nil && config.TargetMesh != "" {
+		targetMesh, err := options.ParseResourceRef(config.TargetMesh)
+		if err != nil {
+			return errors.Wrapf(err, "invalid target mesh in config file")
+		}
+		exp.Spec.TargetMesh = targetMesh
+	}
+	if len(exp.Spec.FailureConditions) == 0 {
+		for _, fc := range config.DefaultFailureConditions {
+			exp.Spec.FailureConditions = append(exp.Spec.FailureConditions, proto.Clone(fc).(*v1.FailureCondition))
+		}
+	}
+	return nil
+}
+
+// runs the custom prometheus queries of the experiment once, so mistakes surface before the experiment is created
+func validateQueries(ctx context.Context, prometheusUrl string, exp *v1.Experiment) error {
+	promClient, err := api.NewClient(api.Config{Address: prometheusUrl})
+	if err != nil {
+		return errors.Wrapf(err, "connecting to prometheus")
+	}
+	promApi := promv1.NewAPI(promClient)
+	for _, fc := range utils.FlattenFailureConditions(exp.Spec.FailureConditions) {
+		query := fc.Trigger.GetPrometheus().GetCustomQuery()
+		if query == "" {
+			continue
+		}
+		if _, err := promquery.QueryScalar(ctx, promApi, promquery.Query(query)); err != nil {
+			return errors.Wrapf(err, "invalid query of failure condition %v", fc.Name)
+		}
+	}
 	return nil
 }
@@ -0,0 +1,57 @@
+package describe
+
+import (
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/solo-io/glooshot/pkg/cli/export"
+	"github.com/solo-io/glooshot/pkg/cli/flagutils"
+	"github.com/solo-io/glooshot/pkg/cli/options"
+	"github.com/solo-io/glooshot/pkg/cli/printer"
+	"github.com/solo-io/glooshot/pkg/translator"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/spf13/cobra"
+)
+
+func Cmd(o *options.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "describe",
+		Short: "show details of a glooshot resource",
+	}
+	cmd.AddCommand(
+		describeExperimentCmd(o),
+	)
+	pflags := cmd.PersistentFlags()
+	flagutils.AddMetadataFlags(pflags, &o.Metadata)
+	return cmd
+}
+
+func describeExperimentCmd(o *options.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "experiment",
+		Short:   "show the spec, progress, result and generated routing rules of an experiment",
+		Aliases: options.ExperimentAliases,
+		RunE: func(c *cobra.Command, args []string) error {
+			return doDescribeExperiment(o, args)
+		},
+	}
+	return cmd
+}
+
+func doDescribeExperiment(o *options.Options, args []string) error {
+	if err := options.MetadataArgsParse(o, args, true); err != nil {
+		return err
+	}
+	report, err := export.ReadExperimentReport(o.Clients.ExpClient(), o.Clients.ReportClient(), o.Metadata.Namespace, o.Metadata.Name)
+	if err != nil {
+		return err
+	}
+	routingRules, err := o.Clients.RoutingRuleClient().List(o.Metadata.Namespace, clients.ListOpts{
+		Selector: translator.LabelsForRoutingRule(o.Metadata.Name),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "could not list routing rules of experiment")
+	}
+	return printer.DescribeExperiment(os.Stdout, report.Experiment, report.Report, routingRules, time.Now())
+}
@@ -0,0 +1,80 @@
+package exportreports
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/solo-io/glooshot/pkg/cli/export"
+	"github.com/solo-io/glooshot/pkg/cli/flagutils"
+	"github.com/solo-io/glooshot/pkg/cli/options"
+	"github.com/solo-io/glooshot/pkg/cli/printer"
+	"github.com/spf13/cobra"
+)
+
+func Cmd(o *options.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "export glooshot resources for use by other tools",
+	}
+	cmd.AddCommand(
+		exportReportsCmd(o),
+	)
+	return cmd
+}
+
+func exportReportsCmd(o *options.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reports",
+		Short: "export the reports of glooshot experiments",
+		Long: fmt.Sprintf("export experiments along with their reports. The output format is one of %v and defaults to %v. "+
+			"Failure conditions are rendered as test cases, the condition an experiment failed on as a failure.",
+			strings.Join(export.Formats, "|"), export.FormatJunit),
+		Aliases: options.ReportAliases,
+		RunE: func(c *cobra.Command, args []string) error {
+			return doExportReports(o)
+		},
+	}
+	pflags := cmd.PersistentFlags()
+	flagutils.AddMetadataFlags(pflags, &o.Metadata)
+	pflags.BoolVar(&o.Export.AllNamespaces, "all-namespaces", false, "if set, exports experiments from all namespaces")
+	pflags.DurationVar(&o.Export.Since, "since", 0, "only export experiments which started within this duration, e.g. 24h. Exports all experiments if not set")
+	pflags.StringVarP(&o.Export.File, "file", "f", "", "file to write the export to, defaults to stdout")
+	return cmd
+}
+
+func doExportReports(o *options.Options) (err error) {
+	namespaces := []string{o.Metadata.Namespace}
+	if o.Export.AllNamespaces {
+		namespaces = options.GetNamespaces(o)
+	}
+	var since time.Time
+	if o.Export.Since > 0 {
+		since = time.Now().Add(-o.Export.Since)
+	}
+	reports, err := export.ListExperimentReports(o.Clients.ExpClient(), o.Clients.ReportClient(), namespaces, since)
+	if err != nil {
+		return err
+	}
+	var w io.Writer = os.Stdout
+	if o.Export.File != "" {
+		f, createErr := os.Create(o.Export.File)
+		if createErr != nil {
+			return errors.Wrapf(createErr, "could not create export file")
+		}
+		defer func() {
+			if closeErr := f.Close(); closeErr != nil && err == nil {
+				err = errors.Wrapf(closeErr, "could not write export file")
+			}
+		}()
+		w = f
+	}
+	format := o.Top.Output
+	if format == "" || format == printer.OutputTable || format == printer.OutputWide {
+		format = export.FormatJunit
+	}
+	return export.Render(w, format, reports)
+}
@@ -1,12 +1,17 @@
 package get
 
 import (
+	"os"
+
 	"github.com/pkg/errors"
 	v1 "github.com/solo-io/glooshot/pkg/api/v1"
+	"github.com/solo-io/glooshot/pkg/cli/export"
 	"github.com/solo-io/glooshot/pkg/cli/flagutils"
 	"github.com/solo-io/glooshot/pkg/cli/options"
 	"github.com/solo-io/glooshot/pkg/cli/printer"
+	"github.com/solo-io/glooshot/pkg/utils"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	skerrors "github.com/solo-io/solo-kit/pkg/errors"
 	"github.com/spf13/cobra"
 )
 
@@ -17,7 +22,14 @@ func Cmd(o *options.Options) *cobra.Command {
 	}
 	cmd.AddCommand(
 		getExperimentsCmd(o),
+		getReportCmd(o),
+		getTemplatesCmd(o),
+		getSuitesCmd(o),
 	)
+	pflags := cmd.PersistentFlags()
+	flagutils.AddMetadataFlags(pflags, &o.Metadata)
+	pflags.BoolVar(&o.Get.AllNamespaces, "all-namespaces", false, "if set, queries all namespaces")
+	pflags.StringVarP(&o.Get.Selector, "selector", "l", "", "label selector to filter on, supports '=' and '==', e.g. -l key1=value1,key2=value2")
 	return cmd
 }
 
@@ -30,9 +42,7 @@ func getExperimentsCmd(o *options.Options) *cobra.Command {
 			return doGetExperiments(o, c, args)
 		},
 	}
-	pflags := cmd.PersistentFlags()
-	flagutils.AddMetadataFlags(pflags, &o.Metadata)
-	pflags.BoolVar(&o.Get.AllNamespaces, "all-namespaces", false, "if set, queries all namespaces")
+	cmd.Flags().StringVar(&o.Get.History, "history", "", "lists all runs of the named experiment, including its reruns")
 	return cmd
 }
 
@@ -40,31 +50,182 @@ func doGetExperiments(o *options.Options, cmd *cobra.Command, args []string) err
 	if err := options.MetadataArgsParse(o, args, false); err != nil {
 		return err
 	}
+	if o.Get.History != "" {
+		return getHistory(o)
+	}
 	if o.Metadata.Namespace != "" && o.Metadata.Name != "" {
 		exp, err := o.Clients.ExpClient().Read(o.Metadata.Namespace, o.Metadata.Name, clients.ReadOpts{})
 		if err != nil {
 			return errors.Wrapf(err, "could not get experiments")
 		}
-		printer.Experiment(*exp)
-		return nil
+		return printer.PrintExperiment(exp, o.Top.Output)
+	}
+	listOpts, err := listOpts(o)
+	if err != nil {
+		return err
 	}
 	exps := []*v1.Experiment{}
-	if o.Get.AllNamespaces {
-		for _, ns := range options.GetNamespaces(o) {
-			nsExps, err := o.Clients.ExpClient().List(ns, clients.ListOpts{})
-			if err != nil {
-				return err
-			}
-			exps = append(exps, nsExps...)
+	for _, ns := range namespaces(o) {
+		nsExps, err := o.Clients.ExpClient().List(ns, listOpts)
+		if err != nil {
+			return err
 		}
+		exps = append(exps, nsExps...)
+	}
+	return printer.PrintExperiments(exps, o.Top.Output)
+}
 
-	} else {
-		var err error
-		exps, err = o.Clients.ExpClient().List(o.Metadata.Namespace, clients.ListOpts{})
+// prints the runs of the experiment named by --history, along with their outcomes
+func getHistory(o *options.Options) error {
+	lineage := o.Get.History
+	exp, err := o.Clients.ExpClient().Read(o.Metadata.Namespace, o.Get.History, clients.ReadOpts{Ctx: o.Ctx})
+	switch {
+	case err == nil:
+		lineage = utils.Lineage(exp)
+	case !skerrors.IsNotExist(err):
+		return errors.Wrapf(err, "could not get experiment")
+	}
+	exps, err := o.Clients.ExpClient().List(o.Metadata.Namespace, clients.ListOpts{Ctx: o.Ctx})
+	if err != nil {
+		return errors.Wrapf(err, "could not get experiments")
+	}
+	runs := utils.RunsOf(exps, lineage)
+	if len(runs) == 0 {
+		return errors.Errorf("no runs of experiment %v found in namespace %v", o.Get.History, o.Metadata.Namespace)
+	}
+	return printer.PrintHistory(runs, o.Top.Output)
+}
+
+func getReportCmd(o *options.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "reports",
+		Short:   "get the reports of glooshot experiments",
+		Long:    "lists the reports of glooshot experiments. If a name is given, renders the report along with its experiment, the output format may then also be one of junit|markdown and defaults to yaml.",
+		Aliases: options.ReportAliases,
+		RunE: func(c *cobra.Command, args []string) error {
+			return doGetReports(o, c, args)
+		},
+	}
+	return cmd
+}
+
+func doGetReports(o *options.Options, cmd *cobra.Command, args []string) error {
+	if err := options.MetadataArgsParse(o, args, false); err != nil {
+		return err
+	}
+	if o.Metadata.Namespace != "" && o.Metadata.Name != "" {
+		report, err := export.ReadExperimentReport(o.Clients.ExpClient(), o.Clients.ReportClient(), o.Metadata.Namespace, o.Metadata.Name)
 		if err != nil {
 			return err
 		}
+		format := o.Top.Output
+		if format == "" || format == printer.OutputTable || format == printer.OutputWide {
+			format = export.FormatYaml
+		}
+		return export.RenderOne(os.Stdout, format, report)
+	}
+	listOpts, err := listOpts(o)
+	if err != nil {
+		return err
+	}
+	reports := []*v1.Report{}
+	for _, ns := range namespaces(o) {
+		nsReports, err := o.Clients.ReportClient().List(ns, listOpts)
+		if err != nil {
+			return errors.Wrapf(err, "could not get reports")
+		}
+		reports = append(reports, nsReports...)
+	}
+	return printer.PrintReports(reports, o.Top.Output)
+}
+
+func getTemplatesCmd(o *options.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "experimenttemplate",
+		Short:   "get glooshot experiment templates and their parameters",
+		Aliases: options.TemplateAliases,
+		RunE: func(c *cobra.Command, args []string) error {
+			return doGetTemplates(o, c, args)
+		},
+	}
+	return cmd
+}
+
+func doGetTemplates(o *options.Options, cmd *cobra.Command, args []string) error {
+	if err := options.MetadataArgsParse(o, args, false); err != nil {
+		return err
+	}
+	if o.Metadata.Namespace != "" && o.Metadata.Name != "" {
+		tmpl, err := o.Clients.ExperimentTemplateClient().Read(o.Metadata.Namespace, o.Metadata.Name, clients.ReadOpts{Ctx: o.Ctx})
+		if err != nil {
+			return errors.Wrapf(err, "could not get experiment templates")
+		}
+		return printer.PrintTemplate(tmpl, o.Top.Output)
+	}
+	listOpts, err := listOpts(o)
+	if err != nil {
+		return err
+	}
+	templates := []*v1.ExperimentTemplate{}
+	for _, ns := range namespaces(o) {
+		nsTemplates, err := o.Clients.ExperimentTemplateClient().List(ns, listOpts)
+		if err != nil {
+			return errors.Wrapf(err, "could not get experiment templates")
+		}
+		templates = append(templates, nsTemplates...)
+	}
+	return printer.PrintTemplates(templates, o.Top.Output)
+}
+
+func getSuitesCmd(o *options.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "experimentsuite",
+		Short:   "get glooshot experiment suites and their results",
+		Aliases: options.SuiteAliases,
+		RunE: func(c *cobra.Command, args []string) error {
+			return doGetSuites(o, c, args)
+		},
+	}
+	return cmd
+}
+
+func doGetSuites(o *options.Options, cmd *cobra.Command, args []string) error {
+	if err := options.MetadataArgsParse(o, args, false); err != nil {
+		return err
+	}
+	if o.Metadata.Namespace != "" && o.Metadata.Name != "" {
+		s, err := o.Clients.ExperimentSuiteClient().Read(o.Metadata.Namespace, o.Metadata.Name, clients.ReadOpts{Ctx: o.Ctx})
+		if err != nil {
+			return errors.Wrapf(err, "could not get experiment suites")
+		}
+		return printer.PrintSuite(s, o.Top.Output)
+	}
+	listOpts, err := listOpts(o)
+	if err != nil {
+		return err
+	}
+	suites := []*v1.ExperimentSuite{}
+	for _, ns := range namespaces(o) {
+		nsSuites, err := o.Clients.ExperimentSuiteClient().List(ns, listOpts)
+		if err != nil {
+			return errors.Wrapf(err, "could not get experiment suites")
+		}
+		suites = append(suites, nsSuites...)
+	}
+	return printer.PrintSuites(suites, o.Top.Output)
+}
+
+func namespaces(o *options.Options) []string {
+	if o.Get.AllNamespaces {
+		return options.GetNamespaces(o)
+	}
+	return []string{o.Metadata.Namespace}
+}
+
+func listOpts(o *options.Options) (clients.ListOpts, error) {
+	selector, err := options.SelectorLabels(o.Get.Selector)
+	if err != nil {
+		return clients.ListOpts{}, err
 	}
-	printer.PrintExperiments(exps, "")
-	return nil
+	return clients.ListOpts{Ctx: o.Ctx, Selector: selector}, nil
 }
@@ -0,0 +1,226 @@
+package initexp
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/pkg/errors"
+	"github.com/solo-io/glooshot/pkg/cli/gsutil"
+	kubeerrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/restmapper"
+)
+
+// how often the cluster is polled while waiting for crds and the operator
+const pollInterval = time.Second
+
+// creates, updates and deletes the resources of rendered manifests with the dynamic client
+type applier struct {
+	dynamic   dynamic.Interface
+	discovery discovery.DiscoveryInterface
+	mapper    meta.RESTMapper
+	// the namespace of the resources which do not specify one
+	namespace string
+	// how long to wait for crds to be established
+	timeout time.Duration
+}
+
+func newApplier(namespace string, timeout time.Duration) (*applier, error) {
+	cfg, err := gsutil.GetRestConfig()
+	if err != nil {
+		return nil, err
+	}
+	dynamicClient, err := dynamic.NewForConfig(cfg)
+	if err != nil {
+		return nil, errors.Wrapf(err, "creating dynamic kubernetes client")
+	}
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(cfg)
+	if err != nil {
+		return nil, errors.Wrapf(err, "creating kubernetes discovery client")
+	}
+	a := &applier{dynamic: dynamicClient, discovery: discoveryClient, namespace: namespace, timeout: timeout}
+	if err := a.refreshMapper(); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// discovers the resources of the cluster, including those of crds created since the last discovery
+func (a *applier) refreshMapper() error {
+	groups, err := restmapper.GetAPIGroupResources(a.discovery)
+	if err != nil {
+		return errors.Wrapf(err, "discovering kubernetes resources")
+	}
+	a.mapper = restmapper.NewDiscoveryRESTMapper(groups)
+	return nil
+}
+
+// creates the resources of the manifest, or updates them if they exist, the crds first so that the resources
+// they define can be created
+func (a *applier) apply(resources []manifestResource) error {
+	crds, others := splitCrds(resources)
+	for _, crd := range crds {
+		if err := a.applyResource(crd); err != nil {
+			return err
+		}
+	}
+	if len(crds) > 0 {
+		if err := a.waitForCrds(crds); err != nil {
+			return err
+		}
+	}
+	for _, res := range others {
+		if err := a.applyResource(res); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *applier) applyResource(res manifestResource) error {
+	obj, client, err := a.resourceClient(res)
+	if err != nil {
+		return err
+	}
+	existing, err := client.Get(obj.GetName(), metav1.GetOptions{})
+	switch {
+	case kubeerrs.IsNotFound(err):
+		if _, err := client.Create(obj, metav1.CreateOptions{}); err != nil {
+			return errors.Wrapf(err, "creating %v", res)
+		}
+		fmt.Printf("%v created\n", res)
+		return nil
+	case err != nil:
+		return errors.Wrapf(err, "reading %v", res)
+	}
+	// a merge patch leaves the fields the cluster fills in, like the cluster ip of services, untouched
+	patch, err := json.Marshal(obj.Object)
+	if err != nil {
+		return err
+	}
+	patched, err := client.Patch(obj.GetName(), types.MergePatchType, patch, metav1.UpdateOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "updating %v", res)
+	}
+	if patched.GetResourceVersion() == existing.GetResourceVersion() {
+		fmt.Printf("%v unchanged\n", res)
+	} else {
+		fmt.Printf("%v configured\n", res)
+	}
+	return nil
+}
+
+// deletes the resources of the manifest in reverse order, resources which do not exist are skipped
+func (a *applier) delete(resources []manifestResource) error {
+	for i := len(resources) - 1; i >= 0; i-- {
+		res := resources[i]
+		obj, client, err := a.resourceClient(res)
+		if meta.IsNoMatchError(errors.Cause(err)) {
+			// the crd of the resource was deleted already
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		err = client.Delete(obj.GetName(), &metav1.DeleteOptions{})
+		if kubeerrs.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return errors.Wrapf(err, "deleting %v", res)
+		}
+		fmt.Printf("%v deleted\n", res)
+	}
+	return nil
+}
+
+// parses the resource and returns the client for its kind, in its namespace if it is namespaced
+func (a *applier) resourceClient(res manifestResource) (*unstructured.Unstructured, dynamic.ResourceInterface, error) {
+	jsn, err := yaml.YAMLToJSON([]byte(res.content))
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "parsing %v", res)
+	}
+	obj := &unstructured.Unstructured{}
+	if err := obj.UnmarshalJSON(jsn); err != nil {
+		return nil, nil, errors.Wrapf(err, "parsing %v", res)
+	}
+	gvk := obj.GroupVersionKind()
+	mapping, err := a.mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "%v", res)
+	}
+	if mapping.Scope.Name() != meta.RESTScopeNameNamespace {
+		return obj, a.dynamic.Resource(mapping.Resource), nil
+	}
+	if obj.GetNamespace() == "" {
+		obj.SetNamespace(a.namespace)
+	}
+	return obj, a.dynamic.Resource(mapping.Resource).Namespace(obj.GetNamespace()), nil
+}
+
+// waits until the resources defined by the crds are served
+func (a *applier) waitForCrds(crds []manifestResource) error {
+	var kinds []schema.GroupKind
+	for _, crd := range crds {
+		var definition struct {
+			Spec struct {
+				Group string `json:"group"`
+				Names struct {
+					Kind string `json:"kind"`
+				} `json:"names"`
+			} `json:"spec"`
+		}
+		if err := yaml.Unmarshal([]byte(crd.content), &definition); err != nil {
+			return errors.Wrapf(err, "parsing %v", crd)
+		}
+		kinds = append(kinds, schema.GroupKind{Group: definition.Spec.Group, Kind: definition.Spec.Names.Kind})
+	}
+	err := wait.PollImmediate(pollInterval, a.timeout, func() (bool, error) {
+		if err := a.refreshMapper(); err != nil {
+			return false, err
+		}
+		for _, kind := range kinds {
+			if _, err := a.mapper.RESTMapping(kind); err != nil {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		return errors.Wrapf(err, "waiting for the glooshot crds to be established")
+	}
+	return nil
+}
+
+// waits until all replicas of the operator are updated and available
+func waitForOperator(kube kubernetes.Interface, namespace string, timeout time.Duration) error {
+	fmt.Printf("waiting for deployment %v to become ready\n", gsutil.OperatorDeployment)
+	var status string
+	err := wait.PollImmediate(pollInterval, timeout, func() (bool, error) {
+		deployment, err := kube.AppsV1().Deployments(namespace).Get(gsutil.OperatorDeployment, metav1.GetOptions{})
+		if err != nil {
+			return false, errors.Wrapf(err, "reading deployment %v", gsutil.OperatorDeployment)
+		}
+		var ready bool
+		ready, status = gsutil.DeploymentReady(deployment)
+		return ready, nil
+	})
+	if err == wait.ErrWaitTimeout {
+		return errors.Errorf("deployment %v did not become ready within %v: %v", gsutil.OperatorDeployment, timeout, status)
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Printf("deployment %v is ready: %v\n", gsutil.OperatorDeployment, status)
+	return nil
+}
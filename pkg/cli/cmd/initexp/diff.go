@@ -0,0 +1,170 @@
+package initexp
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/pkg/errors"
+	"github.com/solo-io/glooshot/pkg/cli/manifest"
+)
+
+const crdKind = "CustomResourceDefinition"
+
+// a resource of a rendered manifest
+type manifestResource struct {
+	kind string
+	name string
+	// the document of the resource, without the comments helm adds with the name of its template
+	content string
+}
+
+func (r manifestResource) String() string {
+	return r.kind + " " + r.name
+}
+
+// splits the manifest into its resources, in the order they are rendered
+func splitManifest(content string) ([]manifestResource, error) {
+	var resources []manifestResource
+	for i, doc := range manifest.Documents([]byte(content)) {
+		var lines []string
+		for _, line := range strings.Split(doc, "\n") {
+			if strings.HasPrefix(line, "#") {
+				continue
+			}
+			lines = append(lines, line)
+		}
+		doc = strings.TrimSpace(strings.Join(lines, "\n"))
+		if doc == "" {
+			continue
+		}
+		var head struct {
+			Kind     string `json:"kind"`
+			Metadata struct {
+				Name string `json:"name"`
+			} `json:"metadata"`
+		}
+		if err := yaml.Unmarshal([]byte(doc), &head); err != nil {
+			return nil, errors.Wrapf(err, "invalid manifest document %v", i+1)
+		}
+		resources = append(resources, manifestResource{kind: head.Kind, name: head.Metadata.Name, content: doc})
+	}
+	return resources, nil
+}
+
+// joins the resources into a manifest
+func joinManifest(resources []manifestResource) string {
+	var docs []string
+	for _, res := range resources {
+		docs = append(docs, res.content)
+	}
+	return strings.Join(docs, "\n---\n") + "\n"
+}
+
+// returns the custom resource definitions of the manifest and its other resources
+func splitCrds(resources []manifestResource) ([]manifestResource, []manifestResource) {
+	var crds, others []manifestResource
+	for _, res := range resources {
+		if res.kind == crdKind {
+			crds = append(crds, res)
+		} else {
+			others = append(others, res)
+		}
+	}
+	return crds, others
+}
+
+// manifestDiff are the changes between two manifests
+type manifestDiff struct {
+	added   []manifestResource
+	removed []manifestResource
+	// the lines of the changed resources, prefixed with + or -
+	changed map[string][]string
+	// the changed resources, in the order of the new manifest
+	changedOrder []string
+}
+
+func (d manifestDiff) empty() bool {
+	return len(d.added) == 0 && len(d.removed) == 0 && len(d.changed) == 0
+}
+
+func diffManifests(previous, current []manifestResource) manifestDiff {
+	diff := manifestDiff{changed: make(map[string][]string)}
+	previousByKey := make(map[string]manifestResource)
+	for _, res := range previous {
+		previousByKey[res.String()] = res
+	}
+	currentKeys := make(map[string]bool)
+	for _, res := range current {
+		key := res.String()
+		currentKeys[key] = true
+		old, ok := previousByKey[key]
+		switch {
+		case !ok:
+			diff.added = append(diff.added, res)
+		case old.content != res.content:
+			diff.changed[key] = diffLines(strings.Split(old.content, "\n"), strings.Split(res.content, "\n"))
+			diff.changedOrder = append(diff.changedOrder, key)
+		}
+	}
+	for _, res := range previous {
+		if !currentKeys[res.String()] {
+			diff.removed = append(diff.removed, res)
+		}
+	}
+	return diff
+}
+
+// the lines printed for the diff, changed resources list their changed lines
+func (d manifestDiff) lines() []string {
+	var lines []string
+	for _, res := range d.added {
+		lines = append(lines, fmt.Sprintf("+ %v", res))
+	}
+	for _, res := range d.removed {
+		lines = append(lines, fmt.Sprintf("- %v", res))
+	}
+	for _, key := range d.changedOrder {
+		lines = append(lines, fmt.Sprintf("~ %v", key))
+		for _, line := range d.changed[key] {
+			lines = append(lines, "    "+line)
+		}
+	}
+	return lines
+}
+
+// returns the removed and added lines, based on the longest common subsequence of the lines
+func diffLines(a, b []string) []string {
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+	var lines []string
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			i++
+			j++
+		case j == len(b) || (i < len(a) && lcs[i+1][j] >= lcs[i][j+1]):
+			lines = append(lines, "- "+a[i])
+			i++
+		default:
+			lines = append(lines, "+ "+b[j])
+			j++
+		}
+	}
+	return lines
+}
@@ -0,0 +1,102 @@
+package initexp
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+const installedManifest = `---
+# Source: glooshot/templates/crds.yaml
+apiVersion: apiextensions.k8s.io/v1beta1
+kind: CustomResourceDefinition
+metadata:
+  name: experiments.glooshot.solo.io
+---
+# Source: glooshot/templates/configmap.yaml
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: glooshot-config
+data:
+  refreshRate: 1s
+---
+# Source: glooshot/templates/deployment.yaml
+apiVersion: extensions/v1beta1
+kind: Deployment
+metadata:
+  name: glooshot
+spec:
+  replicas: 1
+  template:
+    spec:
+      containers:
+      - image: "soloio/glooshot-op:0.0.5"
+        name: glooshot
+`
+
+const upgradedManifest = `---
+# Source: glooshot/templates/crds.yaml
+apiVersion: apiextensions.k8s.io/v1beta1
+kind: CustomResourceDefinition
+metadata:
+  name: experiments.glooshot.solo.io
+---
+# Source: glooshot/templates/deployment.yaml
+apiVersion: extensions/v1beta1
+kind: Deployment
+metadata:
+  name: glooshot
+spec:
+  replicas: 1
+  template:
+    spec:
+      containers:
+      - image: "soloio/glooshot-op:0.0.6"
+        name: glooshot
+---
+# Source: glooshot/templates/serviceaccount.yaml
+apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: glooshot-serviceaccount
+`
+
+var _ = Describe("Manifest diff", func() {
+
+	It("splits a manifest into its resources", func() {
+		resources, err := splitManifest(installedManifest)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resources).To(HaveLen(3))
+		Expect(resources[0].String()).To(Equal("CustomResourceDefinition experiments.glooshot.solo.io"))
+		Expect(resources[2].content).To(HavePrefix("apiVersion: extensions/v1beta1\nkind: Deployment"))
+
+		crds, others := splitCrds(resources)
+		Expect(crds).To(Equal(resources[:1]))
+		Expect(others).To(Equal(resources[1:]))
+		Expect(joinManifest(others)).To(HavePrefix("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: glooshot-config\ndata:\n  refreshRate: 1s\n---\napiVersion: extensions/v1beta1\n"))
+	})
+
+	It("lists the added, removed and changed resources", func() {
+		previous, err := splitManifest(installedManifest)
+		Expect(err).NotTo(HaveOccurred())
+		current, err := splitManifest(upgradedManifest)
+		Expect(err).NotTo(HaveOccurred())
+
+		diff := diffManifests(previous, current)
+		Expect(diff.empty()).To(BeFalse())
+		Expect(diff.lines()).To(Equal([]string{
+			"+ ServiceAccount glooshot-serviceaccount",
+			"- ConfigMap glooshot-config",
+			"~ Deployment glooshot",
+			`    -       - image: "soloio/glooshot-op:0.0.5"`,
+			`    +       - image: "soloio/glooshot-op:0.0.6"`,
+		}))
+		Expect(diffManifests(current, current).empty()).To(BeTrue())
+	})
+
+	It("diffs lines", func() {
+		Expect(diffLines([]string{"a", "b", "c"}, []string{"a", "c", "d"})).To(Equal([]string{"- b", "+ d"}))
+		Expect(diffLines(nil, []string{"a"})).To(Equal([]string{"+ a"}))
+		Expect(diffLines([]string{"a"}, nil)).To(Equal([]string{"- a"}))
+	})
+})
@@ -1,12 +1,9 @@
 package initexp
 
 import (
-	"bytes"
 	"fmt"
-	"io"
 	"io/ioutil"
-	"os"
-	"os/exec"
+	"time"
 
 	"github.com/solo-io/glooshot/pkg/version"
 
@@ -39,11 +36,65 @@ func Cmd(opts *options.Options) *cobra.Command {
 		},
 	}
 	flagutils.AddInitFlags(cmd.PersistentFlags(), &opts.Init)
+	addTimeoutFlag(cmd, opts)
 	return cmd
 }
 
 func installGlooshot(opts *options.Options) error {
+	if _, err := opts.Clients.KubeClient().CoreV1().Namespaces().Create(&v1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: opts.Init.InstallNamespace},
+	}); err != nil && !kubeerrs.IsAlreadyExists(err) {
+		return errors.Wrapf(err, "creating namespace")
+	}
+
+	rendered, err := renderManifest(opts)
+	if err != nil {
+		return err
+	}
+
+	if opts.Init.DryRun {
+		fmt.Printf("%s\n", rendered.manifest)
+		return nil
+	}
+
+	resources, err := splitManifest(rendered.manifest)
+	if err != nil {
+		return err
+	}
+	applier, err := newApplier(opts.Init.InstallNamespace, opts.Init.Timeout)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("installing glooshot version %v\nusing chart uri %v\n", rendered.version, rendered.chartUri)
+
+	if err := applier.apply(resources); err != nil {
+		return err
+	}
+	if err := writeInstallRecord(opts, rendered); err != nil {
+		return err
+	}
+	if err := waitForOperator(opts.Clients.KubeClient(), opts.Init.InstallNamespace, opts.Init.Timeout); err != nil {
+		return err
+	}
+
+	fmt.Printf("install successful!\n")
+	return nil
+}
+
+// a manifest rendered from the glooshot helm chart
+type renderedManifest struct {
+	version  string
+	chartUri string
+	manifest string
+}
+
+// renders the chart of the release given with --release, or of the version of the cli, unless a chart is given with -f
+func renderManifest(opts *options.Options) (renderedManifest, error) {
 	releaseVersion := version.Version
+	if opts.Init.ReleaseVersion != "" {
+		releaseVersion = opts.Init.ReleaseVersion
+	}
 
 	// Get location of Gloo helm chart
 	chartUri := fmt.Sprintf(gsChartUriTemplate, releaseVersion)
@@ -53,13 +104,7 @@ func installGlooshot(opts *options.Options) error {
 
 	values, err := readValues(opts.Init.HelmValues)
 	if err != nil {
-		return errors.Wrapf(err, "reading custom values")
-	}
-
-	if _, err := opts.Clients.KubeClient().CoreV1().Namespaces().Create(&v1.Namespace{
-		ObjectMeta: metav1.ObjectMeta{Name: opts.Init.InstallNamespace},
-	}); err != nil && !kubeerrs.IsAlreadyExists(err) {
-		return errors.Wrapf(err, "creating namespace")
+		return renderedManifest{}, errors.Wrapf(err, "reading custom values")
 	}
 
 	manifests, err := helmchart.RenderManifests(opts.Ctx,
@@ -70,24 +115,14 @@ func installGlooshot(opts *options.Options) error {
 		"",
 	)
 	if err != nil {
-		return errors.Wrapf(err, "rendering manifest from uri: %v", chartUri)
-	}
-
-	manifest := manifests.CombinedString()
-
-	if opts.Init.DryRun {
-		fmt.Printf("%s\n", manifest)
-		return nil
-	}
-
-	fmt.Printf("installing glooshot version %v\nusing chart uri %v\n", releaseVersion, chartUri)
-
-	if err := kubectlApply(manifest, opts.Init.InstallNamespace); err != nil {
-		return errors.Wrapf(err, "executing kubectl failed")
+		return renderedManifest{}, errors.Wrapf(err, "rendering manifest from uri: %v", chartUri)
 	}
+	return renderedManifest{version: releaseVersion, chartUri: chartUri, manifest: manifests.CombinedString()}, nil
+}
 
-	fmt.Printf("install successful!\n")
-	return nil
+func addTimeoutFlag(cmd *cobra.Command, opts *options.Options) {
+	cmd.PersistentFlags().DurationVar(&opts.Init.Timeout, "timeout", 5*time.Minute,
+		"how long to wait for the glooshot crds to be established and for the glooshot deployment to become ready")
 }
 
 func readValues(path string) (string, error) {
@@ -100,17 +135,3 @@ func readValues(path string) (string, error) {
 	}
 	return string(b), nil
 }
-
-func kubectlApply(manifest, namespace string) error {
-	return kubectl(bytes.NewBufferString(manifest), "apply", "-n", namespace, "-f", "-")
-}
-
-func kubectl(stdin io.Reader, args ...string) error {
-	kubectl := exec.Command("kubectl", args...)
-	if stdin != nil {
-		kubectl.Stdin = stdin
-	}
-	kubectl.Stdout = os.Stdout
-	kubectl.Stderr = os.Stderr
-	return kubectl.Run()
-}
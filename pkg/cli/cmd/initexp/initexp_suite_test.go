@@ -0,0 +1,13 @@
+package initexp_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestInitexp(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Initexp Suite")
+}
@@ -0,0 +1,66 @@
+package initexp
+
+import (
+	"github.com/pkg/errors"
+	"github.com/solo-io/glooshot/pkg/cli/options"
+	v1 "k8s.io/api/core/v1"
+	kubeerrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// the config map recording the manifest glooshot was installed or upgraded with, which is what uninstall deletes
+// and what upgrade diffs against
+const installRecordName = "glooshot-install"
+
+const (
+	recordKeyVersion  = "version"
+	recordKeyChartUri = "chart"
+	recordKeyManifest = "manifest"
+)
+
+func writeInstallRecord(opts *options.Options, rendered renderedManifest) error {
+	configMaps := opts.Clients.KubeClient().CoreV1().ConfigMaps(opts.Init.InstallNamespace)
+	record := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   installRecordName,
+			Labels: map[string]string{"app": "glooshot"},
+		},
+		Data: map[string]string{
+			recordKeyVersion:  rendered.version,
+			recordKeyChartUri: rendered.chartUri,
+			recordKeyManifest: rendered.manifest,
+		},
+	}
+	_, err := configMaps.Create(record)
+	if kubeerrs.IsAlreadyExists(err) {
+		_, err = configMaps.Update(record)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "recording the installed manifest")
+	}
+	return nil
+}
+
+// returns false if glooshot was installed without a record, by an older version of the cli or by helm
+func readInstallRecord(opts *options.Options) (renderedManifest, bool, error) {
+	record, err := opts.Clients.KubeClient().CoreV1().ConfigMaps(opts.Init.InstallNamespace).Get(installRecordName, metav1.GetOptions{})
+	if kubeerrs.IsNotFound(err) {
+		return renderedManifest{}, false, nil
+	}
+	if err != nil {
+		return renderedManifest{}, false, errors.Wrapf(err, "reading the installed manifest")
+	}
+	return renderedManifest{
+		version:  record.Data[recordKeyVersion],
+		chartUri: record.Data[recordKeyChartUri],
+		manifest: record.Data[recordKeyManifest],
+	}, true, nil
+}
+
+func deleteInstallRecord(opts *options.Options) error {
+	err := opts.Clients.KubeClient().CoreV1().ConfigMaps(opts.Init.InstallNamespace).Delete(installRecordName, &metav1.DeleteOptions{})
+	if err != nil && !kubeerrs.IsNotFound(err) {
+		return errors.Wrapf(err, "deleting the record of the installed manifest")
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package initexp
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	v1 "github.com/solo-io/glooshot/pkg/api/v1"
+	"github.com/solo-io/glooshot/pkg/cli/flagutils"
+	"github.com/solo-io/glooshot/pkg/cli/options"
+	"github.com/solo-io/glooshot/pkg/translator"
+	"github.com/solo-io/glooshot/pkg/utils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/spf13/cobra"
+)
+
+func UninstallCmd(opts *options.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "uninstall",
+		Short: "uninstall Glooshot from a Kubernetes cluster",
+		Long: "Deletes the resources Glooshot was installed or last upgraded with. The Glooshot CRDs, and with them all " +
+			"experiments, reports, templates and suites, are only deleted with --all, once the faults of the active " +
+			"experiments are removed. The chart flags are only used if Glooshot was installed without a record of its manifest.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := uninstallGlooshot(opts); err != nil {
+				return errors.Wrapf(err, "uninstalling glooshot")
+			}
+			return nil
+		},
+	}
+	pflags := cmd.PersistentFlags()
+	flagutils.AddInitFlags(pflags, &opts.Init)
+	pflags.BoolVar(&opts.Uninstall.All, "all", false,
+		"also delete the Glooshot CRDs along with all experiments, after removing the faults of the active experiments")
+	return cmd
+}
+
+func uninstallGlooshot(opts *options.Options) error {
+	installed, recorded, err := readInstallRecord(opts)
+	if err != nil {
+		return err
+	}
+	if !recorded {
+		if installed, err = renderManifest(opts); err != nil {
+			return err
+		}
+	}
+	resources, err := splitManifest(installed.manifest)
+	if err != nil {
+		return err
+	}
+	crds, others := splitCrds(resources)
+	if !opts.Uninstall.All {
+		crds = nil
+	}
+
+	if opts.Init.DryRun {
+		fmt.Printf("%s", joinManifest(append(others, crds...)))
+		return nil
+	}
+
+	if opts.Uninstall.All {
+		// the faults would remain in the mesh once the operator is deleted
+		if err := removeFaults(opts); err != nil {
+			return err
+		}
+	}
+
+	fmt.Printf("uninstalling glooshot version %v\n", installed.version)
+
+	applier, err := newApplier(opts.Init.InstallNamespace, opts.Init.Timeout)
+	if err != nil {
+		return err
+	}
+	if err := applier.delete(others); err != nil {
+		return err
+	}
+	if err := applier.delete(crds); err != nil {
+		return err
+	}
+	if err := deleteInstallRecord(opts); err != nil {
+		return err
+	}
+
+	fmt.Printf("uninstall successful!\n")
+	return nil
+}
+
+// concludes the active experiments, which makes the operator remove their faults while it is still running,
+// and deletes the routing rules glooshot created in case the operator is not running
+func removeFaults(opts *options.Options) error {
+	uninstalled := map[string]string{utils.FailureReportKeyType: "uninstalled", "message": "glooshot was uninstalled"}
+	for _, ns := range options.GetNamespaces(opts) {
+		exps, err := opts.Clients.ExpClient().List(ns, clients.ListOpts{Ctx: opts.Ctx})
+		if err != nil {
+			return errors.Wrapf(err, "listing experiments")
+		}
+		for _, exp := range exps {
+			switch exp.Result.State {
+			case v1.ExperimentResult_Pending, v1.ExperimentResult_Started:
+			default:
+				continue
+			}
+			exp.Result.State = v1.ExperimentResult_Failed
+			exp.Result.TimeFinished = types.TimestampNow()
+			exp.Result.FailureReport = uninstalled
+			if _, err := opts.Clients.ExpClient().Write(exp, clients.WriteOpts{Ctx: opts.Ctx, OverwriteExisting: true}); err != nil {
+				return errors.Wrapf(err, "concluding experiment %v.%v", exp.Metadata.Namespace, exp.Metadata.Name)
+			}
+			fmt.Printf("concluded active experiment %v.%v\n", exp.Metadata.Namespace, exp.Metadata.Name)
+		}
+
+		rules, err := opts.Clients.RoutingRuleClient().List(ns, clients.ListOpts{Ctx: opts.Ctx, Selector: translator.CreatedByLabels()})
+		if err != nil {
+			return errors.Wrapf(err, "listing routing rules")
+		}
+		for _, rule := range rules {
+			if err := opts.Clients.RoutingRuleClient().Delete(ns, rule.Metadata.Name, clients.DeleteOpts{Ctx: opts.Ctx, IgnoreNotExist: true}); err != nil {
+				return errors.Wrapf(err, "deleting routing rule %v.%v", ns, rule.Metadata.Name)
+			}
+			fmt.Printf("deleted routing rule %v.%v\n", ns, rule.Metadata.Name)
+		}
+	}
+	return nil
+}
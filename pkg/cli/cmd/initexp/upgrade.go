@@ -0,0 +1,92 @@
+package initexp
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/solo-io/glooshot/pkg/cli/flagutils"
+	"github.com/solo-io/glooshot/pkg/cli/options"
+	"github.com/spf13/cobra"
+)
+
+func UpgradeCmd(opts *options.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upgrade",
+		Short: "upgrade Glooshot in a Kubernetes cluster",
+		Long: "Renders the helm chart of the version of the cli, or of the release given with --release, and prints how " +
+			"its manifest differs from the one Glooshot was installed or last upgraded with before applying it. " +
+			"Resources which are no longer part of the chart are deleted. With --dry-run only the differences are printed.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := upgradeGlooshot(opts); err != nil {
+				return errors.Wrapf(err, "upgrading glooshot")
+			}
+			return nil
+		},
+	}
+	pflags := cmd.PersistentFlags()
+	flagutils.AddInitFlags(pflags, &opts.Init)
+	addTimeoutFlag(cmd, opts)
+	// init only offers --release for development builds, which install their own version otherwise
+	if pflags.Lookup("release") == nil {
+		pflags.StringVar(&opts.Init.ReleaseVersion, "release", "", "upgrade to this release version rather than to the version of the cli")
+	}
+	return cmd
+}
+
+func upgradeGlooshot(opts *options.Options) error {
+	installed, recorded, err := readInstallRecord(opts)
+	if err != nil {
+		return err
+	}
+	upgraded, err := renderManifest(opts)
+	if err != nil {
+		return err
+	}
+	previous, err := splitManifest(installed.manifest)
+	if err != nil {
+		return err
+	}
+	current, err := splitManifest(upgraded.manifest)
+	if err != nil {
+		return err
+	}
+
+	if recorded {
+		fmt.Printf("upgrading glooshot from version %v to version %v\n", installed.version, upgraded.version)
+	} else {
+		fmt.Printf("no record of the installed manifest found, upgrading glooshot to version %v\n", upgraded.version)
+	}
+	diff := diffManifests(previous, current)
+	if diff.empty() {
+		fmt.Printf("the manifest is unchanged\n")
+		return nil
+	}
+	for _, line := range diff.lines() {
+		fmt.Println(line)
+	}
+	if opts.Init.DryRun {
+		return nil
+	}
+
+	applier, err := newApplier(opts.Init.InstallNamespace, opts.Init.Timeout)
+	if err != nil {
+		return err
+	}
+	if err := applier.apply(current); err != nil {
+		return err
+	}
+	// custom resource definitions are kept, deleting them would delete all experiments
+	_, removed := splitCrds(diff.removed)
+	if err := applier.delete(removed); err != nil {
+		return err
+	}
+	if err := writeInstallRecord(opts, upgraded); err != nil {
+		return err
+	}
+	if err := waitForOperator(opts.Clients.KubeClient(), opts.Init.InstallNamespace, opts.Init.Timeout); err != nil {
+		return err
+	}
+
+	fmt.Printf("upgrade successful!\n")
+	return nil
+}
@@ -23,6 +23,12 @@ func Cmd(o *options.Options) *cobra.Command {
 			if _, err := regCs.ExpClient().List("default", clients.ListOpts{}); err != nil {
 				return err
 			}
+			if _, err := regCs.ExperimentTemplateClient().List("default", clients.ListOpts{}); err != nil {
+				return err
+			}
+			if _, err := regCs.ExperimentSuiteClient().List("default", clients.ListOpts{}); err != nil {
+				return err
+			}
 			return nil
 		},
 	}
@@ -0,0 +1,65 @@
+package report
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/solo-io/glooshot/pkg/cli/export"
+	"github.com/solo-io/glooshot/pkg/cli/flagutils"
+	"github.com/solo-io/glooshot/pkg/cli/options"
+	"github.com/spf13/cobra"
+)
+
+func Cmd(o *options.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "report",
+		Short: "generate reports of glooshot experiments",
+	}
+	cmd.AddCommand(
+		htmlCmd(o),
+	)
+	return cmd
+}
+
+func htmlCmd(o *options.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "html",
+		Short: "generate a self-contained html report of an experiment",
+		Long:  "generate a single html file with the timeline, injected faults, result and a chart of each failure condition of an experiment. The file has no external assets so it can be attached to postmortems.",
+		RunE: func(c *cobra.Command, args []string) error {
+			return doHtml(o, args)
+		},
+	}
+	pflags := cmd.PersistentFlags()
+	flagutils.AddMetadataFlags(pflags, &o.Metadata)
+	pflags.StringVarP(&o.Report.File, "file", "f", "", "file to write the report to, defaults to <name>.html")
+	return cmd
+}
+
+func doHtml(o *options.Options, args []string) error {
+	if err := options.MetadataArgsParse(o, args, true); err != nil {
+		return err
+	}
+	report, err := export.ReadExperimentReport(o.Clients.ExpClient(), o.Clients.ReportClient(), o.Metadata.Namespace, o.Metadata.Name)
+	if err != nil {
+		return err
+	}
+	file := o.Report.File
+	if file == "" {
+		file = o.Metadata.Name + ".html"
+	}
+	f, err := os.Create(file)
+	if err != nil {
+		return errors.Wrapf(err, "could not create report file")
+	}
+	if err := export.RenderHtml(f, report); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return errors.Wrapf(err, "could not write report file")
+	}
+	fmt.Printf("wrote report of experiment %v.%v to %v\n", o.Metadata.Namespace, o.Metadata.Name, file)
+	return nil
+}
@@ -0,0 +1,63 @@
+package rerun
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	v1 "github.com/solo-io/glooshot/pkg/api/v1"
+	"github.com/solo-io/glooshot/pkg/cli/flagutils"
+	"github.com/solo-io/glooshot/pkg/cli/options"
+	"github.com/solo-io/glooshot/pkg/utils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/util/rand"
+)
+
+// length of the random suffix of the names of reruns
+const suffixLength = 5
+
+func Cmd(o *options.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rerun",
+		Short: "run a glooshot resource again",
+	}
+	cmd.AddCommand(
+		rerunExperimentCmd(o),
+	)
+	flagutils.AddMetadataFlags(cmd.PersistentFlags(), &o.Metadata)
+	return cmd
+}
+
+func rerunExperimentCmd(o *options.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "experiment",
+		Short: "run a concluded glooshot experiment again",
+		Long: "copies the spec of a concluded experiment into a new experiment, named after the experiment's first run with a " +
+			"generated suffix. The previous runs keep their results and reports, list them with glooshot get experiments --history.",
+		Aliases: options.ExperimentAliases,
+		RunE: func(c *cobra.Command, args []string) error {
+			return doRerunExperiment(o, args)
+		},
+	}
+	return cmd
+}
+
+func doRerunExperiment(o *options.Options, args []string) error {
+	if err := options.MetadataArgsParse(o, args, true); err != nil {
+		return err
+	}
+	exp, err := o.Clients.ExpClient().Read(o.Metadata.Namespace, o.Metadata.Name, clients.ReadOpts{Ctx: o.Ctx})
+	if err != nil {
+		return errors.Wrapf(err, "could not read experiment")
+	}
+	switch exp.Result.State {
+	case v1.ExperimentResult_Pending, v1.ExperimentResult_Started:
+		return errors.Errorf("experiment %v.%v has not concluded, it is %v", exp.Metadata.Namespace, exp.Metadata.Name, exp.Result.State.String())
+	}
+	run := utils.NewRun(exp, rand.String(suffixLength))
+	if _, err := o.Clients.ExpClient().Write(run, clients.WriteOpts{Ctx: o.Ctx}); err != nil {
+		return errors.Wrapf(err, "could not create experiment")
+	}
+	fmt.Printf("experiment %v.%v created, rerunning %v.%v\n", run.Metadata.Namespace, run.Metadata.Name, exp.Metadata.Namespace, exp.Metadata.Name)
+	return nil
+}
@@ -0,0 +1,112 @@
+package runscenario
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/solo-io/glooshot/pkg/cli/export"
+	"github.com/solo-io/glooshot/pkg/cli/flagutils"
+	"github.com/solo-io/glooshot/pkg/cli/options"
+	"github.com/solo-io/glooshot/pkg/cli/printer"
+	"github.com/solo-io/glooshot/pkg/cli/scenario"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/util/rand"
+)
+
+// length of the random suffix of the names of the experiments of a scenario
+const runIDLength = 5
+
+func Cmd(o *options.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scenario",
+		Short: "drive game-day scenarios described in local files",
+	}
+	cmd.AddCommand(
+		runScenarioCmd(o),
+	)
+	flagutils.AddMetadataFlags(cmd.PersistentFlags(), &o.Metadata)
+	return cmd
+}
+
+func runScenarioCmd(o *options.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "run FILE",
+		Short: "run the timeline of a scenario, injecting and removing faults at the times it describes",
+		Long: fmt.Sprintf("runs the timeline of a scenario, injecting and removing faults at the times it describes "+
+			"while monitoring the failure conditions of the scenario, which abort it once met. Faults which are still "+
+			"injected when the scenario ends or is interrupted are removed. Writes a combined report of all experiments "+
+			"of the scenario once it ends, the output format is one of %v and defaults to %v. - reads the scenario from stdin.",
+			strings.Join(export.Formats, "|"), export.FormatMarkdown),
+		Args: cobra.ExactArgs(1),
+		RunE: func(c *cobra.Command, args []string) error {
+			return doRunScenario(o, c, args[0])
+		},
+	}
+	cmd.Flags().StringVar(&o.Scenario.ReportFile, "report-file", "", "file to write the combined report to, defaults to stdout")
+	return cmd
+}
+
+func doRunScenario(o *options.Options, cmd *cobra.Command, path string) (err error) {
+	sc, err := scenario.Read(path, os.Stdin)
+	if err != nil {
+		return err
+	}
+	namespace := sc.Namespace
+	if namespace == "" || cmd.Flags().Changed("namespace") {
+		namespace = o.Metadata.Namespace
+	}
+	format := o.Top.Output
+	if format == "" || format == printer.OutputTable || format == printer.OutputWide {
+		format = export.FormatMarkdown
+	}
+
+	// faults are removed when the scenario is interrupted
+	ctx, cancel := context.WithCancel(o.Ctx)
+	defer cancel()
+	interrupts := make(chan os.Signal, 1)
+	signal.Notify(interrupts, os.Interrupt)
+	defer signal.Stop(interrupts)
+	go func() {
+		select {
+		case <-interrupts:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	runner := &scenario.Runner{
+		Experiments: o.Clients.ExpClient(),
+		Reports:     o.Clients.ReportClient(),
+		Log:         os.Stdout,
+	}
+	result, err := runner.Run(ctx, sc, namespace, rand.String(runIDLength))
+	if err != nil {
+		return err
+	}
+
+	var w io.Writer = os.Stdout
+	if o.Scenario.ReportFile != "" {
+		f, createErr := os.Create(o.Scenario.ReportFile)
+		if createErr != nil {
+			return errors.Wrapf(createErr, "could not create report file")
+		}
+		defer func() {
+			if closeErr := f.Close(); closeErr != nil && err == nil {
+				err = errors.Wrapf(closeErr, "could not write report file")
+			}
+		}()
+		w = f
+	}
+	if err := scenario.RenderReport(w, format, sc, result); err != nil {
+		return err
+	}
+	if result.Failed {
+		return errors.Errorf("scenario %v failed", sc.Name)
+	}
+	return nil
+}
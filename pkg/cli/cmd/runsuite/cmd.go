@@ -0,0 +1,158 @@
+package runsuite
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/pkg/errors"
+	v1 "github.com/solo-io/glooshot/pkg/api/v1"
+	"github.com/solo-io/glooshot/pkg/cli/flagutils"
+	"github.com/solo-io/glooshot/pkg/cli/manifest"
+	"github.com/solo-io/glooshot/pkg/cli/options"
+	"github.com/solo-io/glooshot/pkg/cli/watch"
+	"github.com/solo-io/glooshot/pkg/suite"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	skerrors "github.com/solo-io/solo-kit/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+func Cmd(o *options.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "run",
+		Short: "run a glooshot resource and wait for its result",
+	}
+	cmd.AddCommand(
+		runSuiteCmd(o),
+	)
+	flagutils.AddMetadataFlags(cmd.PersistentFlags(), &o.Metadata)
+	return cmd
+}
+
+func runSuiteCmd(o *options.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "suite",
+		Short: "run an experiment suite and wait for it to conclude",
+		Long: "runs an experiment suite and prints the results of its experiments as they conclude, like a test runner. " +
+			"The suite is read from the file given with -f, or is an existing suite which is run again if it concluded. " +
+			"Exits with an error unless all experiments of the suite succeeded.",
+		Aliases: options.SuiteAliases,
+		RunE: func(c *cobra.Command, args []string) error {
+			return doRunSuite(o, c, args)
+		},
+	}
+	flags := cmd.Flags()
+	flags.StringVarP(&o.Run.File, "file", "f", "", "file containing the experiment suite, - reads it from stdin")
+	flags.DurationVar(&o.Run.Timeout, "timeout", 0, "how long to wait for the suite to conclude, no limit if zero")
+	return cmd
+}
+
+func doRunSuite(o *options.Options, cmd *cobra.Command, args []string) error {
+	if err := options.MetadataArgsParse(o, args, o.Run.File == ""); err != nil {
+		return err
+	}
+	s, err := startSuite(o, cmd)
+	if err != nil {
+		return err
+	}
+	ctx := o.Ctx
+	if o.Run.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, o.Run.Timeout)
+		defer cancel()
+	}
+	concluded, err := follow(ctx, o.Clients.ExperimentSuiteClient(), s.Metadata.Namespace, s.Metadata.Name)
+	if err != nil {
+		return err
+	}
+	if concluded.Result.State != v1.SuiteResult_Succeeded {
+		return errors.Errorf("experiment suite %v.%v %v", s.Metadata.Namespace, s.Metadata.Name, concluded.Result.State.String())
+	}
+	return nil
+}
+
+// writes the suite given with -f, or resets the result of the named suite if it concluded, so that glooshot runs it
+func startSuite(o *options.Options, cmd *cobra.Command) (*v1.ExperimentSuite, error) {
+	client := o.Clients.ExperimentSuiteClient()
+	var s *v1.ExperimentSuite
+	if o.Run.File != "" {
+		parsed, err := manifest.ReadSuite(o.Run.File, os.Stdin)
+		if err != nil {
+			return nil, err
+		}
+		if o.Metadata.Name != "" {
+			parsed.Metadata.Name = o.Metadata.Name
+		}
+		if parsed.Metadata.Name == "" {
+			return nil, errors.Errorf("the experiment suite has no name, specify one in the file, with --name or as the first arg")
+		}
+		if parsed.Metadata.Namespace == "" || cmd.Flags().Changed("namespace") {
+			parsed.Metadata.Namespace = o.Metadata.Namespace
+		}
+		s = parsed
+	}
+	namespace, name := o.Metadata.Namespace, o.Metadata.Name
+	if s != nil {
+		namespace, name = s.Metadata.Namespace, s.Metadata.Name
+	}
+
+	existing, err := client.Read(namespace, name, clients.ReadOpts{Ctx: o.Ctx})
+	switch {
+	case err == nil:
+		if !suite.Concluded(existing.Result) {
+			if s != nil {
+				return nil, errors.Errorf("experiment suite %v.%v is %v, wait for it to conclude before running it again",
+					namespace, name, existing.Result.State.String())
+			}
+			fmt.Printf("experiment suite %v.%v is %v already, following it\n", namespace, name, existing.Result.State.String())
+			return existing, nil
+		}
+		if s == nil {
+			s = existing
+		}
+		s.Metadata.ResourceVersion = existing.Metadata.ResourceVersion
+	case !skerrors.IsNotExist(err) || s == nil:
+		return nil, errors.Wrapf(err, "could not read experiment suite")
+	}
+
+	s.Result = v1.SuiteResult{}
+	written, err := client.Write(s, clients.WriteOpts{Ctx: o.Ctx, OverwriteExisting: s.Metadata.ResourceVersion != ""})
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not write experiment suite")
+	}
+	fmt.Printf("running experiment suite %v.%v\n", namespace, name)
+	return written, nil
+}
+
+// prints the changes of the suite until it concludes
+func follow(ctx context.Context, client v1.ExperimentSuiteClient, namespace, name string) (*v1.ExperimentSuite, error) {
+	suites, errs, err := client.Watch(namespace, clients.WatchOpts{Ctx: ctx})
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not watch experiment suites")
+	}
+	var previous *v1.ExperimentSuite
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, errors.Errorf("experiment suite %v.%v did not conclude in time", namespace, name)
+		case err := <-errs:
+			return nil, errors.Wrapf(err, "error watching experiment suites")
+		case list := <-suites:
+			current, err := list.Find(namespace, name)
+			if err != nil {
+				return nil, errors.Errorf("experiment suite %v.%v was deleted", namespace, name)
+			}
+			// the result of the previous run is reset before the suite is run again
+			if current.Result.State == v1.SuiteResult_Pending {
+				continue
+			}
+			for _, line := range watch.SuiteChanges(previous, current) {
+				fmt.Println(line)
+			}
+			if suite.Concluded(current.Result) {
+				return current, nil
+			}
+			previous = current
+		}
+	}
+}
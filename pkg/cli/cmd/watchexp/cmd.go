@@ -0,0 +1,132 @@
+package watchexp
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/mattn/go-isatty"
+	"github.com/pkg/errors"
+	v1 "github.com/solo-io/glooshot/pkg/api/v1"
+	"github.com/solo-io/glooshot/pkg/cli/flagutils"
+	"github.com/solo-io/glooshot/pkg/cli/options"
+	"github.com/solo-io/glooshot/pkg/cli/watch"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/spf13/cobra"
+)
+
+// how long to wait for the final report once the experiment concluded
+const finalReportTimeout = time.Second * 5
+
+// ansi escape sequence moving the cursor home and clearing the screen
+const clearScreen = "\033[H\033[2J"
+
+func Cmd(o *options.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "watch",
+		Short: "follow the progress of a glooshot resource",
+	}
+	cmd.AddCommand(
+		watchExperimentCmd(o),
+	)
+	pflags := cmd.PersistentFlags()
+	flagutils.AddMetadataFlags(pflags, &o.Metadata)
+	return cmd
+}
+
+func watchExperimentCmd(o *options.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "experiment",
+		Short:   "follow the progress of an experiment until it concludes",
+		Long:    "follow the progress of an experiment until it concludes. Renders a live view in a terminal, otherwise prints a line for every change.",
+		Aliases: options.ExperimentAliases,
+		RunE: func(c *cobra.Command, args []string) error {
+			return doWatchExperiment(o, args)
+		},
+	}
+	return cmd
+}
+
+func doWatchExperiment(o *options.Options, args []string) error {
+	if err := options.MetadataArgsParse(o, args, true); err != nil {
+		return err
+	}
+	namespace, name := o.Metadata.Namespace, o.Metadata.Name
+	watchOpts := clients.WatchOpts{Ctx: o.Ctx}
+	experiments, expErrs, err := o.Clients.ExpClient().Watch(namespace, watchOpts)
+	if err != nil {
+		return errors.Wrapf(err, "could not watch experiments")
+	}
+	reports, reportErrs, err := o.Clients.ReportClient().Watch(namespace, watchOpts)
+	if err != nil {
+		return errors.Wrapf(err, "could not watch reports")
+	}
+
+	tty := isatty.IsTerminal(os.Stdout.Fd())
+	// refresh the elapsed time in the terminal between updates
+	refresh := time.NewTicker(time.Second)
+	defer refresh.Stop()
+
+	var (
+		exp       *v1.Experiment
+		report    *v1.Report
+		previous  *watch.Progress
+		concluded <-chan time.Time
+		waiting   bool
+	)
+	for {
+		reportUpdated := false
+		select {
+		case <-o.Ctx.Done():
+			return nil
+		case err := <-expErrs:
+			return errors.Wrapf(err, "error watching experiments")
+		case err := <-reportErrs:
+			return errors.Wrapf(err, "error watching reports")
+		case list := <-experiments:
+			exp, _ = list.Find(namespace, name)
+		case list := <-reports:
+			report, _ = list.Find(namespace, name)
+			reportUpdated = true
+		case <-refresh.C:
+			if !tty {
+				continue
+			}
+		case <-concluded:
+			return nil
+		}
+		if exp == nil {
+			if tty {
+				fmt.Print(clearScreen)
+			}
+			if tty || !waiting {
+				fmt.Printf("waiting for experiment %v.%v\n", namespace, name)
+			}
+			waiting = true
+			continue
+		}
+
+		progress := watch.NewProgress(exp, report, time.Now())
+		if tty {
+			fmt.Print(clearScreen)
+			if err := progress.Render(os.Stdout); err != nil {
+				return err
+			}
+		} else {
+			for _, line := range progress.Changes(previous) {
+				fmt.Println(line)
+			}
+		}
+
+		if progress.Concluded() {
+			// the final report is written right after the experiment concludes
+			if concluded != nil && reportUpdated {
+				return nil
+			}
+			if concluded == nil {
+				concluded = time.After(finalReportTimeout)
+			}
+		}
+		previous = &progress
+	}
+}
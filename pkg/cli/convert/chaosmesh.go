@@ -0,0 +1,233 @@
+package convert
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	v1 "github.com/solo-io/glooshot/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+// the api group of chaos mesh resources
+const chaosMeshGroup = "chaos-mesh.org/"
+
+type chaosMeshSelector struct {
+	Namespaces     []string          `json:"namespaces"`
+	LabelSelectors map[string]string `json:"labelSelectors"`
+}
+
+type networkChaosSpec struct {
+	Action    string          `json:"action"`
+	Mode      string          `json:"mode"`
+	Value     string          `json:"value"`
+	Selector  json.RawMessage `json:"selector"`
+	Delay     json.RawMessage `json:"delay"`
+	Direction string          `json:"direction"`
+	Target    *struct {
+		Selector json.RawMessage `json:"selector"`
+		Mode     string          `json:"mode"`
+		Value    string          `json:"value"`
+	} `json:"target"`
+	Duration string `json:"duration"`
+}
+
+type httpChaosSpec struct {
+	Mode     string          `json:"mode"`
+	Value    string          `json:"value"`
+	Selector json.RawMessage `json:"selector"`
+	Target   string          `json:"target"`
+	Port     uint32          `json:"port"`
+	Abort    bool            `json:"abort"`
+	Delay    string          `json:"delay"`
+	Duration string          `json:"duration"`
+}
+
+func (c *converter) chaosMesh(res resource) error {
+	if !strings.HasPrefix(res.APIVersion, chaosMeshGroup) {
+		return nil
+	}
+	switch res.Kind {
+	case "NetworkChaos":
+		return c.networkChaos(res)
+	case "HTTPChaos":
+		return c.httpChaos(res)
+	}
+	c.notConverted(res, "", "%v faults have no http equivalent", res.Kind)
+	return nil
+}
+
+func (c *converter) networkChaos(res resource) error {
+	var spec networkChaosSpec
+	if err := json.Unmarshal(res.Spec, &spec); err != nil {
+		return errors.Wrapf(err, "invalid spec")
+	}
+	if spec.Action != "delay" {
+		c.notConverted(res, "spec.action", "%v has no http equivalent, only delay has", spec.Action)
+		return nil
+	}
+	if err := c.unmappedFields(res, "spec.", res.Spec, "action", "mode", "value", "selector", "delay", "direction", "target", "duration"); err != nil {
+		return err
+	}
+
+	var delay struct {
+		Latency string `json:"latency"`
+	}
+	if len(spec.Delay) > 0 {
+		if err := json.Unmarshal(spec.Delay, &delay); err != nil {
+			return errors.Wrapf(err, "invalid spec.delay")
+		}
+	}
+	latency, err := time.ParseDuration(delay.Latency)
+	if err != nil {
+		return errors.Wrapf(err, "invalid spec.delay.latency")
+	}
+	if err := c.unmappedFields(res, "spec.delay.", spec.Delay, "latency"); err != nil {
+		return err
+	}
+	duration, err := parseDuration(spec.Duration)
+	if err != nil {
+		return errors.Wrapf(err, "invalid spec.duration")
+	}
+	percentage, err := c.podPercentage(res, "spec.mode", spec.Mode, spec.Value)
+	if err != nil {
+		return err
+	}
+
+	selected, ok, err := c.chaosMeshUpstreams(res, "spec.selector", spec.Selector, 0)
+	if err != nil || !ok {
+		return err
+	}
+	// the selected pods delay the packets they send, or those they receive from the target
+	fault := &v1.ExperimentSpec_InjectedFault{Fault: delayFault(latency, percentage)}
+	if spec.Target == nil {
+		if spec.Direction == "from" {
+			fault.DestinationServices = selected
+		} else {
+			fault.OriginServices = selected
+		}
+	} else {
+		targets, ok, err := c.chaosMeshUpstreams(res, "spec.target.selector", spec.Target.Selector, 0)
+		if err != nil || !ok {
+			return err
+		}
+		if spec.Target.Mode != "" && spec.Target.Mode != "all" {
+			c.unmapped(res, "spec.target.mode", "%v selects some of the target pods, the fault applies to the requests to all of them", spec.Target.Mode)
+		}
+		fault.OriginServices, fault.DestinationServices = selected, targets
+		if spec.Direction == "from" {
+			fault.OriginServices, fault.DestinationServices = targets, selected
+		}
+	}
+	if spec.Direction == "both" {
+		c.unmapped(res, "spec.direction", "both is mapped onto to, glooshot only delays the requests sent by the selected pods")
+	}
+	c.result.Experiments = append(c.result.Experiments, newExperiment(res.Metadata.Namespace, res.Metadata.Name, duration, fault))
+	return nil
+}
+
+func (c *converter) httpChaos(res resource) error {
+	var spec httpChaosSpec
+	if err := json.Unmarshal(res.Spec, &spec); err != nil {
+		return errors.Wrapf(err, "invalid spec")
+	}
+	if err := c.unmappedFields(res, "spec.", res.Spec, "mode", "value", "selector", "target", "port", "abort", "delay", "duration"); err != nil {
+		return err
+	}
+	if !spec.Abort && spec.Delay == "" {
+		c.notConverted(res, "spec", "neither aborts nor delays requests")
+		return nil
+	}
+	duration, err := parseDuration(spec.Duration)
+	if err != nil {
+		return errors.Wrapf(err, "invalid spec.duration")
+	}
+	percentage, err := c.podPercentage(res, "spec.mode", spec.Mode, spec.Value)
+	if err != nil {
+		return err
+	}
+	destinations, ok, err := c.chaosMeshUpstreams(res, "spec.selector", spec.Selector, spec.Port)
+	if err != nil || !ok {
+		return err
+	}
+	if spec.Target == "Response" {
+		c.unmapped(res, "spec.target", "Response is mapped onto Request, glooshot injects faults before requests reach the upstream")
+	}
+
+	var faults []*v1.ExperimentSpec_InjectedFault
+	if spec.Abort {
+		c.unmapped(res, "spec.abort", "closes the connection, it is mapped onto aborting requests with status %v", defaultAbortStatus)
+		faults = append(faults, &v1.ExperimentSpec_InjectedFault{
+			DestinationServices: destinations,
+			Fault:               abortFault(defaultAbortStatus, percentage),
+		})
+	}
+	if spec.Delay != "" {
+		delay, err := time.ParseDuration(spec.Delay)
+		if err != nil {
+			return errors.Wrapf(err, "invalid spec.delay")
+		}
+		faults = append(faults, &v1.ExperimentSpec_InjectedFault{
+			DestinationServices: destinations,
+			Fault:               delayFault(delay, percentage),
+		})
+	}
+	c.result.Experiments = append(c.result.Experiments, newExperiment(res.Metadata.Namespace, res.Metadata.Name, duration, faults...))
+	return nil
+}
+
+// maps the pods selected by chaos mesh onto upstreams, returns false if the selector matches no upstream
+// the selector defaults to the namespace of the resource
+func (c *converter) chaosMeshUpstreams(res resource, field string, raw json.RawMessage, port uint32) ([]*core.ResourceRef, bool, error) {
+	var selector chaosMeshSelector
+	if len(raw) > 0 {
+		if err := json.Unmarshal(raw, &selector); err != nil {
+			return nil, false, errors.Wrapf(err, "invalid %v", field)
+		}
+	}
+	if err := c.unmappedFields(res, field+".", raw, "namespaces", "labelSelectors"); err != nil {
+		return nil, false, err
+	}
+	namespaces := selector.Namespaces
+	if len(namespaces) == 0 {
+		namespaces = []string{res.Metadata.Namespace}
+	}
+	refs := c.selectUpstreams(namespaces, selector.LabelSelectors, port)
+	if len(refs) == 0 {
+		// leaving out the services would inject the fault into all requests of the mesh
+		c.notConverted(res, field, "matches no upstream")
+		return nil, false, nil
+	}
+	return refs, true, nil
+}
+
+// maps the share of the pods a fault is injected into onto the percentage of the requests
+func (c *converter) podPercentage(res resource, field, mode, value string) (float64, error) {
+	switch mode {
+	case "", "all":
+		return 100, nil
+	case "fixed-percent":
+		percentage, err := strconv.ParseFloat(value, 64)
+		if err != nil || percentage <= 0 || percentage > 100 {
+			return 0, errors.Errorf("invalid value %q for mode fixed-percent, must be a percentage", value)
+		}
+		c.unmapped(res, field, "fixed-percent is mapped onto the percentage of the requests rather than of the pods")
+		return percentage, nil
+	}
+	c.unmapped(res, field, "%v selects some of the pods, the fault applies to the requests of all of them", mode)
+	return 100, nil
+}
+
+// returns nil if the duration is empty
+func parseDuration(s string) (*time.Duration, error) {
+	if s == "" {
+		return nil, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
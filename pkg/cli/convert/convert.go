@@ -0,0 +1,227 @@
+package convert
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/pkg/errors"
+	v1 "github.com/solo-io/glooshot/pkg/api/v1"
+	"github.com/solo-io/glooshot/pkg/cli/manifest"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	sgv1 "github.com/solo-io/supergloo/pkg/api/v1"
+)
+
+const (
+	FromChaosMesh = "chaosmesh"
+	FromLitmus    = "litmus"
+)
+
+// Formats are the formats experiments can be converted from
+var Formats = []string{FromChaosMesh, FromLitmus}
+
+// the status code of requests aborted by a fault whose source format does not specify one
+const defaultAbortStatus = 503
+
+// Upstream is an upstream faults can be injected into, along with the pods of the service it routes to
+// the selectors of the source formats select pods, which are mapped onto the upstreams routing to them
+type Upstream struct {
+	Ref core.ResourceRef
+	// Namespace is the namespace of the service
+	Namespace string
+	// Port is the port of the service
+	Port uint32
+	// Selector selects the pods of the service
+	Selector map[string]string
+}
+
+// Unmapped is a field of the source which could not be mapped onto glooshot, or was mapped approximately
+type Unmapped struct {
+	// Source is the resource of the source format the field belongs to, as kind namespace.name
+	Source string
+	Field  string
+	Reason string
+}
+
+func (u Unmapped) String() string {
+	if u.Field == "" {
+		return fmt.Sprintf("%v: %v", u.Source, u.Reason)
+	}
+	return fmt.Sprintf("%v: %v %v", u.Source, u.Field, u.Reason)
+}
+
+// Result are the experiments converted from a manifest, along with the fields which could not be mapped
+// resources and litmus experiments which were not converted are reported along with the reason
+type Result struct {
+	Experiments []*v1.Experiment
+	Unmapped    []Unmapped
+}
+
+// the fields common to the resources of all source formats
+type resource struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Metadata   struct {
+		Name      string `json:"name"`
+		Namespace string `json:"namespace"`
+	} `json:"metadata"`
+	// the spec is decoded by each format, its raw fields are kept to report those which are not mapped
+	Spec json.RawMessage `json:"spec"`
+}
+
+func (r resource) String() string {
+	return fmt.Sprintf("%v %v.%v", r.Kind, r.Metadata.Namespace, r.Metadata.Name)
+}
+
+// Convert converts the network and http faults of a multi-document manifest in the given format into experiments
+// documents of other kinds are skipped, the selectors of the faults are mapped onto the given upstreams
+func Convert(content []byte, from string, upstreams []Upstream) (Result, error) {
+	var convertDoc func(c *converter, res resource) error
+	switch from {
+	case FromChaosMesh:
+		convertDoc = (*converter).chaosMesh
+	case FromLitmus:
+		convertDoc = (*converter).litmus
+	default:
+		return Result{}, errors.Errorf("unknown format %q, must be one of %v", from, strings.Join(Formats, "|"))
+	}
+	c := &converter{upstreams: upstreams}
+	for i, doc := range manifest.Documents(content) {
+		if strings.TrimSpace(doc) == "" {
+			continue
+		}
+		jsn, err := yaml.YAMLToJSON([]byte(doc))
+		if err != nil {
+			return Result{}, errors.Wrapf(err, "document %v", i+1)
+		}
+		var res resource
+		if err := json.Unmarshal(jsn, &res); err != nil {
+			return Result{}, errors.Wrapf(err, "document %v", i+1)
+		}
+		if res.Kind == "" {
+			// only comments
+			continue
+		}
+		if err := convertDoc(c, res); err != nil {
+			return Result{}, errors.Wrapf(err, "document %v: %v", i+1, res)
+		}
+	}
+	return c.result, nil
+}
+
+type converter struct {
+	upstreams []Upstream
+	result    Result
+}
+
+func (c *converter) unmapped(res resource, field, reason string, args ...interface{}) {
+	c.result.Unmapped = append(c.result.Unmapped, Unmapped{Source: res.String(), Field: field, Reason: fmt.Sprintf(reason, args...)})
+}
+
+// reports a resource which could not be converted
+func (c *converter) notConverted(res resource, field, reason string, args ...interface{}) {
+	c.unmapped(res, field, "%v, the resource was not converted", fmt.Sprintf(reason, args...))
+}
+
+// reports the fields of the raw object which are not among the mapped ones, the field names are prefixed with path
+func (c *converter) unmappedFields(res resource, path string, raw json.RawMessage, mapped ...string) error {
+	var fields map[string]json.RawMessage
+	if len(raw) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return errors.Wrapf(err, "invalid %v", strings.TrimSuffix(path, "."))
+	}
+	known := make(map[string]bool)
+	for _, field := range mapped {
+		known[field] = true
+	}
+	var names []string
+	for name := range fields {
+		if !known[name] {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		c.unmapped(res, path+name, "is not supported by glooshot")
+	}
+	return nil
+}
+
+// returns the upstreams routing to the pods matched by the labels in the given namespaces
+// port restricts the upstreams to those of a service port, unless it is zero
+func (c *converter) selectUpstreams(namespaces []string, labels map[string]string, port uint32) []*core.ResourceRef {
+	var refs []*core.ResourceRef
+	for _, us := range c.upstreams {
+		if !contains(namespaces, us.Namespace) || (port != 0 && us.Port != port) {
+			continue
+		}
+		// upstreams without a selector do not route to pods, like those of external services
+		if len(us.Selector) == 0 || !selects(labels, us.Selector) {
+			continue
+		}
+		ref := us.Ref
+		refs = append(refs, &ref)
+	}
+	sort.Slice(refs, func(i, j int) bool {
+		return refs[i].Namespace+"."+refs[i].Name < refs[j].Namespace+"."+refs[j].Name
+	})
+	return refs
+}
+
+// the pods of a service are matched by the labels if every label is part of the selector of the service
+// the pods matched by the labels could be a subset of those of the service, which glooshot cannot tell apart
+func selects(labels, selector map[string]string) bool {
+	for k, v := range labels {
+		if selector[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+func newExperiment(namespace, name string, duration *time.Duration, faults ...*v1.ExperimentSpec_InjectedFault) *v1.Experiment {
+	return &v1.Experiment{
+		Metadata: core.Metadata{Namespace: namespace, Name: name},
+		Spec: &v1.ExperimentSpec{
+			Faults:   faults,
+			Duration: duration,
+		},
+	}
+}
+
+func delayFault(delay time.Duration, percentage float64) *sgv1.FaultInjection {
+	return &sgv1.FaultInjection{
+		FaultInjectionType: &sgv1.FaultInjection_Delay_{
+			Delay: &sgv1.FaultInjection_Delay{
+				Duration:  delay,
+				DelayType: sgv1.FaultInjection_Delay_FIXED,
+			},
+		},
+		Percentage: percentage,
+	}
+}
+
+func abortFault(status int32, percentage float64) *sgv1.FaultInjection {
+	return &sgv1.FaultInjection{
+		FaultInjectionType: &sgv1.FaultInjection_Abort_{
+			Abort: &sgv1.FaultInjection_Abort{
+				ErrorType: &sgv1.FaultInjection_Abort_HttpStatus{HttpStatus: status},
+			},
+		},
+		Percentage: percentage,
+	}
+}
@@ -0,0 +1,268 @@
+package convert_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/glooshot/pkg/cli/convert"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	sgv1 "github.com/solo-io/supergloo/pkg/api/v1"
+)
+
+var upstreams = []convert.Upstream{
+	{
+		Ref:       core.ResourceRef{Namespace: "gloo-system", Name: "bookinfo-ratings-9080"},
+		Namespace: "bookinfo",
+		Port:      9080,
+		Selector:  map[string]string{"app": "ratings"},
+	},
+	{
+		Ref:       core.ResourceRef{Namespace: "gloo-system", Name: "bookinfo-reviews-9080"},
+		Namespace: "bookinfo",
+		Port:      9080,
+		Selector:  map[string]string{"app": "reviews"},
+	},
+	{
+		Ref:       core.ResourceRef{Namespace: "gloo-system", Name: "bookinfo-reviews-9090"},
+		Namespace: "bookinfo",
+		Port:      9090,
+		Selector:  map[string]string{"app": "reviews"},
+	},
+}
+
+func ref(name string) *core.ResourceRef {
+	return &core.ResourceRef{Namespace: "gloo-system", Name: name}
+}
+
+func unmapped(result convert.Result) []string {
+	var lines []string
+	for _, u := range result.Unmapped {
+		lines = append(lines, u.String())
+	}
+	return lines
+}
+
+var _ = Describe("Convert", func() {
+
+	It("rejects unknown formats", func() {
+		_, err := convert.Convert(nil, "gremlin", upstreams)
+		Expect(err).To(MatchError(`unknown format "gremlin", must be one of chaosmesh|litmus`))
+	})
+
+	Context("chaos mesh", func() {
+
+		It("converts network delays and http aborts and delays", func() {
+			result, err := convert.Convert([]byte(`apiVersion: chaos-mesh.org/v1alpha1
+kind: NetworkChaos
+metadata:
+  name: slow-ratings
+  namespace: bookinfo
+spec:
+  action: delay
+  mode: all
+  selector:
+    labelSelectors:
+      app: reviews
+  direction: to
+  target:
+    mode: all
+    selector:
+      labelSelectors:
+        app: ratings
+  delay:
+    latency: 100ms
+    jitter: 10ms
+  duration: 5m
+---
+# services are unaffected
+apiVersion: v1
+kind: Service
+metadata:
+  name: ratings
+---
+apiVersion: chaos-mesh.org/v1alpha1
+kind: HTTPChaos
+metadata:
+  name: broken-reviews
+  namespace: bookinfo
+spec:
+  mode: fixed-percent
+  value: "25"
+  selector:
+    namespaces: [bookinfo]
+    labelSelectors:
+      app: reviews
+  target: Request
+  port: 9090
+  path: /reviews/*
+  abort: true
+  delay: 1s
+`), convert.FromChaosMesh, upstreams)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Experiments).To(HaveLen(2))
+
+			slow := result.Experiments[0]
+			Expect(slow.Metadata).To(Equal(core.Metadata{Namespace: "bookinfo", Name: "slow-ratings"}))
+			Expect(*slow.Spec.Duration).To(Equal(5 * time.Minute))
+			Expect(slow.Spec.Faults).To(HaveLen(1))
+			Expect(slow.Spec.Faults[0].OriginServices).To(Equal([]*core.ResourceRef{ref("bookinfo-reviews-9080"), ref("bookinfo-reviews-9090")}))
+			Expect(slow.Spec.Faults[0].DestinationServices).To(Equal([]*core.ResourceRef{ref("bookinfo-ratings-9080")}))
+			Expect(slow.Spec.Faults[0].Fault.GetDelay()).To(Equal(&sgv1.FaultInjection_Delay{
+				Duration:  100 * time.Millisecond,
+				DelayType: sgv1.FaultInjection_Delay_FIXED,
+			}))
+			Expect(slow.Spec.Faults[0].Fault.Percentage).To(Equal(100.0))
+
+			broken := result.Experiments[1]
+			Expect(broken.Spec.Duration).To(BeNil())
+			Expect(broken.Spec.Faults).To(HaveLen(2))
+			Expect(broken.Spec.Faults[0].DestinationServices).To(Equal([]*core.ResourceRef{ref("bookinfo-reviews-9090")}))
+			Expect(broken.Spec.Faults[0].Fault.GetAbort().GetHttpStatus()).To(Equal(int32(503)))
+			Expect(broken.Spec.Faults[0].Fault.Percentage).To(Equal(25.0))
+			Expect(broken.Spec.Faults[1].Fault.GetDelay().Duration).To(Equal(time.Second))
+
+			Expect(unmapped(result)).To(Equal([]string{
+				"NetworkChaos bookinfo.slow-ratings: spec.delay.jitter is not supported by glooshot",
+				"HTTPChaos bookinfo.broken-reviews: spec.path is not supported by glooshot",
+				"HTTPChaos bookinfo.broken-reviews: spec.mode fixed-percent is mapped onto the percentage of the requests rather than of the pods",
+				"HTTPChaos bookinfo.broken-reviews: spec.abort closes the connection, it is mapped onto aborting requests with status 503",
+			}))
+		})
+
+		It("reports resources which cannot be converted", func() {
+			result, err := convert.Convert([]byte(`apiVersion: chaos-mesh.org/v1alpha1
+kind: NetworkChaos
+metadata:
+  name: lossy
+  namespace: bookinfo
+spec:
+  action: loss
+  loss:
+    loss: "25"
+---
+apiVersion: chaos-mesh.org/v1alpha1
+kind: PodChaos
+metadata:
+  name: kill
+  namespace: bookinfo
+spec:
+  action: pod-kill
+---
+apiVersion: chaos-mesh.org/v1alpha1
+kind: HTTPChaos
+metadata:
+  name: details
+  namespace: bookinfo
+spec:
+  selector:
+    labelSelectors:
+      app: details
+  delay: 1s
+`), convert.FromChaosMesh, upstreams)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Experiments).To(BeEmpty())
+			Expect(unmapped(result)).To(Equal([]string{
+				"NetworkChaos bookinfo.lossy: spec.action loss has no http equivalent, only delay has, the resource was not converted",
+				"PodChaos bookinfo.kill: PodChaos faults have no http equivalent, the resource was not converted",
+				"HTTPChaos bookinfo.details: spec.selector matches no upstream, the resource was not converted",
+			}))
+		})
+
+		It("fails on invalid values", func() {
+			_, err := convert.Convert([]byte(`apiVersion: chaos-mesh.org/v1alpha1
+kind: HTTPChaos
+metadata:
+  name: details
+  namespace: bookinfo
+spec:
+  delay: soon
+`), convert.FromChaosMesh, upstreams)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(HavePrefix("document 1: HTTPChaos bookinfo.details: invalid spec.delay"))
+		})
+	})
+
+	Context("litmus", func() {
+
+		It("converts the http and network experiments of a chaos engine", func() {
+			result, err := convert.Convert([]byte(`apiVersion: litmuschaos.io/v1alpha1
+kind: ChaosEngine
+metadata:
+  name: reviews-chaos
+  namespace: bookinfo
+spec:
+  engineState: active
+  chaosServiceAccount: litmus-admin
+  appinfo:
+    appns: bookinfo
+    applabel: app=reviews
+    appkind: deployment
+  experiments:
+  - name: pod-http-status-code
+    spec:
+      components:
+        env:
+        - name: STATUS_CODE
+          value: "500"
+        - name: TOXICITY
+          value: "50"
+        - name: TARGET_SERVICE_PORT
+          value: "9080"
+        - name: TOTAL_CHAOS_DURATION
+          value: "120"
+  - name: pod-network-latency
+    spec:
+      components:
+        env:
+        - name: NETWORK_LATENCY
+          value: "300"
+        - name: JITTER
+          value: "50"
+        - name: PODS_AFFECTED_PERC
+          value: "50"
+  - name: pod-cpu-hog
+`), convert.FromLitmus, upstreams)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Experiments).To(HaveLen(2))
+
+			status := result.Experiments[0]
+			Expect(status.Metadata).To(Equal(core.Metadata{Namespace: "bookinfo", Name: "reviews-chaos-pod-http-status-code"}))
+			Expect(*status.Spec.Duration).To(Equal(2 * time.Minute))
+			Expect(status.Spec.Faults[0].DestinationServices).To(Equal([]*core.ResourceRef{ref("bookinfo-reviews-9080")}))
+			Expect(status.Spec.Faults[0].Fault.GetAbort().GetHttpStatus()).To(Equal(int32(500)))
+			Expect(status.Spec.Faults[0].Fault.Percentage).To(Equal(50.0))
+
+			latency := result.Experiments[1]
+			Expect(latency.Metadata.Name).To(Equal("reviews-chaos-pod-network-latency"))
+			Expect(*latency.Spec.Duration).To(Equal(time.Minute))
+			Expect(latency.Spec.Faults[0].OriginServices).To(Equal([]*core.ResourceRef{ref("bookinfo-reviews-9080"), ref("bookinfo-reviews-9090")}))
+			Expect(latency.Spec.Faults[0].Fault.GetDelay().Duration).To(Equal(300 * time.Millisecond))
+
+			Expect(unmapped(result)).To(Equal([]string{
+				"ChaosEngine bookinfo.reviews-chaos: spec.experiments.pod-network-latency.env.JITTER is not supported by glooshot",
+				"ChaosEngine bookinfo.reviews-chaos: spec.experiments.pod-network-latency.env.PODS_AFFECTED_PERC selects some of the pods, the fault applies to the requests of all of them",
+				"ChaosEngine bookinfo.reviews-chaos: spec.experiments.pod-cpu-hog has no http equivalent, the experiment was not converted",
+			}))
+		})
+
+		It("names the experiment after the engine if it runs a single experiment", func() {
+			result, err := convert.Convert([]byte(`apiVersion: litmuschaos.io/v1alpha1
+kind: ChaosEngine
+metadata:
+  name: ratings-latency
+  namespace: bookinfo
+spec:
+  appinfo:
+    applabel: app=ratings
+  experiments:
+  - name: pod-http-latency
+`), convert.FromLitmus, upstreams)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Unmapped).To(BeEmpty())
+			Expect(result.Experiments).To(HaveLen(1))
+			Expect(result.Experiments[0].Metadata.Name).To(Equal("ratings-latency"))
+			Expect(result.Experiments[0].Spec.Faults[0].Fault.GetDelay().Duration).To(Equal(2 * time.Second))
+		})
+	})
+})
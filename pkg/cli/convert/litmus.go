@@ -0,0 +1,195 @@
+package convert
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	v1 "github.com/solo-io/glooshot/pkg/api/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// the api group of litmus resources
+const litmusGroup = "litmuschaos.io/"
+
+// the defaults of the litmus experiments
+const (
+	litmusDefaultDuration = 60 * time.Second
+	litmusDefaultLatency  = 2000 * time.Millisecond
+)
+
+// settings of the litmus operator and of the chaos libraries, which do not affect the faults
+var litmusExecutionSettings = []string{"engineState", "chaosServiceAccount", "annotationCheck", "jobCleanUpPolicy", "monitoring"}
+var litmusExecutionEnv = []string{"LIB", "LIB_IMAGE", "TC_IMAGE", "CONTAINER_RUNTIME", "SOCKET_PATH", "TARGET_CONTAINER",
+	"NETWORK_INTERFACE", "PROXY_PORT", "SEQUENCE"}
+
+type chaosEngineSpec struct {
+	Appinfo struct {
+		Appns    string `json:"appns"`
+		Applabel string `json:"applabel"`
+	} `json:"appinfo"`
+	Experiments []litmusExperiment `json:"experiments"`
+}
+
+type litmusExperiment struct {
+	Name string `json:"name"`
+	Spec struct {
+		Components struct {
+			Env []struct {
+				Name  string `json:"name"`
+				Value string `json:"value"`
+			} `json:"env"`
+		} `json:"components"`
+	} `json:"spec"`
+}
+
+func (c *converter) litmus(res resource) error {
+	if !strings.HasPrefix(res.APIVersion, litmusGroup) {
+		return nil
+	}
+	if res.Kind != "ChaosEngine" {
+		c.notConverted(res, "", "only ChaosEngines are converted")
+		return nil
+	}
+	var spec chaosEngineSpec
+	if err := json.Unmarshal(res.Spec, &spec); err != nil {
+		return errors.Wrapf(err, "invalid spec")
+	}
+	if err := c.unmappedFields(res, "spec.", res.Spec, append([]string{"appinfo", "experiments"}, litmusExecutionSettings...)...); err != nil {
+		return err
+	}
+	appLabels, err := labels.ConvertSelectorToLabelsMap(spec.Appinfo.Applabel)
+	if err != nil {
+		c.notConverted(res, "spec.appinfo.applabel", "%q is not a list of labels", spec.Appinfo.Applabel)
+		return nil
+	}
+	namespace := spec.Appinfo.Appns
+	if namespace == "" {
+		namespace = res.Metadata.Namespace
+	}
+	for _, exp := range spec.Experiments {
+		name := res.Metadata.Name
+		if len(spec.Experiments) > 1 {
+			name += "-" + exp.Name
+		}
+		if err := c.litmusExperiment(res, exp, name, namespace, appLabels); err != nil {
+			return errors.Wrapf(err, "experiment %v", exp.Name)
+		}
+	}
+	return nil
+}
+
+func (c *converter) litmusExperiment(res resource, exp litmusExperiment, name, appNamespace string, appLabels map[string]string) error {
+	field := fmt.Sprintf("spec.experiments.%v", exp.Name)
+	env := make(map[string]string)
+	for _, variable := range exp.Spec.Components.Env {
+		env[variable.Name] = variable.Value
+	}
+	// the variables of each experiment, the others are reported
+	var mapped []string
+	switch exp.Name {
+	case "pod-network-latency":
+		mapped = []string{"NETWORK_LATENCY"}
+	case "pod-http-latency":
+		mapped = []string{"LATENCY", "TARGET_SERVICE_PORT", "TOXICITY"}
+	case "pod-http-status-code":
+		mapped = []string{"STATUS_CODE", "TARGET_SERVICE_PORT", "TOXICITY", "MODIFY_RESPONSE_BODY"}
+	default:
+		c.unmapped(res, field, "has no http equivalent, the experiment was not converted")
+		return nil
+	}
+	mapped = append(mapped, "TOTAL_CHAOS_DURATION", "PODS_AFFECTED_PERC")
+	mapped = append(mapped, litmusExecutionEnv...)
+	var unmapped []string
+	for variable := range env {
+		if !contains(mapped, variable) {
+			unmapped = append(unmapped, variable)
+		}
+	}
+	sort.Strings(unmapped)
+	for _, variable := range unmapped {
+		c.unmapped(res, field+".env."+variable, "is not supported by glooshot")
+	}
+
+	duration := litmusDefaultDuration
+	if value := env["TOTAL_CHAOS_DURATION"]; value != "" {
+		seconds, err := strconv.Atoi(value)
+		if err != nil || seconds <= 0 {
+			return errors.Errorf("invalid TOTAL_CHAOS_DURATION %q, must be a number of seconds", value)
+		}
+		duration = time.Duration(seconds) * time.Second
+	}
+	if value := env["PODS_AFFECTED_PERC"]; value != "" && value != "0" && value != "100" {
+		c.unmapped(res, field+".env.PODS_AFFECTED_PERC", "selects some of the pods, the fault applies to the requests of all of them")
+	}
+	percentage := 100.0
+	if value := env["TOXICITY"]; value != "" {
+		var err error
+		percentage, err = strconv.ParseFloat(value, 64)
+		if err != nil || percentage <= 0 || percentage > 100 {
+			return errors.Errorf("invalid TOXICITY %q, must be a percentage", value)
+		}
+	}
+	var port uint32
+	if value := env["TARGET_SERVICE_PORT"]; value != "" {
+		parsed, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			return errors.Errorf("invalid TARGET_SERVICE_PORT %q", value)
+		}
+		port = uint32(parsed)
+	}
+
+	services := c.selectUpstreams([]string{appNamespace}, appLabels, port)
+	if len(services) == 0 {
+		// leaving out the services would inject the fault into all requests of the mesh
+		c.unmapped(res, field, "targets pods which match no upstream, the experiment was not converted")
+		return nil
+	}
+	fault := &v1.ExperimentSpec_InjectedFault{}
+	switch exp.Name {
+	case "pod-network-latency":
+		latency, err := litmusMillis(env, "NETWORK_LATENCY")
+		if err != nil {
+			return err
+		}
+		// the target pods delay the packets they send
+		fault.OriginServices = services
+		fault.Fault = delayFault(latency, percentage)
+	case "pod-http-latency":
+		latency, err := litmusMillis(env, "LATENCY")
+		if err != nil {
+			return err
+		}
+		fault.DestinationServices = services
+		fault.Fault = delayFault(latency, percentage)
+	case "pod-http-status-code":
+		status, err := strconv.ParseInt(env["STATUS_CODE"], 10, 32)
+		if err != nil || status < 100 || status > 599 {
+			return errors.Errorf("invalid STATUS_CODE %q, must be an http status", env["STATUS_CODE"])
+		}
+		if value := env["MODIFY_RESPONSE_BODY"]; value != "" && value != "false" {
+			c.unmapped(res, field+".env.MODIFY_RESPONSE_BODY", "is not supported by glooshot, the body of aborted requests is empty")
+		}
+		fault.DestinationServices = services
+		fault.Fault = abortFault(int32(status), percentage)
+	}
+	c.result.Experiments = append(c.result.Experiments, newExperiment(res.Metadata.Namespace, name, &duration, fault))
+	return nil
+}
+
+// parses a latency in milliseconds, litmus defaults to two seconds
+func litmusMillis(env map[string]string, variable string) (time.Duration, error) {
+	value := env[variable]
+	if value == "" {
+		return litmusDefaultLatency, nil
+	}
+	millis, err := strconv.Atoi(value)
+	if err != nil || millis <= 0 {
+		return 0, errors.Errorf("invalid %v %q, must be a number of milliseconds", variable, value)
+	}
+	return time.Duration(millis) * time.Millisecond, nil
+}
@@ -0,0 +1,214 @@
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	v1 "github.com/solo-io/glooshot/pkg/api/v1"
+	"github.com/solo-io/glooshot/pkg/utils"
+	"github.com/solo-io/go-utils/errors"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	skerrors "github.com/solo-io/solo-kit/pkg/errors"
+)
+
+const (
+	FormatJunit    = "junit"
+	FormatJson     = "json"
+	FormatYaml     = "yaml"
+	FormatMarkdown = "markdown"
+)
+
+var Formats = []string{FormatJunit, FormatJson, FormatYaml, FormatMarkdown}
+
+// an experiment along with the report of its measurements
+// the report is nil if the experiment has not concluded yet
+type ExperimentReport struct {
+	Experiment *v1.Experiment
+	Report     *v1.Report
+}
+
+// renders a single experiment report in the given format, as an object rather than a list in json
+func RenderOne(w io.Writer, format string, report ExperimentReport) error {
+	if format == FormatJson {
+		doc, err := marshalExperimentReport(report)
+		if err != nil {
+			return err
+		}
+		return writeJson(w, doc)
+	}
+	return Render(w, format, []ExperimentReport{report})
+}
+
+// renders the experiment reports in the given format, json is always an array however many reports there are
+func Render(w io.Writer, format string, reports []ExperimentReport) error {
+	switch format {
+	case FormatJunit:
+		return renderJunit(w, reports)
+	case FormatJson:
+		return renderJson(w, reports)
+	case FormatYaml:
+		return renderYaml(w, reports)
+	case FormatMarkdown:
+		return renderMarkdown(w, reports)
+	}
+	return errors.Errorf("unknown output format %q, must be one of %v", format, Formats)
+}
+
+func marshalExperimentReport(report ExperimentReport) ([]byte, error) {
+	doc := make(map[string]json.RawMessage)
+	if report.Experiment != nil {
+		b, err := marshalProto(report.Experiment)
+		if err != nil {
+			return nil, err
+		}
+		doc["experiment"] = b
+	}
+	if report.Report != nil {
+		b, err := marshalProto(report.Report)
+		if err != nil {
+			return nil, err
+		}
+		doc["report"] = b
+	}
+	return json.Marshal(doc)
+}
+
+func marshalProto(msg proto.Message) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := (&jsonpb.Marshaler{}).Marshal(&buf, msg); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func renderJson(w io.Writer, reports []ExperimentReport) error {
+	docs := []json.RawMessage{}
+	for _, report := range reports {
+		doc, err := marshalExperimentReport(report)
+		if err != nil {
+			return err
+		}
+		docs = append(docs, doc)
+	}
+	return writeJson(w, docs)
+}
+
+func writeJson(w io.Writer, out interface{}) error {
+	b, err := json.MarshalIndent(out, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(b, '\n'))
+	return err
+}
+
+func renderYaml(w io.Writer, reports []ExperimentReport) error {
+	for i, report := range reports {
+		doc, err := marshalExperimentReport(report)
+		if err != nil {
+			return err
+		}
+		b, err := yaml.JSONToYAML(doc)
+		if err != nil {
+			return err
+		}
+		if i > 0 {
+			if _, err := io.WriteString(w, "---\n"); err != nil {
+				return err
+			}
+		}
+		if _, err := w.Write(b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func timeFromProto(ts *types.Timestamp) (time.Time, bool) {
+	if ts == nil {
+		return time.Time{}, false
+	}
+	t, err := types.TimestampFromProto(ts)
+	return t, err == nil
+}
+
+// the time the experiment ran for, up to now if it has not finished
+func elapsed(exp *v1.Experiment) time.Duration {
+	start, ok := timeFromProto(exp.Result.TimeStarted)
+	if !ok {
+		return 0
+	}
+	end, ok := timeFromProto(exp.Result.TimeFinished)
+	if !ok {
+		end = time.Now()
+	}
+	return end.Sub(start)
+}
+
+// the name of the failure condition the experiment failed on, if known
+func breachedCondition(exp *v1.Experiment) string {
+	return exp.Result.FailureReport[utils.FailureReportKeyCondition]
+}
+
+// whether the leaf condition was met at the time the compound condition the experiment failed on was
+func metWithCompound(exp *v1.Experiment, name string) bool {
+	return exp.Result.FailureReport[utils.FailureReportKeyType] == "compound_condition_met" &&
+		exp.Result.FailureReport["condition."+name] == "met"
+}
+
+// reads the experiment along with its report, which is written under the same name once the experiment concludes
+func ReadExperimentReport(experiments v1.ExperimentClient, reports v1.ReportClient, namespace, name string) (ExperimentReport, error) {
+	exp, err := experiments.Read(namespace, name, clients.ReadOpts{})
+	if err != nil {
+		return ExperimentReport{}, errors.Wrapf(err, "could not read experiment %v.%v", namespace, name)
+	}
+	report, err := reports.Read(namespace, name, clients.ReadOpts{})
+	if err != nil {
+		if !skerrors.IsNotExist(err) {
+			return ExperimentReport{}, errors.Wrapf(err, "could not read report for experiment %v.%v", namespace, name)
+		}
+		report = nil
+	}
+	return ExperimentReport{Experiment: exp, Report: report}, nil
+}
+
+// reads the experiments in the given namespaces which started at or after the given time, along with their reports
+func ListExperimentReports(experiments v1.ExperimentClient, reports v1.ReportClient, namespaces []string, since time.Time) ([]ExperimentReport, error) {
+	var result []ExperimentReport
+	for _, ns := range namespaces {
+		exps, err := experiments.List(ns, clients.ListOpts{})
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not list experiments in namespace %v", ns)
+		}
+		nsReports, err := reports.List(ns, clients.ListOpts{})
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not list reports in namespace %v", ns)
+		}
+		for _, exp := range StartedSince(exps, since) {
+			report, _ := nsReports.Find(ns, exp.Metadata.Name)
+			result = append(result, ExperimentReport{Experiment: exp, Report: report})
+		}
+	}
+	return result, nil
+}
+
+// filters the experiments to those which started at or after the given time
+// experiments which have not started are excluded unless since is the zero time
+func StartedSince(exps v1.ExperimentList, since time.Time) v1.ExperimentList {
+	if since.IsZero() {
+		return exps
+	}
+	var filtered v1.ExperimentList
+	for _, exp := range exps {
+		if start, ok := timeFromProto(exp.Result.TimeStarted); ok && !start.Before(since) {
+			filtered = append(filtered, exp)
+		}
+	}
+	return filtered
+}
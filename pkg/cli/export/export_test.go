@@ -0,0 +1,173 @@
+package export_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/glooshot/pkg/api/v1"
+	"github.com/solo-io/glooshot/pkg/cli/export"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+func timestamp(t time.Time) *types.Timestamp {
+	ts, err := types.TimestampProto(t)
+	Expect(err).NotTo(HaveOccurred())
+	return ts
+}
+
+var _ = Describe("Export", func() {
+	var (
+		start  = time.Date(2019, 6, 1, 12, 0, 0, 0, time.UTC)
+		exp    *v1.Experiment
+		report *v1.Report
+	)
+
+	BeforeEach(func() {
+		exp = &v1.Experiment{
+			Metadata: core.Metadata{Namespace: "default", Name: "abort-reviews"},
+			Spec: &v1.ExperimentSpec{
+				FailureConditions: []*v1.FailureCondition{
+					{Name: "latency", Trigger: &v1.FailureCondition_Trigger{FailureTrigger: &v1.FailureCondition_Trigger_Prometheus{}}},
+					{Name: "errors", Trigger: &v1.FailureCondition_Trigger{FailureTrigger: &v1.FailureCondition_Trigger_Prometheus{}}},
+				},
+			},
+			Result: v1.ExperimentResult{
+				State:        v1.ExperimentResult_Failed,
+				TimeStarted:  timestamp(start),
+				TimeFinished: timestamp(start.Add(90 * time.Second)),
+				FailureReport: map[string]string{
+					"failure_type":      "value_exceeded_threshold",
+					"failure_condition": "errors",
+					"value":             "0.3",
+				},
+			},
+		}
+		report = &v1.Report{
+			Metadata: exp.Metadata,
+			FailureConditionHistory: []*v1.Report_FailureConditionHistory{{
+				FailureConditionName: "errors",
+				FailureConditionSnapshots: []*v1.Report_FailureConditionSnapshot{
+					{Value: 0.1, Timestamp: timestamp(start.Add(30 * time.Second))},
+					{Value: 0.3, Timestamp: timestamp(start.Add(60 * time.Second))},
+				},
+			}},
+		}
+	})
+
+	type testCase struct {
+		Name      string    `xml:"name,attr"`
+		Failure   *struct{} `xml:"failure"`
+		Error     *struct{} `xml:"error"`
+		Skipped   *struct{} `xml:"skipped"`
+		SystemOut string    `xml:"system-out"`
+	}
+	type testSuites struct {
+		Tests    int `xml:"tests,attr"`
+		Failures int `xml:"failures,attr"`
+		Suites   []struct {
+			Name      string     `xml:"name,attr"`
+			Time      string     `xml:"time,attr"`
+			TestCases []testCase `xml:"testcase"`
+		} `xml:"testsuite"`
+	}
+
+	renderJunit := func(reports ...export.ExperimentReport) testSuites {
+		var buf bytes.Buffer
+		Expect(export.Render(&buf, export.FormatJunit, reports)).NotTo(HaveOccurred())
+		var suites testSuites
+		Expect(xml.Unmarshal(buf.Bytes(), &suites)).NotTo(HaveOccurred())
+		return suites
+	}
+
+	It("renders the breached condition as a failed test case with its history", func() {
+		suites := renderJunit(export.ExperimentReport{Experiment: exp, Report: report})
+		Expect(suites.Tests).To(Equal(2))
+		Expect(suites.Failures).To(Equal(1))
+		Expect(suites.Suites).To(HaveLen(1))
+		suite := suites.Suites[0]
+		Expect(suite.Name).To(Equal("default.abort-reviews"))
+		Expect(suite.Time).To(Equal("90.000"))
+		Expect(suite.TestCases).To(HaveLen(2))
+		Expect(suite.TestCases[0].Name).To(Equal("latency"))
+		Expect(suite.TestCases[0].Failure).To(BeNil())
+		Expect(suite.TestCases[1].Name).To(Equal("errors"))
+		Expect(suite.TestCases[1].Failure).NotTo(BeNil())
+		Expect(suite.TestCases[1].SystemOut).To(Equal("timestamp,value\n2019-06-01T12:00:30Z,0.1\n2019-06-01T12:01:00Z,0.3"))
+	})
+
+	It("adds an experiment test case for failures not attributed to a condition", func() {
+		delete(exp.Result.FailureReport, "failure_condition")
+		suite := renderJunit(export.ExperimentReport{Experiment: exp}).Suites[0]
+		Expect(suite.TestCases).To(HaveLen(3))
+		Expect(suite.TestCases[2].Name).To(Equal("experiment"))
+		Expect(suite.TestCases[2].Failure).NotTo(BeNil())
+	})
+
+	It("renders rejected experiments as errors and running experiments as skipped", func() {
+		rejected := *exp
+		rejected.Result = v1.ExperimentResult{
+			State:         v1.ExperimentResult_Rejected,
+			FailureReport: map[string]string{"failure_type": "invalid_config", "message": "bad"},
+		}
+		running := *exp
+		running.Result = v1.ExperimentResult{State: v1.ExperimentResult_Started, TimeStarted: timestamp(start)}
+		suites := renderJunit(export.ExperimentReport{Experiment: &rejected}, export.ExperimentReport{Experiment: &running})
+		Expect(suites.Suites[0].TestCases[2].Error).NotTo(BeNil())
+		Expect(suites.Suites[1].TestCases[0].Skipped).NotTo(BeNil())
+		Expect(suites.Suites[1].TestCases[1].Skipped).NotTo(BeNil())
+	})
+
+	It("renders reports as a json array however many there are", func() {
+		for _, reports := range [][]export.ExperimentReport{
+			nil,
+			{{Experiment: exp, Report: report}},
+			{{Experiment: exp}, {Experiment: exp}},
+		} {
+			var buf bytes.Buffer
+			Expect(export.Render(&buf, export.FormatJson, reports)).NotTo(HaveOccurred())
+			var several []map[string]interface{}
+			Expect(json.Unmarshal(buf.Bytes(), &several)).NotTo(HaveOccurred())
+			Expect(several).To(HaveLen(len(reports)))
+		}
+	})
+
+	It("renders a single report as a json object", func() {
+		var buf bytes.Buffer
+		Expect(export.RenderOne(&buf, export.FormatJson, export.ExperimentReport{Experiment: exp, Report: report})).NotTo(HaveOccurred())
+		var single map[string]interface{}
+		Expect(json.Unmarshal(buf.Bytes(), &single)).NotTo(HaveOccurred())
+		Expect(single).To(HaveKey("experiment"))
+		Expect(single).To(HaveKey("report"))
+	})
+
+	It("separates yaml documents", func() {
+		var buf bytes.Buffer
+		Expect(export.Render(&buf, export.FormatYaml, []export.ExperimentReport{{Experiment: exp}, {Experiment: exp}})).NotTo(HaveOccurred())
+		Expect(bytes.Count(buf.Bytes(), []byte("---\n"))).To(Equal(1))
+	})
+
+	It("renders markdown with the breached condition", func() {
+		var buf bytes.Buffer
+		Expect(export.Render(&buf, export.FormatMarkdown, []export.ExperimentReport{{Experiment: exp, Report: report}})).NotTo(HaveOccurred())
+		Expect(buf.String()).To(ContainSubstring("# Experiment `default.abort-reviews`: Failed"))
+		Expect(buf.String()).To(ContainSubstring("| errors | 2 | 0.3 | yes |"))
+		Expect(buf.String()).To(ContainSubstring("| latency | 0 | - |  |"))
+	})
+
+	It("rejects unknown formats", func() {
+		Expect(export.Render(&bytes.Buffer{}, "csv", nil)).To(HaveOccurred())
+	})
+
+	It("filters experiments by start time", func() {
+		notStarted := &v1.Experiment{Metadata: core.Metadata{Name: "pending"}}
+		exps := v1.ExperimentList{exp, notStarted}
+		Expect(export.StartedSince(exps, time.Time{})).To(HaveLen(2))
+		Expect(export.StartedSince(exps, start)).To(Equal(v1.ExperimentList{exp}))
+		Expect(export.StartedSince(exps, start.Add(time.Second))).To(BeEmpty())
+	})
+})
@@ -0,0 +1,377 @@
+package export
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	v1 "github.com/solo-io/glooshot/pkg/api/v1"
+	"github.com/solo-io/glooshot/pkg/utils"
+)
+
+// dimensions of the condition charts, in pixels
+const (
+	chartWidth   = 640
+	chartHeight  = 200
+	chartPadding = 40
+)
+
+type htmlReport struct {
+	Title         string
+	State         string
+	StateClass    string
+	Started       string
+	Finished      string
+	Elapsed       string
+	Duration      string
+	TargetMesh    string
+	Timeline      []htmlTimelineEntry
+	Faults        []string
+	FailureReport []htmlKeyValue
+	Conditions    []htmlCondition
+}
+
+type htmlTimelineEntry struct {
+	Offset string
+	Time   string
+	Label  string
+	Class  string
+}
+
+type htmlKeyValue struct {
+	Key   string
+	Value string
+}
+
+type htmlCondition struct {
+	Name      string
+	Type      string
+	Breached  bool
+	Samples   int
+	LastValue string
+	Chart     *htmlChart
+}
+
+type htmlChart struct {
+	Width, Height int
+	Left, Right   int
+	Top, Bottom   int
+	Points        string
+	Thresholds    []htmlLine
+	Breach        *htmlPoint
+	YMin, YMax    string
+	XMin, XMax    string
+}
+
+type htmlLine struct {
+	Y     float64
+	Label string
+}
+
+type htmlPoint struct {
+	X, Y float64
+}
+
+// RenderHtml renders the experiment report as a single html document without external assets
+// the history of each failure condition is charted against its threshold
+func RenderHtml(w io.Writer, report ExperimentReport) error {
+	return htmlTemplate.Execute(w, htmlView(report))
+}
+
+func htmlView(report ExperimentReport) htmlReport {
+	exp := report.Experiment
+	ref := exp.Metadata.Ref()
+	view := htmlReport{
+		Title:      fmt.Sprintf("%v.%v", ref.Namespace, ref.Name),
+		State:      exp.Result.State.String(),
+		StateClass: strings.ToLower(exp.Result.State.String()),
+		Started:    markdownTime(exp.Result.TimeStarted),
+		Finished:   markdownTime(exp.Result.TimeFinished),
+		Elapsed:    elapsed(exp).Round(time.Second).String(),
+		Duration:   "-",
+	}
+	if exp.Spec != nil {
+		view.Duration = utils.ExperimentDuration(exp).String()
+	}
+	if exp.Spec != nil && exp.Spec.TargetMesh != nil {
+		view.TargetMesh = fmt.Sprintf("%v.%v", exp.Spec.TargetMesh.Namespace, exp.Spec.TargetMesh.Name)
+	}
+
+	var keys []string
+	for k := range exp.Result.FailureReport {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		view.FailureReport = append(view.FailureReport, htmlKeyValue{Key: k, Value: exp.Result.FailureReport[k]})
+	}
+
+	if exp.Spec != nil {
+		for _, fault := range exp.Spec.Faults {
+			view.Faults = append(view.Faults, utils.FaultSummary(fault))
+		}
+	}
+
+	histories := make(map[string]*v1.Report_FailureConditionHistory)
+	if report.Report != nil {
+		for _, history := range report.Report.FailureConditionHistory {
+			if history != nil {
+				histories[history.FailureConditionName] = history
+			}
+		}
+	}
+	start, end := chartRange(exp, histories)
+	breached := breachedCondition(exp)
+	var breachTime time.Time
+	if exp.Spec != nil {
+		for _, fc := range utils.FlattenFailureConditions(exp.Spec.FailureConditions) {
+			condition := htmlCondition{
+				Name:      fc.Name,
+				Type:      utils.FailureConditionType(fc),
+				Breached:  exp.Result.State == v1.ExperimentResult_Failed && fc.Name == breached,
+				LastValue: "-",
+			}
+			// the leaves which were met along with the breached compound condition are marked on their charts
+			marked := condition.Breached || (exp.Result.State == v1.ExperimentResult_Failed && metWithCompound(exp, fc.Name))
+			history := histories[fc.Name]
+			if history != nil && len(history.FailureConditionSnapshots) > 0 {
+				snapshots := history.FailureConditionSnapshots
+				condition.Samples = len(snapshots)
+				condition.LastValue = fmt.Sprintf("%v", snapshots[len(snapshots)-1].Value)
+				if last, ok := timeFromProto(snapshots[len(snapshots)-1].Timestamp); ok && marked && last.After(breachTime) {
+					breachTime = last
+				}
+				condition.Chart = conditionChart(snapshots, thresholds(fc, exp), marked, start, end)
+			}
+			view.Conditions = append(view.Conditions, condition)
+		}
+	}
+	if breachTime.IsZero() && breached != "" && exp.Result.State == v1.ExperimentResult_Failed {
+		// compound conditions have no history of their own, the experiment failed as soon as they were met
+		breachTime, _ = timeFromProto(exp.Result.TimeFinished)
+	}
+	view.Timeline = timeline(exp, breached, breachTime)
+	return view
+}
+
+// the horizontal lines marking where the condition is met
+func thresholds(fc *v1.FailureCondition, exp *v1.Experiment) []htmlLine {
+	if errorBudget := fc.Trigger.GetErrorBudget(); errorBudget != nil {
+		return []htmlLine{{Y: utils.BurnRateThreshold(errorBudget, exp), Label: "max burn rate"}}
+	}
+	prometheus := fc.Trigger.GetPrometheus()
+	if prometheus == nil {
+		return nil
+	}
+	comparison, err := utils.GetComparison(prometheus)
+	if err != nil {
+		return nil
+	}
+	if comparison.Operator == v1.PrometheusTrigger_OUTSIDE_RANGE {
+		return []htmlLine{{Y: comparison.Low, Label: "low"}, {Y: comparison.High, Label: "high"}}
+	}
+	return []htmlLine{{Y: comparison.Threshold, Label: "threshold (" + strings.ToLower(comparison.Operator.String()) + ")"}}
+}
+
+// the time span shared by all charts, from the start of the experiment to its end or the latest sample
+func chartRange(exp *v1.Experiment, histories map[string]*v1.Report_FailureConditionHistory) (time.Time, time.Time) {
+	start, hasStart := timeFromProto(exp.Result.TimeStarted)
+	end, hasEnd := timeFromProto(exp.Result.TimeFinished)
+	for _, history := range histories {
+		for _, snapshot := range history.FailureConditionSnapshots {
+			t, ok := timeFromProto(snapshot.Timestamp)
+			if !ok {
+				continue
+			}
+			if !hasStart || t.Before(start) {
+				start, hasStart = t, true
+			}
+			if !hasEnd || t.After(end) {
+				end, hasEnd = t, true
+			}
+		}
+	}
+	if !end.After(start) {
+		end = start.Add(time.Second)
+	}
+	return start, end
+}
+
+func conditionChart(snapshots []*v1.Report_FailureConditionSnapshot, lines []htmlLine, breached bool, start, end time.Time) *htmlChart {
+	yMin, yMax := math.Inf(1), math.Inf(-1)
+	for _, snapshot := range snapshots {
+		yMin, yMax = math.Min(yMin, snapshot.Value), math.Max(yMax, snapshot.Value)
+	}
+	for _, line := range lines {
+		yMin, yMax = math.Min(yMin, line.Y), math.Max(yMax, line.Y)
+	}
+	if yMin > 0 {
+		yMin = 0
+	}
+	if yMax <= yMin {
+		yMax = yMin + 1
+	}
+	span := end.Sub(start)
+	x := func(t time.Time) float64 {
+		return chartPadding + float64(t.Sub(start))/float64(span)*(chartWidth-2*chartPadding)
+	}
+	y := func(v float64) float64 {
+		return chartHeight - chartPadding - (v-yMin)/(yMax-yMin)*(chartHeight-2*chartPadding)
+	}
+
+	chart := &htmlChart{
+		Width:  chartWidth,
+		Height: chartHeight,
+		Left:   chartPadding,
+		Right:  chartWidth - chartPadding,
+		Top:    chartPadding,
+		Bottom: chartHeight - chartPadding,
+		YMin:   fmt.Sprintf("%.3g", yMin),
+		YMax:   fmt.Sprintf("%.3g", yMax),
+		XMin:   "0s",
+		XMax:   span.Round(time.Second).String(),
+	}
+	var points []string
+	var last htmlPoint
+	for _, snapshot := range snapshots {
+		t, ok := timeFromProto(snapshot.Timestamp)
+		if !ok {
+			continue
+		}
+		last = htmlPoint{X: x(t), Y: y(snapshot.Value)}
+		points = append(points, fmt.Sprintf("%.1f,%.1f", last.X, last.Y))
+	}
+	chart.Points = strings.Join(points, " ")
+	for _, line := range lines {
+		chart.Thresholds = append(chart.Thresholds, htmlLine{Y: y(line.Y), Label: fmt.Sprintf("%v %v", line.Label, line.Y)})
+	}
+	if breached && len(points) > 0 {
+		chart.Breach = &last
+	}
+	return chart
+}
+
+func timeline(exp *v1.Experiment, breached string, breachTime time.Time) []htmlTimelineEntry {
+	start, started := timeFromProto(exp.Result.TimeStarted)
+	entry := func(t time.Time, label, class string) htmlTimelineEntry {
+		offset := "-"
+		if started {
+			offset = "+" + t.Sub(start).Round(time.Second).String()
+		}
+		return htmlTimelineEntry{Offset: offset, Time: t.UTC().Format(time.RFC3339), Label: label, Class: class}
+	}
+	var entries []htmlTimelineEntry
+	if started {
+		label := "experiment started, faults injected"
+		if exp.Spec == nil || len(exp.Spec.Faults) == 0 {
+			label = "experiment started, no faults injected (control experiment)"
+		}
+		entries = append(entries, entry(start, label, "started"))
+	}
+	if !breachTime.IsZero() {
+		entries = append(entries, entry(breachTime, fmt.Sprintf("failure condition %v breached", breached), "failed"))
+	}
+	if finished, ok := timeFromProto(exp.Result.TimeFinished); ok {
+		state := strings.ToLower(exp.Result.State.String())
+		label := "experiment " + state
+		if started && exp.Spec != nil && len(exp.Spec.Faults) > 0 {
+			label += ", faults rolled back"
+		}
+		entries = append(entries, entry(finished, label, state))
+	}
+	return entries
+}
+
+var htmlTemplate = template.Must(template.New("report").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>glooshot experiment {{ .Title }}</title>
+<style>
+body { font-family: -apple-system, Helvetica, Arial, sans-serif; margin: 2em auto; max-width: 760px; color: #222; }
+h1 { font-size: 1.6em; }
+table { border-collapse: collapse; margin-bottom: 1.5em; }
+th, td { border: 1px solid #ccc; padding: 4px 10px; text-align: left; }
+.state { padding: 2px 8px; border-radius: 4px; color: #fff; background: #888; }
+.succeeded { background: #2e7d32; }
+.failed, .rejected { background: #c62828; }
+.started, .pending { background: #1565c0; }
+.timeline td.failed { color: #c62828; font-weight: bold; background: none; }
+svg { background: #fafafa; border: 1px solid #ddd; }
+svg text { font-size: 11px; fill: #555; }
+</style>
+</head>
+<body>
+<h1>Experiment {{ .Title }} <span class="state {{ .StateClass }}">{{ .State }}</span></h1>
+<table>
+<tr><th>Started</th><td>{{ .Started }}</td></tr>
+<tr><th>Finished</th><td>{{ .Finished }}</td></tr>
+<tr><th>Elapsed</th><td>{{ .Elapsed }}</td></tr>
+<tr><th>Duration</th><td>{{ .Duration }}</td></tr>
+{{- if .TargetMesh }}
+<tr><th>Target mesh</th><td>{{ .TargetMesh }}</td></tr>
+{{- end }}
+</table>
+
+<h2>Timeline</h2>
+<table class="timeline">
+{{- range .Timeline }}
+<tr><td>{{ .Offset }}</td><td>{{ .Time }}</td><td class="{{ .Class }}">{{ .Label }}</td></tr>
+{{- else }}
+<tr><td>the experiment has not started</td></tr>
+{{- end }}
+</table>
+
+<h2>Injected faults</h2>
+{{- if .Faults }}
+<ol>
+{{- range .Faults }}
+<li>{{ . }}</li>
+{{- end }}
+</ol>
+{{- else }}
+<p>none, this is a control experiment</p>
+{{- end }}
+
+{{- if .FailureReport }}
+<h2>Failure report</h2>
+<table>
+{{- range .FailureReport }}
+<tr><th>{{ .Key }}</th><td>{{ .Value }}</td></tr>
+{{- end }}
+</table>
+{{- end }}
+
+<h2>Failure conditions</h2>
+{{- range .Conditions }}
+<h3>{{ .Name }}{{ if .Breached }} &mdash; breached{{ end }}</h3>
+<p>{{ .Type }}, {{ .Samples }} samples, last value {{ .LastValue }}</p>
+{{- with .Chart }}
+<svg xmlns="http://www.w3.org/2000/svg" width="{{ .Width }}" height="{{ .Height }}" viewBox="0 0 {{ .Width }} {{ .Height }}">
+<line x1="{{ .Left }}" y1="{{ .Bottom }}" x2="{{ .Right }}" y2="{{ .Bottom }}" stroke="#999"/>
+<line x1="{{ .Left }}" y1="{{ .Top }}" x2="{{ .Left }}" y2="{{ .Bottom }}" stroke="#999"/>
+<text x="4" y="{{ .Top }}" dy="4">{{ .YMax }}</text>
+<text x="4" y="{{ .Bottom }}" dy="4">{{ .YMin }}</text>
+<text x="{{ .Left }}" y="{{ .Bottom }}" dy="16">{{ .XMin }}</text>
+<text x="{{ .Right }}" y="{{ .Bottom }}" dy="16" text-anchor="end">{{ .XMax }}</text>
+{{- $right := .Right }}{{ $left := .Left }}
+{{- range .Thresholds }}
+<line x1="{{ $left }}" y1="{{ printf "%.1f" .Y }}" x2="{{ $right }}" y2="{{ printf "%.1f" .Y }}" stroke="#c62828" stroke-dasharray="6,4"/>
+<text x="{{ $right }}" y="{{ printf "%.1f" .Y }}" dy="-4" text-anchor="end" style="fill:#c62828">{{ .Label }}</text>
+{{- end }}
+<polyline fill="none" stroke="#1565c0" stroke-width="2" points="{{ .Points }}"/>
+{{- with .Breach }}
+<circle cx="{{ printf "%.1f" .X }}" cy="{{ printf "%.1f" .Y }}" r="5" fill="#c62828"/>
+{{- end }}
+</svg>
+{{- else }}
+<p>no measurements recorded</p>
+{{- end }}
+{{- end }}
+</body>
+</html>
+`))
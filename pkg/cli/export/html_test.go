@@ -0,0 +1,131 @@
+package export_test
+
+import (
+	"bytes"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/glooshot/pkg/api/v1"
+	"github.com/solo-io/glooshot/pkg/cli/export"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("Html", func() {
+	start := time.Date(2019, 6, 1, 12, 0, 0, 0, time.UTC)
+	exp := &v1.Experiment{
+		Metadata: core.Metadata{Namespace: "default", Name: "abort-reviews"},
+		Spec: &v1.ExperimentSpec{
+			FailureConditions: []*v1.FailureCondition{{
+				Name: "errors",
+				Trigger: &v1.FailureCondition_Trigger{FailureTrigger: &v1.FailureCondition_Trigger_Prometheus{
+					Prometheus: &v1.PrometheusTrigger{
+						ThresholdValue: 0.2,
+						Operator:       v1.PrometheusTrigger_GREATER_THAN,
+					},
+				}},
+			}},
+		},
+		Result: v1.ExperimentResult{
+			State:         v1.ExperimentResult_Failed,
+			FailureReport: map[string]string{"failure_condition": "errors", "failure_type": "value_exceeded_threshold"},
+		},
+	}
+	report := &v1.Report{
+		Metadata: exp.Metadata,
+		FailureConditionHistory: []*v1.Report_FailureConditionHistory{{
+			FailureConditionName: "errors",
+		}},
+	}
+
+	BeforeEach(func() {
+		exp.Result.TimeStarted = timestamp(start)
+		exp.Result.TimeFinished = timestamp(start.Add(time.Minute))
+		report.FailureConditionHistory[0].FailureConditionSnapshots = []*v1.Report_FailureConditionSnapshot{
+			{Value: 0.1, Timestamp: timestamp(start.Add(20 * time.Second))},
+			{Value: 0.4, Timestamp: timestamp(start.Add(time.Minute))},
+		}
+	})
+
+	It("charts each condition against its threshold", func() {
+		var buf bytes.Buffer
+		Expect(export.RenderHtml(&buf, export.ExperimentReport{Experiment: exp, Report: report})).NotTo(HaveOccurred())
+		html := buf.String()
+		Expect(html).To(ContainSubstring("Experiment default.abort-reviews"))
+		// the values span 0 to 0.4 over y=160 to y=40, the samples x=40 at the start to x=600 at the end of the experiment
+		Expect(html).To(ContainSubstring(`points="226.7,130.0 600.0,40.0"`))
+		Expect(html).To(ContainSubstring(`y1="100.0"`))
+		Expect(html).To(ContainSubstring("threshold (greater_than) 0.2"))
+		Expect(html).To(ContainSubstring(`<circle cx="600.0" cy="40.0"`))
+		Expect(html).To(ContainSubstring("failure condition errors breached"))
+		Expect(html).To(ContainSubstring("none, this is a control experiment"))
+		Expect(html).NotTo(ContainSubstring("<script"))
+		Expect(html).NotTo(ContainSubstring("src="))
+	})
+
+	It("marks the breach of compound conditions on the leaves which were met", func() {
+		errors := exp.Spec.FailureConditions[0]
+		compound := &v1.Experiment{
+			Metadata: exp.Metadata,
+			Spec: &v1.ExperimentSpec{
+				FailureConditions: []*v1.FailureCondition{{
+					Name: "errors-and-latency",
+					Trigger: &v1.FailureCondition_Trigger{FailureTrigger: &v1.FailureCondition_Trigger_Compound{
+						Compound: &v1.CompoundTrigger{
+							Operator:   v1.CompoundTrigger_OR,
+							Conditions: []*v1.FailureCondition{errors, {Name: "latency"}},
+						},
+					}},
+				}},
+			},
+			Result: v1.ExperimentResult{
+				State:        v1.ExperimentResult_Failed,
+				TimeStarted:  exp.Result.TimeStarted,
+				TimeFinished: timestamp(start.Add(90 * time.Second)),
+				FailureReport: map[string]string{
+					"failure_condition": "errors-and-latency",
+					"failure_type":      "compound_condition_met",
+					"condition.errors":  "met",
+					"condition.latency": "not_met",
+					"met_conditions":    "errors",
+				},
+			},
+		}
+		var buf bytes.Buffer
+		Expect(export.RenderHtml(&buf, export.ExperimentReport{Experiment: compound, Report: report})).NotTo(HaveOccurred())
+		html := buf.String()
+		Expect(html).To(ContainSubstring("errors-and-latency &mdash; breached"))
+		Expect(html).To(ContainSubstring(`<circle cx=`))
+		// the breach is dated by the last sample of the met leaf
+		Expect(html).To(MatchRegexp(`<td>\+1m0s</td><td>[^<]*</td><td class="failed">failure condition errors-and-latency breached`))
+	})
+
+	It("dates the breach of compound conditions without samples by the end of the experiment", func() {
+		unsampled := &v1.Experiment{
+			Metadata: exp.Metadata,
+			Spec: &v1.ExperimentSpec{
+				FailureConditions: []*v1.FailureCondition{{
+					Name: "errors-and-latency",
+					Trigger: &v1.FailureCondition_Trigger{FailureTrigger: &v1.FailureCondition_Trigger_Compound{
+						Compound: &v1.CompoundTrigger{Operator: v1.CompoundTrigger_OR, Conditions: []*v1.FailureCondition{{Name: "errors"}}},
+					}},
+				}},
+			},
+			Result: v1.ExperimentResult{
+				State:         v1.ExperimentResult_Failed,
+				TimeStarted:   timestamp(start),
+				TimeFinished:  timestamp(start.Add(90 * time.Second)),
+				FailureReport: map[string]string{"failure_condition": "errors-and-latency", "failure_type": "compound_condition_met"},
+			},
+		}
+		var buf bytes.Buffer
+		Expect(export.RenderHtml(&buf, export.ExperimentReport{Experiment: unsampled})).NotTo(HaveOccurred())
+		Expect(buf.String()).To(MatchRegexp(`<td>\+1m30s</td><td>[^<]*</td><td class="failed">failure condition errors-and-latency breached`))
+	})
+
+	It("renders experiments without measurements", func() {
+		var buf bytes.Buffer
+		Expect(export.RenderHtml(&buf, export.ExperimentReport{Experiment: exp})).NotTo(HaveOccurred())
+		Expect(buf.String()).To(ContainSubstring("no measurements recorded"))
+	})
+})
@@ -0,0 +1,219 @@
+package export
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	v1 "github.com/solo-io/glooshot/pkg/api/v1"
+	"github.com/solo-io/glooshot/pkg/utils"
+)
+
+// each experiment is rendered as a test suite, with a test case per failure condition
+// the condition the experiment failed on is reported as a failure, the measurement history of each
+// condition is attached as its system-out
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Errors   int              `xml:"errors,attr"`
+	Time     string           `xml:"time,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name       string          `xml:"name,attr"`
+	Tests      int             `xml:"tests,attr"`
+	Failures   int             `xml:"failures,attr"`
+	Errors     int             `xml:"errors,attr"`
+	Skipped    int             `xml:"skipped,attr"`
+	Time       string          `xml:"time,attr"`
+	Timestamp  string          `xml:"timestamp,attr,omitempty"`
+	Properties []junitProperty `xml:"properties>property,omitempty"`
+	TestCases  []junitTestCase `xml:"testcase"`
+}
+
+type junitProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	Error     *junitFailure `xml:"error,omitempty"`
+	Skipped   *junitSkipped `xml:"skipped,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Body    string `xml:",chardata"`
+}
+
+type junitSkipped struct {
+	Message string `xml:"message,attr"`
+}
+
+// the pseudo test case used for results which cannot be attributed to a failure condition
+const experimentTestCase = "experiment"
+
+func renderJunit(w io.Writer, reports []ExperimentReport) error {
+	suites := junitTestSuites{Name: "glooshot"}
+	var total time.Duration
+	for _, report := range reports {
+		suite := junitSuite(report)
+		suites.Suites = append(suites.Suites, suite)
+		suites.Tests += suite.Tests
+		suites.Failures += suite.Failures
+		suites.Errors += suite.Errors
+		total += elapsed(report.Experiment)
+	}
+	suites.Time = junitSeconds(total)
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(suites); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func junitSuite(report ExperimentReport) junitTestSuite {
+	exp := report.Experiment
+	ref := exp.Metadata.Ref()
+	className := ref.Namespace + "." + ref.Name
+	duration := junitSeconds(elapsed(exp))
+	suite := junitTestSuite{
+		Name: className,
+		Time: duration,
+		Properties: []junitProperty{
+			{Name: "state", Value: exp.Result.State.String()},
+		},
+	}
+	if start, ok := timeFromProto(exp.Result.TimeStarted); ok {
+		suite.Timestamp = start.UTC().Format("2006-01-02T15:04:05")
+	}
+	if exp.Spec != nil && exp.Spec.Duration != nil {
+		suite.Properties = append(suite.Properties, junitProperty{Name: "duration", Value: exp.Spec.Duration.String()})
+	}
+
+	histories := make(map[string]*v1.Report_FailureConditionHistory)
+	if report.Report != nil {
+		for _, history := range report.Report.FailureConditionHistory {
+			if history != nil {
+				histories[history.FailureConditionName] = history
+			}
+		}
+	}
+
+	breached := breachedCondition(exp)
+	attributed := false
+	var conditions []*v1.FailureCondition
+	if exp.Spec != nil {
+		conditions = utils.FlattenFailureConditions(exp.Spec.FailureConditions)
+	}
+	for _, fc := range conditions {
+		testCase := junitTestCase{
+			Name:      fc.Name,
+			ClassName: className,
+			Time:      duration,
+			SystemOut: historyText(histories[fc.Name]),
+		}
+		switch {
+		case exp.Result.State == v1.ExperimentResult_Failed && fc.Name == breached:
+			testCase.Failure = failureFor(exp)
+			attributed = true
+		case exp.Result.State == v1.ExperimentResult_Pending || exp.Result.State == v1.ExperimentResult_Started:
+			testCase.Skipped = &junitSkipped{Message: "experiment has not concluded"}
+		}
+		suite.TestCases = append(suite.TestCases, testCase)
+	}
+
+	switch {
+	case exp.Result.State == v1.ExperimentResult_Failed && !attributed:
+		suite.TestCases = append(suite.TestCases, junitTestCase{
+			Name:      experimentTestCase,
+			ClassName: className,
+			Time:      duration,
+			Failure:   failureFor(exp),
+		})
+	case exp.Result.State == v1.ExperimentResult_Rejected:
+		suite.TestCases = append(suite.TestCases, junitTestCase{
+			Name:      experimentTestCase,
+			ClassName: className,
+			Time:      duration,
+			Error: &junitFailure{
+				Message: exp.Result.FailureReport["message"],
+				Type:    exp.Result.FailureReport[utils.FailureReportKeyType],
+				Body:    failureReportText(exp.Result.FailureReport),
+			},
+		})
+	}
+
+	for _, testCase := range suite.TestCases {
+		suite.Tests++
+		switch {
+		case testCase.Failure != nil:
+			suite.Failures++
+		case testCase.Error != nil:
+			suite.Errors++
+		case testCase.Skipped != nil:
+			suite.Skipped++
+		}
+	}
+	return suite
+}
+
+func failureFor(exp *v1.Experiment) *junitFailure {
+	failureType := exp.Result.FailureReport[utils.FailureReportKeyType]
+	return &junitFailure{
+		Message: fmt.Sprintf("failure condition met: %v", failureType),
+		Type:    failureType,
+		Body:    failureReportText(exp.Result.FailureReport),
+	}
+}
+
+func failureReportText(report map[string]string) string {
+	var keys []string
+	for k := range report {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var lines []string
+	for _, k := range keys {
+		lines = append(lines, fmt.Sprintf("%v: %v", k, report[k]))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// the measurements of the condition as csv
+func historyText(history *v1.Report_FailureConditionHistory) string {
+	if history == nil || len(history.FailureConditionSnapshots) == 0 {
+		return ""
+	}
+	lines := []string{"timestamp,value"}
+	for _, snapshot := range history.FailureConditionSnapshots {
+		timestamp := ""
+		if t, ok := timeFromProto(snapshot.Timestamp); ok {
+			timestamp = t.UTC().Format(time.RFC3339)
+		}
+		lines = append(lines, fmt.Sprintf("%v,%v", timestamp, snapshot.Value))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func junitSeconds(d time.Duration) string {
+	return fmt.Sprintf("%.3f", d.Seconds())
+}
@@ -0,0 +1,86 @@
+package export
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	v1 "github.com/solo-io/glooshot/pkg/api/v1"
+	"github.com/solo-io/glooshot/pkg/utils"
+)
+
+func renderMarkdown(w io.Writer, reports []ExperimentReport) error {
+	var sections []string
+	for _, report := range reports {
+		sections = append(sections, markdownSection(report))
+	}
+	_, err := io.WriteString(w, strings.Join(sections, "\n"))
+	return err
+}
+
+func markdownSection(report ExperimentReport) string {
+	exp := report.Experiment
+	ref := exp.Metadata.Ref()
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Experiment `%v.%v`: %v\n\n", ref.Namespace, ref.Name, exp.Result.State.String())
+
+	b.WriteString("| Started | Finished | Elapsed |\n|---|---|---|\n")
+	fmt.Fprintf(&b, "| %v | %v | %v |\n\n", markdownTime(exp.Result.TimeStarted), markdownTime(exp.Result.TimeFinished), elapsed(exp).Round(time.Second))
+
+	if len(exp.Result.FailureReport) > 0 {
+		b.WriteString("## Failure report\n\n| Key | Value |\n|---|---|\n")
+		var keys []string
+		for k := range exp.Result.FailureReport {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			fmt.Fprintf(&b, "| %v | `%v` |\n", k, exp.Result.FailureReport[k])
+		}
+		b.WriteString("\n")
+	}
+
+	if exp.Spec != nil && len(exp.Spec.FailureConditions) > 0 {
+		histories := make(map[string]*v1.Report_FailureConditionHistory)
+		if report.Report != nil {
+			for _, history := range report.Report.FailureConditionHistory {
+				if history != nil {
+					histories[history.FailureConditionName] = history
+				}
+			}
+		}
+		b.WriteString("## Failure conditions\n\n| Condition | Samples | Last value | Breached |\n|---|---|---|---|\n")
+		for _, fc := range utils.FlattenFailureConditions(exp.Spec.FailureConditions) {
+			samples, lastValue := 0, "-"
+			if history := histories[fc.Name]; history != nil && len(history.FailureConditionSnapshots) > 0 {
+				samples = len(history.FailureConditionSnapshots)
+				lastValue = fmt.Sprintf("%v", history.FailureConditionSnapshots[samples-1].Value)
+			}
+			breached := ""
+			if exp.Result.State == v1.ExperimentResult_Failed && fc.Name == breachedCondition(exp) {
+				breached = "yes"
+			}
+			fmt.Fprintf(&b, "| %v | %v | %v | %v |\n", fc.Name, samples, lastValue, breached)
+		}
+		b.WriteString("\n")
+	}
+
+	if exp.Spec != nil && len(exp.Spec.Faults) > 0 {
+		b.WriteString("## Faults\n\n")
+		for i, fault := range exp.Spec.Faults {
+			fmt.Fprintf(&b, "%v. %v\n", i+1, fault.String())
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+func markdownTime(ts *types.Timestamp) string {
+	if t, ok := timeFromProto(ts); ok {
+		return t.UTC().Format(time.RFC3339)
+	}
+	return "-"
+}
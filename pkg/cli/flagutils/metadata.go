@@ -10,6 +10,7 @@ import (
 func AddMetadataFlags(set *pflag.FlagSet, in *core.Metadata) {
 	set.StringVar(&in.Name, "name", "", "name for the resource")
 	set.StringVar(&in.Namespace, "namespace", "glooshot", "namespace for the resource")
+	options.AnnotateConfigKey(set, "namespace", options.ConfigKeyNamespace)
 }
 
 func AddInitFlags(set *pflag.FlagSet, init *options.Init) {
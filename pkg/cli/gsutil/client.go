@@ -2,29 +2,111 @@ package gsutil
 
 import (
 	"context"
+	"sync"
 
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	sgv1 "github.com/solo-io/supergloo/pkg/api/v1"
 
 	"github.com/pkg/errors"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
 
 	v1 "github.com/solo-io/glooshot/pkg/api/v1"
 	"github.com/solo-io/go-utils/kubeutils"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/kube"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/kube/crd"
 )
 
-func GetExperimentClient(ctx context.Context, skipCrdCreation bool) (v1.ExperimentClient, error) {
-	cfg, err := kubeutils.GetConfig("", "")
+// KubeOptions select the cluster the clients of the process connect to
+type KubeOptions struct {
+	// Kubeconfig is the path of the kubeconfig file, if empty the in-cluster config,
+	// $KUBECONFIG or ~/.kube/config is used
+	Kubeconfig string
+	// Context is the context of the kubeconfig to use, its current context if empty
+	Context string
+}
+
+// all clients of the process share a single rest config and kube cache
+var shared struct {
+	sync.Mutex
+	opts       KubeOptions
+	cfg        *rest.Config
+	kubeClient *kubernetes.Clientset
+	// carries the shared config and cache, copied for each client
+	baseFactory *factory.KubeResourceClientFactory
+}
+
+// SetKubeOptions selects the kubeconfig and context for all clients of the process
+// must be called before any client is created
+func SetKubeOptions(opts KubeOptions) {
+	shared.Lock()
+	defer shared.Unlock()
+	shared.opts = opts
+	shared.cfg = nil
+	shared.kubeClient = nil
+	shared.baseFactory = nil
+}
+
+// GetRestConfig returns the rest config shared by all clients of the process
+func GetRestConfig() (*rest.Config, error) {
+	shared.Lock()
+	defer shared.Unlock()
+	return sharedRestConfig()
+}
+
+// must be called with the shared lock held
+func sharedRestConfig() (*rest.Config, error) {
+	if shared.cfg != nil {
+		return shared.cfg, nil
+	}
+	cfg, err := loadRestConfig(shared.opts)
 	if err != nil {
 		return nil, err
 	}
-	cache := kube.NewKubeCache(ctx)
-	rcFactory := &factory.KubeResourceClientFactory{
-		Crd:             v1.ExperimentCrd,
-		Cfg:             cfg,
-		SharedCache:     cache,
-		SkipCrdCreation: skipCrdCreation,
+	shared.cfg = cfg
+	return cfg, nil
+}
+
+func loadRestConfig(opts KubeOptions) (*rest.Config, error) {
+	if opts.Kubeconfig == "" && opts.Context == "" {
+		return kubeutils.GetConfig("", "")
+	}
+	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	loadingRules.ExplicitPath = opts.Kubeconfig
+	overrides := &clientcmd.ConfigOverrides{CurrentContext: opts.Context}
+	cfg, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, overrides).ClientConfig()
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not load kubeconfig %q with context %q", opts.Kubeconfig, opts.Context)
+	}
+	return cfg, nil
+}
+
+// the kube cache is created with the context of the first client, as it lives as long as the process
+func resourceClientFactory(ctx context.Context, resourceCrd crd.Crd, skipCrdCreation bool) (*factory.KubeResourceClientFactory, error) {
+	shared.Lock()
+	defer shared.Unlock()
+	if shared.baseFactory == nil {
+		cfg, err := sharedRestConfig()
+		if err != nil {
+			return nil, err
+		}
+		shared.baseFactory = &factory.KubeResourceClientFactory{
+			Cfg:         cfg,
+			SharedCache: kube.NewKubeCache(ctx),
+		}
+	}
+	rcFactory := *shared.baseFactory
+	rcFactory.Crd = resourceCrd
+	rcFactory.SkipCrdCreation = skipCrdCreation
+	return &rcFactory, nil
+}
+
+func GetExperimentClient(ctx context.Context, skipCrdCreation bool) (v1.ExperimentClient, error) {
+	rcFactory, err := resourceClientFactory(ctx, v1.ExperimentCrd, skipCrdCreation)
+	if err != nil {
+		return nil, err
 	}
 	client, err := v1.NewExperimentClient(rcFactory)
 	if err != nil {
@@ -37,17 +119,10 @@ func GetExperimentClient(ctx context.Context, skipCrdCreation bool) (v1.Experime
 }
 
 func GetReportClient(ctx context.Context, skipCrdCreation bool) (v1.ReportClient, error) {
-	cfg, err := kubeutils.GetConfig("", "")
+	rcFactory, err := resourceClientFactory(ctx, v1.ReportCrd, skipCrdCreation)
 	if err != nil {
 		return nil, err
 	}
-	cache := kube.NewKubeCache(ctx)
-	rcFactory := &factory.KubeResourceClientFactory{
-		Crd:             v1.ReportCrd,
-		Cfg:             cfg,
-		SharedCache:     cache,
-		SkipCrdCreation: skipCrdCreation,
-	}
 	client, err := v1.NewReportClient(rcFactory)
 	if err != nil {
 		return nil, err
@@ -58,17 +133,55 @@ func GetReportClient(ctx context.Context, skipCrdCreation bool) (v1.ReportClient
 	return client, nil
 }
 
-func GetRoutingRuleClient(ctx context.Context, skipCrdCreation bool) (sgv1.RoutingRuleClient, error) {
-	cfg, err := kubeutils.GetConfig("", "")
+func GetExperimentTemplateClient(ctx context.Context, skipCrdCreation bool) (v1.ExperimentTemplateClient, error) {
+	rcFactory, err := resourceClientFactory(ctx, v1.ExperimentTemplateCrd, skipCrdCreation)
 	if err != nil {
 		return nil, err
 	}
-	cache := kube.NewKubeCache(ctx)
-	rcFactory := &factory.KubeResourceClientFactory{
-		Crd:             sgv1.RoutingRuleCrd,
-		Cfg:             cfg,
-		SharedCache:     cache,
-		SkipCrdCreation: skipCrdCreation,
+	client, err := v1.NewExperimentTemplateClient(rcFactory)
+	if err != nil {
+		return nil, err
+	}
+	if err := client.Register(); err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
+func GetExperimentSuiteClient(ctx context.Context, skipCrdCreation bool) (v1.ExperimentSuiteClient, error) {
+	rcFactory, err := resourceClientFactory(ctx, v1.ExperimentSuiteCrd, skipCrdCreation)
+	if err != nil {
+		return nil, err
+	}
+	client, err := v1.NewExperimentSuiteClient(rcFactory)
+	if err != nil {
+		return nil, err
+	}
+	if err := client.Register(); err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
+func GetReportSinkClient(ctx context.Context, skipCrdCreation bool) (v1.ReportSinkClient, error) {
+	rcFactory, err := resourceClientFactory(ctx, v1.ReportSinkCrd, skipCrdCreation)
+	if err != nil {
+		return nil, err
+	}
+	client, err := v1.NewReportSinkClient(rcFactory)
+	if err != nil {
+		return nil, err
+	}
+	if err := client.Register(); err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
+func GetRoutingRuleClient(ctx context.Context, skipCrdCreation bool) (sgv1.RoutingRuleClient, error) {
+	rcFactory, err := resourceClientFactory(ctx, sgv1.RoutingRuleCrd, skipCrdCreation)
+	if err != nil {
+		return nil, err
 	}
 	client, err := sgv1.NewRoutingRuleClient(rcFactory)
 	if err != nil {
@@ -81,17 +194,10 @@ func GetRoutingRuleClient(ctx context.Context, skipCrdCreation bool) (sgv1.Routi
 }
 
 func GetMeshClient(ctx context.Context, skipCrdCreation bool) (sgv1.MeshClient, error) {
-	cfg, err := kubeutils.GetConfig("", "")
+	rcFactory, err := resourceClientFactory(ctx, sgv1.MeshCrd, skipCrdCreation)
 	if err != nil {
 		return nil, err
 	}
-	cache := kube.NewKubeCache(ctx)
-	rcFactory := &factory.KubeResourceClientFactory{
-		Crd:             sgv1.MeshCrd,
-		Cfg:             cfg,
-		SharedCache:     cache,
-		SkipCrdCreation: skipCrdCreation,
-	}
 	client, err := sgv1.NewMeshClient(rcFactory)
 	if err != nil {
 		return nil, err
@@ -102,8 +208,28 @@ func GetMeshClient(ctx context.Context, skipCrdCreation bool) (sgv1.MeshClient,
 	return client, nil
 }
 
+func GetUpstreamClient(ctx context.Context, skipCrdCreation bool) (gloov1.UpstreamClient, error) {
+	rcFactory, err := resourceClientFactory(ctx, gloov1.UpstreamCrd, skipCrdCreation)
+	if err != nil {
+		return nil, err
+	}
+	client, err := gloov1.NewUpstreamClient(rcFactory)
+	if err != nil {
+		return nil, err
+	}
+	if err := client.Register(); err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
 func GetKubeClient() (*kubernetes.Clientset, error) {
-	restCfg, err := kubeutils.GetConfig("", "")
+	shared.Lock()
+	defer shared.Unlock()
+	if shared.kubeClient != nil {
+		return shared.kubeClient, nil
+	}
+	restCfg, err := sharedRestConfig()
 	if err != nil {
 		return &kubernetes.Clientset{}, errors.Wrapf(err, "no Kubernetes context config found; please double check your Kubernetes environment")
 	}
@@ -111,6 +237,7 @@ func GetKubeClient() (*kubernetes.Clientset, error) {
 	if err != nil {
 		return &kubernetes.Clientset{}, errors.Wrapf(err, "error connecting to current Kubernetes Context Host %s; please double check your Kubernetes environment", restCfg.Host)
 	}
+	shared.kubeClient = kubeClient
 	return kubeClient, nil
 }
 
@@ -125,9 +252,14 @@ type ClientCache struct {
 	registerCrds bool
 
 	// internal cache
-	kubeClient *kubernetes.Clientset
-	expClient  *v1.ExperimentClient
-	repClient  *v1.ReportClient
+	kubeClient  *kubernetes.Clientset
+	expClient   *v1.ExperimentClient
+	repClient   *v1.ReportClient
+	tmplClient  *v1.ExperimentTemplateClient
+	suiteClient *v1.ExperimentSuiteClient
+	rrClient    *sgv1.RoutingRuleClient
+	meshClient  *sgv1.MeshClient
+	usClient    *gloov1.UpstreamClient
 }
 
 func NewClientCache(ctx context.Context, registerCrds bool, handleError func(error)) ClientCache {
@@ -165,6 +297,57 @@ func (cc *ClientCache) ReportClient() v1.ReportClient {
 	return *cc.repClient
 }
 
+func (cc *ClientCache) ExperimentTemplateClient() v1.ExperimentTemplateClient {
+	if cc.tmplClient == nil {
+		tmplClient, err := GetExperimentTemplateClient(cc.ctx, !cc.registerCrds)
+		cc.check(err)
+		cc.tmplClient = &tmplClient
+	}
+	return *cc.tmplClient
+}
+
+func (cc *ClientCache) ExperimentSuiteClient() v1.ExperimentSuiteClient {
+	if cc.suiteClient == nil {
+		suiteClient, err := GetExperimentSuiteClient(cc.ctx, !cc.registerCrds)
+		cc.check(err)
+		cc.suiteClient = &suiteClient
+	}
+	return *cc.suiteClient
+}
+
+func (cc *ClientCache) RoutingRuleClient() sgv1.RoutingRuleClient {
+	if cc.rrClient == nil {
+		rrClient, err := GetRoutingRuleClient(cc.ctx, !cc.registerCrds)
+		cc.check(err)
+		cc.rrClient = &rrClient
+	}
+	return *cc.rrClient
+}
+
+func (cc *ClientCache) MeshClient() sgv1.MeshClient {
+	if cc.meshClient == nil {
+		meshClient, err := GetMeshClient(cc.ctx, !cc.registerCrds)
+		cc.check(err)
+		cc.meshClient = &meshClient
+	}
+	return *cc.meshClient
+}
+
+func (cc *ClientCache) UpstreamClient() gloov1.UpstreamClient {
+	if cc.usClient == nil {
+		usClient, err := GetUpstreamClient(cc.ctx, !cc.registerCrds)
+		cc.check(err)
+		cc.usClient = &usClient
+	}
+	return *cc.usClient
+}
+
+// SetKubeOptions selects the kubeconfig and context the clients connect to
+// must be called before any client is used
+func (cc *ClientCache) SetKubeOptions(opts KubeOptions) {
+	SetKubeOptions(opts)
+}
+
 func (cc *ClientCache) Ctx() context.Context {
 	return cc.ctx
 }
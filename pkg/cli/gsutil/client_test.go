@@ -0,0 +1,83 @@
+package gsutil_test
+
+import (
+	"io/ioutil"
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/glooshot/pkg/cli/gsutil"
+)
+
+const kubeconfig = `apiVersion: v1
+kind: Config
+current-context: staging
+clusters:
+- name: staging
+  cluster:
+    server: https://staging.example.com
+- name: prod
+  cluster:
+    server: https://prod.example.com
+contexts:
+- name: staging
+  context:
+    cluster: staging
+    user: admin
+- name: prod
+  context:
+    cluster: prod
+    user: admin
+users:
+- name: admin
+  user:
+    token: secret
+`
+
+var _ = Describe("Kube options", func() {
+
+	var path string
+
+	BeforeEach(func() {
+		f, err := ioutil.TempFile("", "kubeconfig")
+		Expect(err).NotTo(HaveOccurred())
+		_, err = f.WriteString(kubeconfig)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(f.Close()).To(Succeed())
+		path = f.Name()
+	})
+
+	AfterEach(func() {
+		gsutil.SetKubeOptions(gsutil.KubeOptions{})
+		os.Remove(path)
+	})
+
+	It("uses the current context of the given kubeconfig", func() {
+		gsutil.SetKubeOptions(gsutil.KubeOptions{Kubeconfig: path})
+		cfg, err := gsutil.GetRestConfig()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg.Host).To(Equal("https://staging.example.com"))
+	})
+
+	It("uses the given context", func() {
+		gsutil.SetKubeOptions(gsutil.KubeOptions{Kubeconfig: path, Context: "prod"})
+		cfg, err := gsutil.GetRestConfig()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg.Host).To(Equal("https://prod.example.com"))
+	})
+
+	It("shares the config between calls", func() {
+		gsutil.SetKubeOptions(gsutil.KubeOptions{Kubeconfig: path})
+		first, err := gsutil.GetRestConfig()
+		Expect(err).NotTo(HaveOccurred())
+		second, err := gsutil.GetRestConfig()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(second).To(BeIdenticalTo(first))
+	})
+
+	It("fails for unknown contexts", func() {
+		gsutil.SetKubeOptions(gsutil.KubeOptions{Kubeconfig: path, Context: "dev"})
+		_, err := gsutil.GetRestConfig()
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,25 @@
+package gsutil
+
+import (
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+)
+
+// OperatorDeployment is the name of the deployment of the glooshot operator in its install namespace
+const OperatorDeployment = "glooshot"
+
+// DeploymentReady returns whether the rollout of the deployment completed, along with a description of its progress
+func DeploymentReady(deployment *appsv1.Deployment) (bool, string) {
+	replicas := int32(1)
+	if deployment.Spec.Replicas != nil {
+		replicas = *deployment.Spec.Replicas
+	}
+	status := deployment.Status
+	progress := fmt.Sprintf("%v of %v replicas updated, %v available", status.UpdatedReplicas, replicas, status.AvailableReplicas)
+	if status.ObservedGeneration < deployment.Generation {
+		return false, "the rollout has not started yet"
+	}
+	ready := status.UpdatedReplicas == replicas && status.AvailableReplicas == replicas && status.Replicas == replicas
+	return ready, progress
+}
@@ -0,0 +1,41 @@
+package gsutil_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/glooshot/pkg/cli/gsutil"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Operator readiness", func() {
+
+	deployment := func(generation, observed int64, replicas, updated, available int32) *appsv1.Deployment {
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Generation: generation},
+			Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+			Status: appsv1.DeploymentStatus{
+				ObservedGeneration: observed,
+				Replicas:           replicas,
+				UpdatedReplicas:    updated,
+				AvailableReplicas:  available,
+			},
+		}
+	}
+
+	It("is ready once all replicas are updated and available", func() {
+		ready, status := gsutil.DeploymentReady(deployment(2, 2, 2, 2, 2))
+		Expect(ready).To(BeTrue())
+		Expect(status).To(Equal("2 of 2 replicas updated, 2 available"))
+	})
+
+	It("is not ready while the rollout is in progress", func() {
+		ready, status := gsutil.DeploymentReady(deployment(2, 2, 2, 1, 1))
+		Expect(ready).To(BeFalse())
+		Expect(status).To(Equal("1 of 2 replicas updated, 1 available"))
+
+		ready, status = gsutil.DeploymentReady(deployment(3, 2, 2, 2, 2))
+		Expect(ready).To(BeFalse())
+		Expect(status).To(Equal("the rollout has not started yet"))
+	})
+})
@@ -0,0 +1,13 @@
+package gsutil_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestGsutil(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Gsutil Suite")
+}
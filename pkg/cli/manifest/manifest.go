@@ -0,0 +1,221 @@
+package manifest
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	v1 "github.com/solo-io/glooshot/pkg/api/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Stdin is the path which reads manifests from stdin
+const Stdin = "-"
+
+var documentSeparator = regexp.MustCompile(`(?m)^---[ \t]*$`)
+
+// Documents splits a multi-document yaml or json manifest, documents which are empty or only contain whitespace are kept
+// so that the index of a document is its position in the manifest
+func Documents(content []byte) []string {
+	return documentSeparator.Split(string(content), -1)
+}
+
+// ReadExperiments reads the experiments from a file, from all yaml and json files of a directory, or from stdin
+func ReadExperiments(path string, stdin io.Reader) ([]*v1.Experiment, error) {
+	if path == Stdin {
+		content, err := ioutil.ReadAll(stdin)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not read stdin")
+		}
+		return ParseExperiments(content)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return readExperimentsFile(path)
+	}
+	files, err := ioutil.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+	var exps []*v1.Experiment
+	for _, file := range files {
+		switch filepath.Ext(file.Name()) {
+		case ".yaml", ".yml", ".json":
+		default:
+			continue
+		}
+		if file.IsDir() {
+			continue
+		}
+		fileExps, err := readExperimentsFile(filepath.Join(path, file.Name()))
+		if err != nil {
+			return nil, err
+		}
+		exps = append(exps, fileExps...)
+	}
+	return exps, nil
+}
+
+func readExperimentsFile(path string) ([]*v1.Experiment, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	exps, err := ParseExperiments(content)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid experiment in %v", path)
+	}
+	return exps, nil
+}
+
+// ReadSuite reads a single experiment suite from a yaml or json file, or from stdin
+func ReadSuite(path string, stdin io.Reader) (*v1.ExperimentSuite, error) {
+	var (
+		content []byte
+		err     error
+	)
+	if path == Stdin {
+		content, err = ioutil.ReadAll(stdin)
+	} else {
+		content, err = ioutil.ReadFile(path)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read experiment suite")
+	}
+	return ParseSuite(content)
+}
+
+// ParseSuite parses a manifest containing a single experiment suite, either a glooshot resource or a kubernetes resource
+func ParseSuite(content []byte) (*v1.ExperimentSuite, error) {
+	var suite *v1.ExperimentSuite
+	for i, doc := range Documents(content) {
+		if strings.TrimSpace(doc) == "" {
+			continue
+		}
+		parsed := &v1.ExperimentSuite{}
+		ok, err := parseDocument([]byte(doc), v1.ExperimentSuiteCrd.TypeMeta(), parsed)
+		if err != nil {
+			return nil, errors.Wrapf(err, "document %v", i+1)
+		}
+		if !ok {
+			continue
+		}
+		if suite != nil {
+			return nil, errors.Errorf("expected a single experiment suite, found several")
+		}
+		suite = parsed
+	}
+	if suite == nil {
+		return nil, errors.Errorf("no experiment suite found")
+	}
+	return suite, nil
+}
+
+// ParseExperiments parses the experiments of a multi-document yaml or json manifest
+// documents are either glooshot experiments, or kubernetes resources with an apiVersion, kind, metadata and spec
+// like those read and written by kubectl
+func ParseExperiments(content []byte) ([]*v1.Experiment, error) {
+	var exps []*v1.Experiment
+	for i, doc := range Documents(content) {
+		if strings.TrimSpace(doc) == "" {
+			continue
+		}
+		exp, err := parseExperiment([]byte(doc))
+		if err != nil {
+			return nil, errors.Wrapf(err, "document %v", i+1)
+		}
+		if exp != nil {
+			exps = append(exps, exp)
+		}
+	}
+	return exps, nil
+}
+
+// returns nil for documents which only contain comments
+func parseExperiment(doc []byte) (*v1.Experiment, error) {
+	exp := &v1.Experiment{}
+	ok, err := parseDocument(doc, v1.ExperimentCrd.TypeMeta(), exp)
+	if err != nil || !ok {
+		return nil, err
+	}
+	return exp, nil
+}
+
+// unmarshals a glooshot resource, or a kubernetes resource of the expected type, into the resource
+// returns false for documents which only contain comments
+func parseDocument(doc []byte, expected metav1.TypeMeta, resource proto.Message) (bool, error) {
+	jsn, err := yaml.YAMLToJSON(doc)
+	if err != nil {
+		return false, err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(jsn, &fields); err != nil {
+		return false, errors.Errorf("expected an object")
+	}
+	if fields == nil {
+		return false, nil
+	}
+	if fields["kind"] != nil || fields["apiVersion"] != nil {
+		jsn, err = fromKubeResource(jsn, expected)
+		if err != nil {
+			return false, err
+		}
+	}
+	if err := jsonpb.Unmarshal(bytes.NewReader(jsn), resource); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// a kubernetes resource storing a glooshot resource
+// solo-kit stores the fields of a resource, other than its metadata and status, in the spec of the kubernetes resource
+type kubeResource struct {
+	Kind       string                     `json:"kind"`
+	APIVersion string                     `json:"apiVersion"`
+	Metadata   kubeMetadata               `json:"metadata"`
+	Spec       map[string]json.RawMessage `json:"spec"`
+}
+
+// the metadata of kubernetes resources which carries over to glooshot resources
+type kubeMetadata struct {
+	Name        string            `json:"name,omitempty"`
+	Namespace   string            `json:"namespace,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// converts a kubernetes resource to the json of the glooshot resource it stores
+func fromKubeResource(jsn []byte, expected metav1.TypeMeta) ([]byte, error) {
+	var resource kubeResource
+	if err := json.Unmarshal(jsn, &resource); err != nil {
+		return nil, errors.Wrapf(err, "invalid kubernetes resource")
+	}
+	if resource.Kind != expected.Kind {
+		return nil, errors.Errorf("unsupported kind %q, only %v resources can be created", resource.Kind, expected.Kind)
+	}
+	if resource.APIVersion != expected.APIVersion {
+		return nil, errors.Errorf("unsupported apiVersion %q, must be %v", resource.APIVersion, expected.APIVersion)
+	}
+	fields := resource.Spec
+	if fields == nil {
+		fields = make(map[string]json.RawMessage)
+	}
+	metadata, err := json.Marshal(resource.Metadata)
+	if err != nil {
+		return nil, err
+	}
+	fields["metadata"] = metadata
+	return json.Marshal(fields)
+}
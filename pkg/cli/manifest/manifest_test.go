@@ -0,0 +1,162 @@
+package manifest_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/glooshot/pkg/api/v1"
+	"github.com/solo-io/glooshot/pkg/cli/manifest"
+)
+
+const glooshotDoc = `metadata:
+  name: abort-ratings
+  namespace: bookinfo
+spec:
+  duration: 60s
+  faults:
+  - destinationServices:
+    - name: ratings-9080
+      namespace: glooshot
+    fault:
+      abort:
+        httpStatus: 500
+      percentage: 100
+`
+
+const kubeDoc = `apiVersion: glooshot.solo.io/v1
+kind: Experiment
+metadata:
+  name: delay-reviews
+  labels:
+    team: chaos
+  uid: 0d5c7a4e-5c2f-11e9-8647-d663bd873d93
+spec:
+  spec:
+    duration: 120s
+    targetMesh:
+      name: istio
+      namespace: supergloo-system
+`
+
+const suiteDoc = `apiVersion: glooshot.solo.io/v1
+kind: ExperimentSuite
+metadata:
+  name: game-day
+  namespace: bookinfo
+spec:
+  mode: STOP_ON_FIRST_FAILURE
+  coolDown: 5m
+  experiments:
+  - name: abort-ratings
+    template:
+      template:
+        name: abort
+      values:
+        destination: glooshot.ratings-9080
+  - name: delay-reviews
+    spec:
+      duration: 120s
+`
+
+var _ = Describe("Manifests", func() {
+
+	It("parses multi-document yaml with glooshot and kubernetes style documents", func() {
+		exps, err := manifest.ParseExperiments([]byte("# experiments\n---\n" + glooshotDoc + "---\n" + kubeDoc + "---\n"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(exps).To(HaveLen(2))
+
+		Expect(exps[0].Metadata.Name).To(Equal("abort-ratings"))
+		Expect(exps[0].Metadata.Namespace).To(Equal("bookinfo"))
+		Expect(*exps[0].Spec.Duration).To(Equal(time.Minute))
+		Expect(exps[0].Spec.Faults[0].Fault.GetAbort().GetHttpStatus()).To(BeEquivalentTo(500))
+
+		Expect(exps[1].Metadata.Name).To(Equal("delay-reviews"))
+		Expect(exps[1].Metadata.Namespace).To(BeEmpty())
+		Expect(exps[1].Metadata.Labels).To(Equal(map[string]string{"team": "chaos"}))
+		Expect(*exps[1].Spec.Duration).To(Equal(2 * time.Minute))
+		Expect(exps[1].Spec.TargetMesh.Name).To(Equal("istio"))
+	})
+
+	It("parses json", func() {
+		exps, err := manifest.ParseExperiments([]byte(`{"metadata": {"name": "exp"}, "spec": {"duration": "30s"}}`))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(exps).To(HaveLen(1))
+		Expect(*exps[0].Spec.Duration).To(Equal(30 * time.Second))
+	})
+
+	It("rejects other kinds of resources", func() {
+		_, err := manifest.ParseExperiments([]byte(strings.Replace(kubeDoc, "kind: Experiment", "kind: Report", 1)))
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(`unsupported kind "Report"`))
+
+		_, err = manifest.ParseExperiments([]byte(strings.Replace(kubeDoc, "glooshot.solo.io/v1", "glooshot.solo.io/v2", 1)))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("reports which document is invalid", func() {
+		_, err := manifest.ParseExperiments([]byte(glooshotDoc + "---\nspec:\n  unknownField: true\n"))
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("document 2"))
+	})
+
+	Context("reading", func() {
+
+		var dir string
+
+		BeforeEach(func() {
+			var err error
+			dir, err = ioutil.TempDir("", "manifests")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ioutil.WriteFile(filepath.Join(dir, "a.yaml"), []byte(glooshotDoc), 0644)).To(Succeed())
+			Expect(ioutil.WriteFile(filepath.Join(dir, "b.yml"), []byte(kubeDoc), 0644)).To(Succeed())
+			Expect(ioutil.WriteFile(filepath.Join(dir, "README.md"), []byte("# not an experiment"), 0644)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(dir)
+		})
+
+		It("reads the yaml and json files of a directory", func() {
+			exps, err := manifest.ReadExperiments(dir, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exps).To(HaveLen(2))
+			Expect(exps[0].Metadata.Name).To(Equal("abort-ratings"))
+			Expect(exps[1].Metadata.Name).To(Equal("delay-reviews"))
+		})
+
+		It("reads a file", func() {
+			exps, err := manifest.ReadExperiments(filepath.Join(dir, "b.yml"), nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exps).To(HaveLen(1))
+		})
+
+		It("reads stdin", func() {
+			exps, err := manifest.ReadExperiments(manifest.Stdin, strings.NewReader(glooshotDoc))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exps).To(HaveLen(1))
+		})
+	})
+
+	It("parses a single experiment suite", func() {
+		suite, err := manifest.ParseSuite([]byte("# game day\n---\n" + suiteDoc))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(suite.Metadata.Name).To(Equal("game-day"))
+		Expect(suite.Mode).To(Equal(v1.ExperimentSuite_STOP_ON_FIRST_FAILURE))
+		Expect(*suite.CoolDown).To(Equal(5 * time.Minute))
+		Expect(suite.Experiments).To(HaveLen(2))
+		Expect(suite.Experiments[0].GetTemplate().Template.Name).To(Equal("abort"))
+		Expect(suite.Experiments[0].GetTemplate().Values).To(HaveKeyWithValue("destination", "glooshot.ratings-9080"))
+		Expect(*suite.Experiments[1].GetSpec().Duration).To(Equal(2 * time.Minute))
+
+		_, err = manifest.ParseSuite([]byte(suiteDoc + "---\n" + suiteDoc))
+		Expect(err).To(HaveOccurred())
+		_, err = manifest.ParseSuite([]byte(kubeDoc))
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(`unsupported kind "Experiment"`))
+	})
+})
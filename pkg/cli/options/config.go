@@ -0,0 +1,252 @@
+package options
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/mitchellh/go-homedir"
+	"github.com/pkg/errors"
+	v1 "github.com/solo-io/glooshot/pkg/api/v1"
+	"github.com/solo-io/glooshot/pkg/cli/gsutil"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// flags annotated with a config key take their default from that key of the config file
+// values given on the command line always override the config file
+const ConfigKeyAnnotation = "glooshot.solo.io/config-key"
+
+// the keys of the config file
+const (
+	ConfigKeyNamespace                = "namespace"
+	ConfigKeyOutput                   = "output"
+	ConfigKeyTargetMesh               = "targetMesh"
+	ConfigKeyKubeContext              = "kubeContext"
+	ConfigKeyPrometheusUrl            = "prometheusUrl"
+	ConfigKeyDefaultFailureConditions = "defaultFailureConditions"
+)
+
+// Config provides default values for glooshot commands, it is read from TopOptions.ConfigFile
+type Config struct {
+	// Namespace is the default namespace of glooshot resources
+	Namespace string `json:"namespace,omitempty"`
+	// Output is the default output format
+	Output string `json:"output,omitempty"`
+	// TargetMesh is the mesh experiments which do not specify one are applied to, as namespace.name
+	TargetMesh string `json:"targetMesh,omitempty"`
+	// KubeContext is the context of the kubeconfig to use, defaults to its current context
+	KubeContext string `json:"kubeContext,omitempty"`
+	// PrometheusUrl is used to validate the prometheus queries of experiments before they are created
+	PrometheusUrl string `json:"prometheusUrl,omitempty"`
+	// DefaultFailureConditions are added to experiments which are created without failure conditions
+	DefaultFailureConditions FailureConditions `json:"defaultFailureConditions,omitempty"`
+}
+
+// FailureConditions are (un)marshalled with jsonpb, as encoding/json does not support oneofs
+type FailureConditions []*v1.FailureCondition
+
+func (fcs FailureConditions) MarshalJSON() ([]byte, error) {
+	items := []json.RawMessage{}
+	for _, fc := range fcs {
+		var buf bytes.Buffer
+		if err := (&jsonpb.Marshaler{}).Marshal(&buf, fc); err != nil {
+			return nil, err
+		}
+		items = append(items, buf.Bytes())
+	}
+	return json.Marshal(items)
+}
+
+func (fcs *FailureConditions) UnmarshalJSON(data []byte) error {
+	var items []json.RawMessage
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	*fcs = nil
+	for _, item := range items {
+		fc := &v1.FailureCondition{}
+		if err := jsonpb.Unmarshal(bytes.NewReader(item), fc); err != nil {
+			return err
+		}
+		*fcs = append(*fcs, fc)
+	}
+	return nil
+}
+
+// ConfigKeys lists the keys which can be set in the config file
+func ConfigKeys() []string {
+	keys := []string{
+		ConfigKeyNamespace,
+		ConfigKeyOutput,
+		ConfigKeyTargetMesh,
+		ConfigKeyKubeContext,
+		ConfigKeyPrometheusUrl,
+		ConfigKeyDefaultFailureConditions,
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Get returns the value of the key, empty if it is not set
+func (c *Config) Get(key string) (string, error) {
+	switch key {
+	case ConfigKeyNamespace:
+		return c.Namespace, nil
+	case ConfigKeyOutput:
+		return c.Output, nil
+	case ConfigKeyTargetMesh:
+		return c.TargetMesh, nil
+	case ConfigKeyKubeContext:
+		return c.KubeContext, nil
+	case ConfigKeyPrometheusUrl:
+		return c.PrometheusUrl, nil
+	case ConfigKeyDefaultFailureConditions:
+		if len(c.DefaultFailureConditions) == 0 {
+			return "", nil
+		}
+		b, err := json.Marshal(c.DefaultFailureConditions)
+		if err != nil {
+			return "", err
+		}
+		b, err = yaml.JSONToYAML(b)
+		return string(b), err
+	}
+	return "", errors.Errorf("unknown config key %v, must be one of %v", key, strings.Join(ConfigKeys(), ", "))
+}
+
+// Set sets the key to the value, an empty value unsets it
+// default failure conditions are given as a yaml list
+func (c *Config) Set(key, value string) error {
+	switch key {
+	case ConfigKeyNamespace:
+		c.Namespace = value
+	case ConfigKeyOutput:
+		c.Output = value
+	case ConfigKeyTargetMesh:
+		if value != "" {
+			if _, err := ParseResourceRef(value); err != nil {
+				return err
+			}
+		}
+		c.TargetMesh = value
+	case ConfigKeyKubeContext:
+		c.KubeContext = value
+	case ConfigKeyPrometheusUrl:
+		c.PrometheusUrl = value
+	case ConfigKeyDefaultFailureConditions:
+		var fcs FailureConditions
+		if value != "" {
+			b, err := yaml.YAMLToJSON([]byte(value))
+			if err != nil {
+				return errors.Wrapf(err, "invalid failure conditions")
+			}
+			if err := json.Unmarshal(b, &fcs); err != nil {
+				return errors.Wrapf(err, "invalid failure conditions")
+			}
+		}
+		c.DefaultFailureConditions = fcs
+	default:
+		return errors.Errorf("unknown config key %v, must be one of %v", key, strings.Join(ConfigKeys(), ", "))
+	}
+	return nil
+}
+
+// ParseResourceRef parses a resource ref given as namespace.name
+func ParseResourceRef(ref string) (*core.ResourceRef, error) {
+	parts := strings.SplitN(ref, ".", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, errors.Errorf("invalid resource reference %q, must be of the form namespace.name", ref)
+	}
+	return &core.ResourceRef{Namespace: parts[0], Name: parts[1]}, nil
+}
+
+// ReadConfig reads the config file, a missing file is an empty config
+func ReadConfig(path string) (*Config, error) {
+	path, err := expandHome(path)
+	if err != nil {
+		return nil, err
+	}
+	b, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return &Config{}, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read config file %v", path)
+	}
+	config := &Config{}
+	if err := yaml.Unmarshal(b, config); err != nil {
+		return nil, errors.Wrapf(err, "invalid config file %v", path)
+	}
+	return config, nil
+}
+
+// WriteConfig writes the config file, creating its directory if needed
+func WriteConfig(path string, config *Config) error {
+	path, err := expandHome(path)
+	if err != nil {
+		return err
+	}
+	b, err := yaml.Marshal(config)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return errors.Wrapf(err, "could not create config directory")
+	}
+	return ioutil.WriteFile(path, b, 0644)
+}
+
+func expandHome(path string) (string, error) {
+	expanded, err := homedir.Expand(path)
+	if err != nil {
+		return "", errors.Wrapf(err, "could not determine home directory")
+	}
+	return expanded, nil
+}
+
+// AnnotateConfigKey makes the flag take its default from the given key of the config file
+func AnnotateConfigKey(set *pflag.FlagSet, flag, key string) {
+	// only fails if the flag does not exist
+	_ = set.SetAnnotation(flag, ConfigKeyAnnotation, []string{key})
+}
+
+// ApplyConfig reads the config file and uses its values as defaults for the flags of the command which were not set
+// the kube options of the clients are set from the resulting flag values
+func ApplyConfig(o *Options, cmd *cobra.Command) error {
+	config, err := ReadConfig(o.Top.ConfigFile)
+	if err != nil {
+		return err
+	}
+	o.Top.Config = *config
+	var setErr error
+	cmd.Flags().VisitAll(func(f *pflag.Flag) {
+		keys := f.Annotations[ConfigKeyAnnotation]
+		if f.Changed || len(keys) == 0 || setErr != nil {
+			return
+		}
+		value, err := config.Get(keys[0])
+		if err != nil || value == "" {
+			setErr = err
+			return
+		}
+		if err := f.Value.Set(value); err != nil {
+			setErr = errors.Wrapf(err, "invalid value %q for %v in config file", value, keys[0])
+		}
+	})
+	if setErr != nil {
+		return setErr
+	}
+	o.Clients.SetKubeOptions(gsutil.KubeOptions{
+		Kubeconfig: o.Top.Kubeconfig,
+		Context:    o.Top.KubeContext,
+	})
+	return nil
+}
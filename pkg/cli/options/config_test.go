@@ -0,0 +1,110 @@
+package options_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/glooshot/pkg/cli/options"
+	"github.com/spf13/cobra"
+)
+
+var _ = Describe("Config", func() {
+
+	var dir string
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "glooshot-config")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	It("sets and gets values", func() {
+		config := &options.Config{}
+		Expect(config.Set(options.ConfigKeyNamespace, "chaos")).To(Succeed())
+		Expect(config.Set(options.ConfigKeyTargetMesh, "supergloo-system.istio")).To(Succeed())
+		Expect(config.Get(options.ConfigKeyNamespace)).To(Equal("chaos"))
+		Expect(config.Get(options.ConfigKeyTargetMesh)).To(Equal("supergloo-system.istio"))
+
+		Expect(config.Set(options.ConfigKeyNamespace, "")).To(Succeed())
+		Expect(config.Namespace).To(BeEmpty())
+	})
+
+	It("rejects unknown keys and invalid values", func() {
+		config := &options.Config{}
+		Expect(config.Set("color", "blue")).To(HaveOccurred())
+		Expect(config.Set(options.ConfigKeyTargetMesh, "istio")).To(HaveOccurred())
+		Expect(config.Set(options.ConfigKeyDefaultFailureConditions, "- trigger: 42")).To(HaveOccurred())
+	})
+
+	It("round trips default failure conditions through the config file", func() {
+		config := &options.Config{}
+		Expect(config.Set(options.ConfigKeyDefaultFailureConditions, `
+- name: errors
+  trigger:
+    prometheus:
+      customQuery: sum(rate(errors[1m]))
+      thresholdValue: 0.1
+      comparisonOperator: ">"
+`)).To(Succeed())
+		path := filepath.Join(dir, "nested", "config.yaml")
+		Expect(options.WriteConfig(path, config)).To(Succeed())
+
+		read, err := options.ReadConfig(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(read.DefaultFailureConditions).To(HaveLen(1))
+		fc := read.DefaultFailureConditions[0]
+		Expect(fc.Name).To(Equal("errors"))
+		Expect(fc.Trigger.GetPrometheus().GetCustomQuery()).To(Equal("sum(rate(errors[1m]))"))
+		Expect(fc.Trigger.GetPrometheus().ThresholdValue).To(Equal(0.1))
+	})
+
+	It("treats a missing config file as empty", func() {
+		config, err := options.ReadConfig(filepath.Join(dir, "missing.yaml"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*config).To(Equal(options.Config{}))
+	})
+
+	Context("applying the config to flags", func() {
+
+		var (
+			o   options.Options
+			cmd *cobra.Command
+		)
+
+		BeforeEach(func() {
+			o = options.InitialOptions(context.Background())
+			o.Top.ConfigFile = filepath.Join(dir, "config.yaml")
+			Expect(options.WriteConfig(o.Top.ConfigFile, &options.Config{Namespace: "chaos", Output: "wide"})).To(Succeed())
+
+			cmd = &cobra.Command{Use: "test"}
+			flags := cmd.Flags()
+			flags.StringVarP(&o.Metadata.Namespace, "namespace", "n", "default", "")
+			options.AnnotateConfigKey(flags, "namespace", options.ConfigKeyNamespace)
+			flags.StringVarP(&o.Top.Output, "output", "o", "", "")
+			options.AnnotateConfigKey(flags, "output", options.ConfigKeyOutput)
+		})
+
+		It("uses config values for flags which were not set", func() {
+			Expect(cmd.ParseFlags(nil)).To(Succeed())
+			Expect(options.ApplyConfig(&o, cmd)).To(Succeed())
+			Expect(o.Metadata.Namespace).To(Equal("chaos"))
+			Expect(o.Top.Output).To(Equal("wide"))
+			Expect(o.Top.Config.Namespace).To(Equal("chaos"))
+		})
+
+		It("lets flags override the config", func() {
+			Expect(cmd.ParseFlags([]string{"-n", "prod"})).To(Succeed())
+			Expect(options.ApplyConfig(&o, cmd)).To(Succeed())
+			Expect(o.Metadata.Namespace).To(Equal("prod"))
+			Expect(o.Top.Output).To(Equal("wide"))
+		})
+	})
+})
@@ -2,6 +2,7 @@ package options
 
 import (
 	"context"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/surveyutils"
@@ -11,9 +12,14 @@ import (
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	"go.uber.org/zap"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
 )
 
 var ExperimentAliases = []string{"experiment", "experiments", "exp"}
+var ReportAliases = []string{"report", "reports"}
+var TemplateAliases = []string{"template", "templates", "exptemplate", "experimenttemplates"}
+var SuiteAliases = []string{"suite", "suites", "expsuite", "experimentsuites"}
 
 /*------------------------------------------------------------------------------
 Options
@@ -24,27 +30,52 @@ type Options struct {
 	Clients gsutil.ClientCache
 	Top     TopOptions
 	// Metadata identifies a resource for commands that operate on a particular resource
-	Metadata core.Metadata
-	Create   CreateOptions
-	Delete   DeleteOptions
-	Get      GetOptions
-	Init     Init
-	cache    optionsCache
+	Metadata  core.Metadata
+	Create    CreateOptions
+	Delete    DeleteOptions
+	Get       GetOptions
+	Export    ExportOptions
+	Report    ReportOptions
+	Run       RunOptions
+	Scenario  ScenarioOptions
+	Convert   ConvertOptions
+	Init      Init
+	Uninstall UninstallOptions
+	Check     CheckOptions
+	Config    ConfigOptions
+	cache     optionsCache
 }
 
 type TopOptions struct {
 	// ConfigFile provides default values for glooshot commands.
 	// Can be overwritten by flags.
-	// TODO(mitchdraft) -add config file
 	ConfigFile string
 
+	// Config is the content of the config file, read before any command runs
+	Config Config
+
 	// Interactive indicates whether or not we are in an interactive input mode
 	Interactive bool
+
+	// Output is the format resources are printed in, one of table, wide, yaml or json
+	// commands which render reports also accept their report formats
+	Output string
+
+	// Kubeconfig is the kubeconfig file the clients use, $KUBECONFIG or ~/.kube/config if empty
+	Kubeconfig string
+
+	// KubeContext is the context of the kubeconfig the clients use, its current context if empty
+	KubeContext string
 }
 
 type CreateOptions struct {
-	// CreateFile contains the glooshot api resource that should be created
+	// CreateFile is the file or directory containing the glooshot api resources that should be created or applied,
+	// - reads them from stdin
 	CreateFile string
+	// FromTemplate is the name of the experiment template to render the experiment from
+	FromTemplate string
+	// Set are the values of the parameters of the template, as key=value
+	Set []string
 }
 
 type DeleteOptions struct {
@@ -56,6 +87,42 @@ type DeleteOptions struct {
 
 type GetOptions struct {
 	AllNamespaces bool
+	// Selector is a label selector to filter resources on, e.g. key1=value1,key2=value2
+	Selector string
+	// History is the name of an experiment whose runs should be listed
+	History string
+}
+
+type ExportOptions struct {
+	AllNamespaces bool
+	// Since limits the export to experiments which started within this long ago, all experiments are exported if zero
+	Since time.Duration
+	// File is the file reports are written to, stdout if empty
+	File string
+}
+
+type ReportOptions struct {
+	// File is the file the report is written to
+	File string
+}
+
+type RunOptions struct {
+	// File is the file the experiment suite is read from, - reads it from stdin
+	File string
+	// Timeout is how long to wait for the suite to conclude, no limit if zero
+	Timeout time.Duration
+}
+
+type ScenarioOptions struct {
+	// ReportFile is the file the combined report of the scenario is written to, stdout if empty
+	ReportFile string
+}
+
+type ConvertOptions struct {
+	// From is the format the faults are converted from
+	From string
+	// File is the file the faults are read from, - reads them from stdin
+	File string
 }
 
 type Init struct {
@@ -64,6 +131,25 @@ type Init struct {
 	InstallNamespace  string
 	ReleaseVersion    string
 	DryRun            bool
+	// Timeout is how long to wait for glooshot to become ready once it is installed or upgraded
+	Timeout time.Duration
+}
+
+type UninstallOptions struct {
+	// All deletes the glooshot CRDs along with all experiments, after removing the faults of the active experiments
+	All bool
+}
+
+type CheckOptions struct {
+	// InstallNamespace is the namespace glooshot is installed in
+	InstallNamespace string
+	// PrometheusUrl is the prometheus checked for istio metrics, the check is skipped if empty
+	PrometheusUrl string
+}
+
+type ConfigOptions struct {
+	// Force replaces a config file which cannot be read when setting a value, dropping its other values
+	Force bool
 }
 
 type optionsCache struct {
@@ -109,6 +195,33 @@ func cliClientErrorHandler(ctx context.Context) func(error) {
 	}
 }
 
+// SelectorLabels parses an equality based label selector into the labels solo-kit clients filter on
+func SelectorLabels(selector string) (map[string]string, error) {
+	if selector == "" {
+		return nil, nil
+	}
+	parsed, err := labels.Parse(selector)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid selector %q", selector)
+	}
+	requirements, _ := parsed.Requirements()
+	set := make(map[string]string)
+	for _, req := range requirements {
+		values := req.Values().List()
+		switch {
+		case req.Operator() != selection.Equals && req.Operator() != selection.DoubleEquals:
+			return nil, errors.Errorf("invalid selector %q: only '=' and '==' are supported, got %v", selector, req.Operator())
+		case len(values) != 1:
+			return nil, errors.Errorf("invalid selector %q: %v must have exactly one value", selector, req.Key())
+		}
+		if existing, ok := set[req.Key()]; ok && existing != values[0] {
+			return nil, errors.Errorf("invalid selector %q: %v selected with both %v and %v", selector, req.Key(), existing, values[0])
+		}
+		set[req.Key()] = values[0]
+	}
+	return set, nil
+}
+
 const nameError = "name must be specified in flag (--name) or via first arg"
 
 func MetadataArgsParse(o *Options, args []string, nameRequired bool) error {
@@ -0,0 +1,65 @@
+package options_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/glooshot/pkg/api/v1"
+	"github.com/solo-io/glooshot/pkg/cli/options"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("SelectorLabels", func() {
+
+	It("parses equality based selectors", func() {
+		selector, err := options.SelectorLabels("team=payments,tier==backend")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(selector).To(Equal(map[string]string{"team": "payments", "tier": "backend"}))
+	})
+
+	It("selects everything if empty", func() {
+		selector, err := options.SelectorLabels("")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(selector).To(BeNil())
+	})
+
+	It("rejects set based selectors", func() {
+		_, err := options.SelectorLabels("team in (payments)")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(`invalid selector "team in (payments)"`))
+	})
+
+	It("rejects inequality and existence selectors", func() {
+		for _, selector := range []string{"team!=payments", "!team", "team"} {
+			_, err := options.SelectorLabels(selector)
+			Expect(err).To(HaveOccurred(), selector)
+		}
+	})
+
+	It("rejects a label selected with different values", func() {
+		_, err := options.SelectorLabels("team=payments,team=reviews")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("filters the resources listed with it", func() {
+		experiments, err := v1.NewExperimentClient(&factory.MemoryResourceClientFactory{Cache: memory.NewInMemoryResourceCache()})
+		Expect(err).NotTo(HaveOccurred())
+		for name, labels := range map[string]map[string]string{
+			"abort-payments": {"team": "payments", "tier": "backend"},
+			"delay-payments": {"team": "payments"},
+			"abort-reviews":  {"team": "reviews", "tier": "backend"},
+		} {
+			_, err := experiments.Write(&v1.Experiment{Metadata: core.Metadata{Namespace: "default", Name: name, Labels: labels}}, clients.WriteOpts{})
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		selector, err := options.SelectorLabels("team=payments,tier=backend")
+		Expect(err).NotTo(HaveOccurred())
+		selected, err := experiments.List("default", clients.ListOpts{Selector: selector})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(selected).To(HaveLen(1))
+		Expect(selected[0].Metadata.Name).To(Equal("abort-payments"))
+	})
+})
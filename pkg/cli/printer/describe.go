@@ -0,0 +1,122 @@
+package printer
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	v1 "github.com/solo-io/glooshot/pkg/api/v1"
+	"github.com/solo-io/glooshot/pkg/utils"
+	sgv1 "github.com/solo-io/supergloo/pkg/api/v1"
+)
+
+const none = "<none>"
+
+// DescribeExperiment prints a detailed, human readable description of the experiment in the style of kubectl describe
+// report and routingRules may be empty, e.g. if the experiment has not concluded or not started
+func DescribeExperiment(w io.Writer, exp *v1.Experiment, report *v1.Report, routingRules sgv1.RoutingRuleList, now time.Time) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(tw, "Name:\t%v\n", exp.Metadata.Name)
+	fmt.Fprintf(tw, "Namespace:\t%v\n", exp.Metadata.Namespace)
+	fmt.Fprintf(tw, "State:\t%v\n", exp.Result.State.String())
+
+	start, started := timestampToTime(exp.Result.TimeStarted)
+	finish, finished := timestampToTime(exp.Result.TimeFinished)
+	fmt.Fprintf(tw, "Started:\t%v\n", formatTime(start, started))
+	fmt.Fprintf(tw, "Finished:\t%v\n", formatTime(finish, finished))
+
+	fmt.Fprintf(tw, "Duration:\t%v\n", experimentDuration(exp))
+	if started {
+		fmt.Fprintf(tw, "Elapsed:\t%v\n", experimentElapsed(exp, now))
+		if !finished && exp.Spec != nil && exp.Spec.Duration != nil {
+			remaining := *exp.Spec.Duration - now.Sub(start)
+			if remaining < 0 {
+				remaining = 0
+			}
+			fmt.Fprintf(tw, "Remaining:\t%v\n", remaining.Round(time.Second))
+		}
+	}
+
+	fmt.Fprintf(tw, "Target Mesh:\t%v\n", experimentTargetMesh(exp))
+
+	fmt.Fprintf(tw, "Faults:\t")
+	if exp.Spec == nil || len(exp.Spec.Faults) == 0 {
+		fmt.Fprintf(tw, "%v\n", none)
+	} else {
+		fmt.Fprintf(tw, "\n")
+		for i, fault := range exp.Spec.Faults {
+			fmt.Fprintf(tw, "  %v.\t%v\n", i+1, utils.FaultSummary(fault))
+		}
+	}
+
+	fmt.Fprintf(tw, "Failure Conditions:\t")
+	if exp.Spec == nil || len(exp.Spec.FailureConditions) == 0 {
+		fmt.Fprintf(tw, "%v\n", none)
+	} else {
+		histories := make(map[string]*v1.Report_FailureConditionHistory)
+		if report != nil {
+			for _, history := range report.FailureConditionHistory {
+				if history != nil {
+					histories[history.FailureConditionName] = history
+				}
+			}
+		}
+		fmt.Fprintf(tw, "\n  NAME\tTYPE\tSAMPLES\tLATEST VALUE\n")
+		for _, fc := range utils.FlattenFailureConditions(exp.Spec.FailureConditions) {
+			samples, latest := 0, "-"
+			if history := histories[fc.Name]; history != nil && len(history.FailureConditionSnapshots) > 0 {
+				samples = len(history.FailureConditionSnapshots)
+				latest = fmt.Sprintf("%v", history.FailureConditionSnapshots[samples-1].Value)
+			}
+			fmt.Fprintf(tw, "  %v\t%v\t%v\t%v\n", fc.Name, utils.FailureConditionType(fc), samples, latest)
+		}
+	}
+
+	fmt.Fprintf(tw, "Failure Report:\t")
+	if len(exp.Result.FailureReport) == 0 {
+		fmt.Fprintf(tw, "%v\n", none)
+	} else {
+		fmt.Fprintf(tw, "\n")
+		var keys []string
+		for k := range exp.Result.FailureReport {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			fmt.Fprintf(tw, "  %v:\t%v\n", k, exp.Result.FailureReport[k])
+		}
+	}
+
+	fmt.Fprintf(tw, "Routing Rules:\t")
+	if len(routingRules) == 0 {
+		fmt.Fprintf(tw, "%v\n", none)
+	} else {
+		fmt.Fprintf(tw, "\n")
+		for _, rr := range routingRules.Sort() {
+			mesh := ""
+			if rr.TargetMesh != nil {
+				mesh = rr.TargetMesh.Namespace + "." + rr.TargetMesh.Name
+			}
+			fmt.Fprintf(tw, "  %v.%v\t%v\n", rr.Metadata.Namespace, rr.Metadata.Name, mesh)
+		}
+	}
+	return tw.Flush()
+}
+
+func timestampToTime(ts *types.Timestamp) (time.Time, bool) {
+	if ts == nil {
+		return time.Time{}, false
+	}
+	t, err := types.TimestampFromProto(ts)
+	return t, err == nil
+}
+
+func formatTime(t time.Time, ok bool) string {
+	if !ok {
+		return none
+	}
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,87 @@
+package printer_test
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/glooshot/pkg/api/v1"
+	"github.com/solo-io/glooshot/pkg/cli/printer"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	sgv1 "github.com/solo-io/supergloo/pkg/api/v1"
+)
+
+var _ = Describe("DescribeExperiment", func() {
+	start := time.Date(2019, 6, 1, 12, 0, 0, 0, time.UTC)
+	duration := 10 * time.Minute
+
+	experiment := func() *v1.Experiment {
+		started, err := types.TimestampProto(start)
+		Expect(err).NotTo(HaveOccurred())
+		return &v1.Experiment{
+			Metadata: core.Metadata{Namespace: "default", Name: "abort-reviews"},
+			Spec: &v1.ExperimentSpec{
+				Duration: &duration,
+				Faults: []*v1.ExperimentSpec_InjectedFault{{
+					DestinationServices: []*core.ResourceRef{{Namespace: "default", Name: "reviews"}},
+					Fault: &sgv1.FaultInjection{
+						FaultInjectionType: &sgv1.FaultInjection_Abort_{
+							Abort: &sgv1.FaultInjection_Abort{
+								ErrorType: &sgv1.FaultInjection_Abort_HttpStatus{HttpStatus: 500},
+							},
+						},
+						Percentage: 50,
+					},
+				}},
+				FailureConditions: []*v1.FailureCondition{{
+					Name:    "errors",
+					Trigger: &v1.FailureCondition_Trigger{FailureTrigger: &v1.FailureCondition_Trigger_Prometheus{}},
+				}},
+			},
+			Result: v1.ExperimentResult{State: v1.ExperimentResult_Started, TimeStarted: started},
+		}
+	}
+
+	It("describes a running experiment with its remaining time and routing rules", func() {
+		rrs := sgv1.RoutingRuleList{{
+			Metadata:   core.Metadata{Namespace: "default", Name: "abort-reviews-0"},
+			TargetMesh: &core.ResourceRef{Namespace: "supergloo-system", Name: "istio"},
+		}}
+		var buf bytes.Buffer
+		Expect(printer.DescribeExperiment(&buf, experiment(), nil, rrs, start.Add(90*time.Second))).NotTo(HaveOccurred())
+		out := buf.String()
+		Expect(out).To(MatchRegexp(`State:\s+Started`))
+		Expect(out).To(MatchRegexp(`Elapsed:\s+1m30s`))
+		Expect(out).To(MatchRegexp(`Remaining:\s+8m30s`))
+		Expect(out).To(ContainSubstring("abort with HTTP 500 on 50% of requests to default.reviews"))
+		Expect(out).To(MatchRegexp(`errors\s+prometheus\s+0\s+-`))
+		Expect(out).To(MatchRegexp(`default.abort-reviews-0\s+supergloo-system.istio`))
+	})
+
+	It("describes a concluded experiment with its failure report and latest condition values", func() {
+		exp := experiment()
+		finished, err := types.TimestampProto(start.Add(time.Minute))
+		Expect(err).NotTo(HaveOccurred())
+		exp.Result.State = v1.ExperimentResult_Failed
+		exp.Result.TimeFinished = finished
+		exp.Result.FailureReport = map[string]string{"failure_condition": "errors", "value": "0.4"}
+		report := &v1.Report{
+			FailureConditionHistory: []*v1.Report_FailureConditionHistory{{
+				FailureConditionName: "errors",
+				FailureConditionSnapshots: []*v1.Report_FailureConditionSnapshot{
+					{Value: 0.1}, {Value: 0.4},
+				},
+			}},
+		}
+		var buf bytes.Buffer
+		Expect(printer.DescribeExperiment(&buf, exp, report, nil, start.Add(time.Hour))).NotTo(HaveOccurred())
+		out := buf.String()
+		Expect(out).To(MatchRegexp(`Elapsed:\s+1m0s`))
+		Expect(out).NotTo(ContainSubstring("Remaining:"))
+		Expect(out).To(MatchRegexp(`failure_condition:\s+errors`))
+		Expect(out).To(MatchRegexp(`errors\s+prometheus\s+2\s+0.4`))
+		Expect(out).To(MatchRegexp(`Routing Rules:\s+<none>`))
+	})
+})
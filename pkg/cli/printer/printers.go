@@ -1,42 +1,312 @@
 package printer
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/ghodss/yaml"
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
 	"github.com/olekukonko/tablewriter"
 	v1 "github.com/solo-io/glooshot/pkg/api/v1"
-	"github.com/solo-io/go-utils/cliutils"
+	"github.com/solo-io/glooshot/pkg/suite"
+	"github.com/solo-io/glooshot/pkg/utils"
+	"github.com/solo-io/go-utils/errors"
 )
 
-func Experiment(exp v1.Experiment) {
-	fmt.Printf("Experiment: %s in namespace: %s\n", exp.Metadata.Name, exp.Metadata.Namespace)
-	fmt.Printf("Status: %s\n", exp.Status.State.String())
-	PrintExperiments([]*v1.Experiment{&exp}, "")
+// output formats of the get commands, following kubectl conventions
+const (
+	OutputTable = "table"
+	OutputWide  = "wide"
+	OutputYaml  = "yaml"
+	OutputJson  = "json"
+)
+
+var Outputs = []string{OutputTable, OutputWide, OutputYaml, OutputJson}
+
+func PrintExperiment(exp *v1.Experiment, outputType string) error {
+	return printResources(os.Stdout, outputType, []proto.Message{exp}, true, func(w io.Writer, wide bool) {
+		experimentTable([]*v1.Experiment{exp}, wide, w)
+	})
+}
+
+func PrintExperiments(exps []*v1.Experiment, outputType string) error {
+	var msgs []proto.Message
+	for _, exp := range exps {
+		msgs = append(msgs, exp)
+	}
+	return printResources(os.Stdout, outputType, msgs, false, func(w io.Writer, wide bool) {
+		experimentTable(exps, wide, w)
+	})
+}
+
+func PrintReports(reports []*v1.Report, outputType string) error {
+	var msgs []proto.Message
+	for _, report := range reports {
+		msgs = append(msgs, report)
+	}
+	return printResources(os.Stdout, outputType, msgs, false, func(w io.Writer, wide bool) {
+		reportTable(reports, w)
+	})
+}
+
+func PrintTemplate(tmpl *v1.ExperimentTemplate, outputType string) error {
+	return printResources(os.Stdout, outputType, []proto.Message{tmpl}, true, func(w io.Writer, wide bool) {
+		templateTable([]*v1.ExperimentTemplate{tmpl}, w)
+	})
+}
+
+func PrintTemplates(templates []*v1.ExperimentTemplate, outputType string) error {
+	var msgs []proto.Message
+	for _, tmpl := range templates {
+		msgs = append(msgs, tmpl)
+	}
+	return printResources(os.Stdout, outputType, msgs, false, func(w io.Writer, wide bool) {
+		templateTable(templates, w)
+	})
+}
+
+func PrintSuite(s *v1.ExperimentSuite, outputType string) error {
+	return printResources(os.Stdout, outputType, []proto.Message{s}, true, func(w io.Writer, wide bool) {
+		suiteTable([]*v1.ExperimentSuite{s}, w)
+	})
+}
+
+func PrintSuites(suites []*v1.ExperimentSuite, outputType string) error {
+	var msgs []proto.Message
+	for _, s := range suites {
+		msgs = append(msgs, s)
+	}
+	return printResources(os.Stdout, outputType, msgs, false, func(w io.Writer, wide bool) {
+		suiteTable(suites, w)
+	})
+}
+
+// PrintHistory prints the runs of an experiment in the order they ran, along with their outcomes
+func PrintHistory(runs []*v1.Experiment, outputType string) error {
+	var msgs []proto.Message
+	for _, run := range runs {
+		msgs = append(msgs, run)
+	}
+	return printResources(os.Stdout, outputType, msgs, false, func(w io.Writer, wide bool) {
+		historyTable(runs, w)
+	})
 }
 
-func PrintExperiments(exps []*v1.Experiment, outputType string) {
-	err := cliutils.PrintList(outputType, "", exps,
-		func(data interface{}, w io.Writer) error {
-			experimentTable(exps, w)
-			return nil
-		}, os.Stdout)
+// prints the resources as a table, or as yaml or json
+// like kubectl, a single resource is printed as an object and a list of resources as a List with the resources as its items
+func printResources(w io.Writer, outputType string, resources []proto.Message, single bool, table func(w io.Writer, wide bool)) error {
+	switch outputType {
+	case "", OutputTable:
+		table(w, false)
+		return nil
+	case OutputWide:
+		table(w, true)
+		return nil
+	case OutputJson, OutputYaml:
+	default:
+		return errors.Errorf("unknown output format %q, must be one of %v", outputType, Outputs)
+	}
+
+	var doc []byte
+	var err error
+	if single && len(resources) == 1 {
+		doc, err = marshalProto(resources[0])
+	} else {
+		doc, err = marshalList(resources)
+	}
 	if err != nil {
-		fmt.Printf("error during print: %v\n", err)
+		return err
+	}
+	if outputType == OutputYaml {
+		doc, err = yaml.JSONToYAML(doc)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(doc)
+		return err
+	}
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, doc, "", "  "); err != nil {
+		return err
+	}
+	indented.WriteString("\n")
+	_, err = indented.WriteTo(w)
+	return err
+}
+
+// ExperimentYaml renders the experiment as yaml, as accepted by glooshot create experiment -f
+func ExperimentYaml(exp *v1.Experiment) ([]byte, error) {
+	doc, err := marshalProto(exp)
+	if err != nil {
+		return nil, err
+	}
+	return yaml.JSONToYAML(doc)
+}
+
+func marshalProto(msg proto.Message) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := (&jsonpb.Marshaler{}).Marshal(&buf, msg); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func marshalList(resources []proto.Message) ([]byte, error) {
+	items := []json.RawMessage{}
+	for _, resource := range resources {
+		item, err := marshalProto(resource)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return json.Marshal(struct {
+		Kind  string            `json:"kind"`
+		Items []json.RawMessage `json:"items"`
+	}{Kind: "List", Items: items})
+}
+
+func experimentTable(list []*v1.Experiment, wide bool, w io.Writer) {
+	table := tablewriter.NewWriter(w)
+	header := []string{"Experiment", "Namespace", "State"}
+	if wide {
+		header = append(header, "Started", "Duration", "Elapsed", "Target Mesh")
+	}
+	table.SetHeader(header)
+
+	for _, v := range list {
+		name := v.GetMetadata().Name
+		namespace := v.GetMetadata().Namespace
+		state := v.Result.State.String()
+
+		row := []string{name, namespace, state}
+		if wide {
+			row = append(row, experimentStarted(v), experimentDuration(v), experimentElapsed(v, time.Now()), experimentTargetMesh(v))
+		}
+		table.Append(row)
+	}
+
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	table.Render()
+}
+
+func historyTable(runs []*v1.Experiment, w io.Writer) {
+	table := tablewriter.NewWriter(w)
+	table.SetHeader([]string{"Run", "Experiment", "State", "Started", "Elapsed", "Failure Condition"})
+
+	for i, run := range runs {
+		failureCondition := run.Result.FailureReport[utils.FailureReportKeyCondition]
+		if failureCondition == "" {
+			failureCondition = none
+		}
+		table.Append([]string{strconv.Itoa(i + 1), run.Metadata.Name, run.Result.State.String(),
+			experimentStarted(run), experimentElapsed(run, time.Now()), failureCondition})
+	}
+
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	table.Render()
+}
+
+// lists the parameters of each template with their types, required parameters are marked with a *
+func templateTable(list []*v1.ExperimentTemplate, w io.Writer) {
+	table := tablewriter.NewWriter(w)
+	table.SetHeader([]string{"Template", "Namespace", "Parameters"})
+
+	for _, v := range list {
+		var params []string
+		for _, param := range v.Parameters {
+			required := ""
+			if param.Required {
+				required = "*"
+			}
+			params = append(params, fmt.Sprintf("%v%v (%v)", param.Name, required, strings.ToLower(param.Type.String())))
+		}
+		table.Append([]string{v.Metadata.Name, v.Metadata.Namespace, strings.Join(params, ", ")})
+	}
+
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	table.Render()
+}
+
+func suiteTable(list []*v1.ExperimentSuite, w io.Writer) {
+	table := tablewriter.NewWriter(w)
+	table.SetHeader([]string{"Suite", "Namespace", "Mode", "State", "Started", "Experiments"})
+
+	for _, v := range list {
+		start, ok := timestampToTime(v.Result.TimeStarted)
+		experiments := fmt.Sprintf("%v", len(v.Experiments))
+		if len(v.Result.Experiments) > 0 {
+			experiments = suite.Summary(v.Result)
+		}
+		table.Append([]string{
+			v.Metadata.Name,
+			v.Metadata.Namespace,
+			strings.ToLower(v.Mode.String()),
+			v.Result.State.String(),
+			formatTime(start, ok),
+			experiments,
+		})
+	}
+
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	table.Render()
+}
+
+func experimentStarted(exp *v1.Experiment) string {
+	start, ok := timestampToTime(exp.Result.TimeStarted)
+	return formatTime(start, ok)
+}
+
+func experimentDuration(exp *v1.Experiment) string {
+	if exp.Spec == nil {
+		return none
+	}
+	return utils.ExperimentDuration(exp).String()
+}
+
+// the time the experiment ran for, up to now if it has not finished
+func experimentElapsed(exp *v1.Experiment, now time.Time) string {
+	start, ok := timestampToTime(exp.Result.TimeStarted)
+	if !ok {
+		return none
+	}
+	if finish, ok := timestampToTime(exp.Result.TimeFinished); ok {
+		now = finish
+	}
+	return now.Sub(start).Round(time.Second).String()
+}
+
+func experimentTargetMesh(exp *v1.Experiment) string {
+	if exp.Spec == nil || exp.Spec.TargetMesh == nil {
+		return "<default>"
 	}
+	return exp.Spec.TargetMesh.Namespace + "." + exp.Spec.TargetMesh.Name
 }
 
-func experimentTable(list []*v1.Experiment, w io.Writer) {
+func reportTable(list []*v1.Report, w io.Writer) {
 	table := tablewriter.NewWriter(w)
-	table.SetHeader([]string{"Experiment", "Namespace", "Status"})
+	table.SetHeader([]string{"Report", "Namespace", "Experiment", "Conditions", "Samples"})
 
 	for _, v := range list {
 		name := v.GetMetadata().Name
 		namespace := v.GetMetadata().Namespace
-		status := v.Status.String()
+		experiment := ""
+		if v.Experiment != nil {
+			experiment = v.Experiment.Namespace + "." + v.Experiment.Name
+		}
+		samples := 0
+		for _, history := range v.FailureConditionHistory {
+			samples += len(history.GetFailureConditionSnapshots())
+		}
 
-		table.Append([]string{name, namespace, status})
+		table.Append([]string{name, namespace, experiment, strconv.Itoa(len(v.FailureConditionHistory)), strconv.Itoa(samples)})
 	}
 
 	table.SetAlignment(tablewriter.ALIGN_LEFT)
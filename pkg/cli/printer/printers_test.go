@@ -0,0 +1,111 @@
+package printer
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/glooshot/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("Printers", func() {
+	duration := 5 * time.Minute
+
+	experiments := func() []*v1.Experiment {
+		return []*v1.Experiment{
+			{
+				Metadata: core.Metadata{Namespace: "default", Name: "abort-reviews"},
+				Spec: &v1.ExperimentSpec{
+					Duration:   &duration,
+					TargetMesh: &core.ResourceRef{Namespace: "supergloo-system", Name: "istio"},
+				},
+				Result: v1.ExperimentResult{State: v1.ExperimentResult_Started},
+			},
+			{
+				Metadata: core.Metadata{Namespace: "default", Name: "delay-ratings"},
+				Spec:     &v1.ExperimentSpec{},
+				Result:   v1.ExperimentResult{State: v1.ExperimentResult_Pending},
+			},
+		}
+	}
+	render := func(outputType string, exps []*v1.Experiment, single bool) (string, error) {
+		var msgs []proto.Message
+		for _, exp := range exps {
+			msgs = append(msgs, exp)
+		}
+		var buf bytes.Buffer
+		err := printResources(&buf, outputType, msgs, single, func(w io.Writer, wide bool) {
+			experimentTable(exps, wide, w)
+		})
+		return buf.String(), err
+	}
+
+	It("prints a table by default", func() {
+		out, err := render("", experiments(), false)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).To(MatchRegexp(`EXPERIMENT\s+\|\s+NAMESPACE\s+\|\s+STATE\s+\|\n`))
+		Expect(out).To(MatchRegexp(`abort-reviews\s+\|\s+default\s+\|\s+Started`))
+		Expect(out).NotTo(ContainSubstring("TARGET MESH"))
+	})
+
+	It("adds the duration, timing and target mesh to wide tables", func() {
+		out, err := render(OutputWide, experiments(), false)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).To(ContainSubstring("TARGET MESH"))
+		Expect(out).To(MatchRegexp(`abort-reviews\s+\|\s+default\s+\|\s+Started\s+\|\s+<none>\s+\|\s+5m0s\s+\|\s+<none>\s+\|\s+supergloo-system.istio`))
+		// experiments without a duration run for the default duration
+		Expect(out).To(MatchRegexp(`delay-ratings\s+\|\s+default\s+\|\s+Pending\s+\|\s+<none>\s+\|\s+10m0s\s+\|\s+<none>\s+\|\s+<default>`))
+	})
+
+	It("prints a single resource as a json object", func() {
+		out, err := render(OutputJson, experiments()[:1], true)
+		Expect(err).NotTo(HaveOccurred())
+		var obj struct {
+			Metadata struct {
+				Name string `json:"name"`
+			} `json:"metadata"`
+			Kind string `json:"kind"`
+		}
+		Expect(json.Unmarshal([]byte(out), &obj)).To(Succeed())
+		Expect(obj.Metadata.Name).To(Equal("abort-reviews"))
+		Expect(obj.Kind).To(BeEmpty())
+		Expect(out).To(HaveSuffix("}\n"))
+	})
+
+	It("prints lists of resources as a json List", func() {
+		out, err := render(OutputJson, experiments(), false)
+		Expect(err).NotTo(HaveOccurred())
+		var list struct {
+			Kind  string            `json:"kind"`
+			Items []json.RawMessage `json:"items"`
+		}
+		Expect(json.Unmarshal([]byte(out), &list)).To(Succeed())
+		Expect(list.Kind).To(Equal("List"))
+		Expect(list.Items).To(HaveLen(2))
+	})
+
+	It("prints an empty List rather than null", func() {
+		out, err := render(OutputJson, nil, false)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).To(ContainSubstring(`"items": []`))
+	})
+
+	It("prints lists of resources as a yaml List", func() {
+		out, err := render(OutputYaml, experiments(), false)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).To(ContainSubstring("kind: List\n"))
+		Expect(out).To(ContainSubstring("name: abort-reviews\n"))
+		Expect(out).To(ContainSubstring("name: delay-ratings\n"))
+	})
+
+	It("rejects unknown output formats", func() {
+		_, err := render("xml", experiments(), false)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(`unknown output format "xml"`))
+	})
+})
@@ -0,0 +1,31 @@
+package scenario
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/solo-io/glooshot/pkg/cli/export"
+)
+
+// RenderReport writes the combined report of the scenario, the reports of all its experiments in the given format
+// markdown reports start with the timeline of the scenario, in the other formats the experiments of the faults
+// record when they were injected in their OffsetAnnotation
+func RenderReport(w io.Writer, format string, sc *Scenario, result Result) error {
+	if format == export.FormatMarkdown {
+		verdict := "Succeeded"
+		if result.Failed {
+			verdict = "Failed"
+		}
+		var b strings.Builder
+		fmt.Fprintf(&b, "# Scenario `%v`: %v\n\n## Timeline\n\n", sc.Name, verdict)
+		for _, line := range result.Timeline {
+			fmt.Fprintf(&b, "- %v\n", line)
+		}
+		b.WriteString("\n")
+		if _, err := io.WriteString(w, b.String()); err != nil {
+			return err
+		}
+	}
+	return export.Render(w, format, result.Reports)
+}
@@ -0,0 +1,292 @@
+package scenario
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	v1 "github.com/solo-io/glooshot/pkg/api/v1"
+	"github.com/solo-io/glooshot/pkg/cli/export"
+	"github.com/solo-io/glooshot/pkg/utils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+const (
+	// ScenarioLabel marks the experiments of a scenario with the name of the scenario
+	ScenarioLabel = "glooshot.solo.io/scenario"
+	// OffsetAnnotation records when the fault of an experiment was injected, relative to the start of the scenario
+	OffsetAnnotation = "glooshot.solo.io/scenario-offset"
+)
+
+// how often the monitoring experiment is checked for a met failure condition
+const defaultPollInterval = time.Second
+
+// how long the experiments of a scenario run beyond its end, so glooshot does not conclude them before the runner does
+// glooshot still concludes them, removing their faults, if the runner does not
+const durationMargin = time.Minute
+
+// Runner drives the timeline of a scenario by creating and concluding experiments
+// the failure conditions of the scenario are monitored by an experiment without faults which runs for the whole scenario,
+// each fault is injected by an experiment without failure conditions, which is concluded to remove the fault
+type Runner struct {
+	Experiments v1.ExperimentClient
+	Reports     v1.ReportClient
+	// Log receives a line for every step of the scenario
+	Log io.Writer
+	// PollInterval is how often the failure conditions are checked, every second if zero
+	PollInterval time.Duration
+}
+
+// Result is the outcome of a scenario
+type Result struct {
+	// Failed is true if a failure condition was met during the scenario
+	Failed bool
+	// FailureReport explains which failure condition was met
+	FailureReport map[string]string
+	// Timeline are the lines logged for the steps of the scenario
+	Timeline []string
+	// Reports are the experiments of the scenario along with their reports, the monitoring experiment first
+	Reports []export.ExperimentReport
+}
+
+// the state of a running scenario
+type run struct {
+	*Runner
+	scenario *Scenario
+	runID    string
+	start    time.Time
+	monitor  core.ResourceRef
+	// the experiments created for the faults of the scenario, by the name of the fault
+	faults map[string]core.ResourceRef
+	// the faults which are injected, in the order they were injected
+	active   []string
+	timeline []string
+}
+
+// Run runs the scenario in the given namespace, its experiments are named after the scenario with the given run id
+// the faults which are still injected are removed when the scenario ends, even if the context is cancelled
+func (r *Runner) Run(ctx context.Context, sc *Scenario, namespace, runID string) (Result, error) {
+	rn := &run{
+		Runner:   r,
+		scenario: sc,
+		runID:    runID,
+		start:    time.Now(),
+		monitor:  core.ResourceRef{Namespace: namespace, Name: sc.Name + "-" + runID},
+		faults:   make(map[string]core.ResourceRef),
+	}
+	if err := rn.createMonitor(ctx); err != nil {
+		return Result{}, err
+	}
+	timelineErr := rn.runTimeline(ctx)
+
+	// clean up without the context, which may have been cancelled
+	cleanupErr := rn.removeActive(context.Background())
+	if timelineErr != nil {
+		// the monitoring experiment would otherwise run forever
+		interrupted := map[string]string{utils.FailureReportKeyType: "interrupted", "message": timelineErr.Error()}
+		cleanupErr = firstError(cleanupErr, conclude(context.Background(), r.Experiments, rn.monitor, v1.ExperimentResult_Failed, interrupted))
+	}
+	if err := firstError(timelineErr, cleanupErr); err != nil {
+		return Result{}, err
+	}
+	return rn.result()
+}
+
+func (rn *run) runTimeline(ctx context.Context) error {
+	for _, step := range rn.scenario.Steps {
+		failed, err := rn.waitUntil(ctx, step.At)
+		if err != nil || failed {
+			return err
+		}
+		if err := rn.runStep(ctx, step); err != nil {
+			return err
+		}
+	}
+	failed, err := rn.waitUntil(ctx, rn.scenario.Duration)
+	if err != nil || failed {
+		return err
+	}
+	// no failure condition was met for the whole scenario
+	rn.logf("scenario %v completed", rn.scenario.Name)
+	return conclude(ctx, rn.Experiments, rn.monitor, v1.ExperimentResult_Succeeded, nil)
+}
+
+// waits until the given time of the scenario, returns true if a failure condition was met in the meantime
+func (rn *run) waitUntil(ctx context.Context, at time.Duration) (bool, error) {
+	interval := rn.PollInterval
+	if interval == 0 {
+		interval = defaultPollInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	timer := time.NewTimer(time.Until(rn.start.Add(at)))
+	defer timer.Stop()
+	for {
+		failed, err := rn.checkMonitor(ctx)
+		if err != nil || failed {
+			return failed, err
+		}
+		select {
+		case <-ctx.Done():
+			rn.logf("scenario %v interrupted", rn.scenario.Name)
+			return false, ctx.Err()
+		case <-timer.C:
+			return false, nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// returns true if the monitoring experiment concluded, as glooshot concludes it once a failure condition is met
+func (rn *run) checkMonitor(ctx context.Context) (bool, error) {
+	monitor, err := rn.Experiments.Read(rn.monitor.Namespace, rn.monitor.Name, clients.ReadOpts{Ctx: ctx})
+	if err != nil {
+		return false, errors.Wrapf(err, "could not read experiment %v.%v", rn.monitor.Namespace, rn.monitor.Name)
+	}
+	switch monitor.Result.State {
+	case v1.ExperimentResult_Failed, v1.ExperimentResult_Rejected:
+		reason := monitor.Result.FailureReport[utils.FailureReportKeyCondition]
+		if reason == "" {
+			reason = monitor.Result.FailureReport["message"]
+		}
+		rn.logf("experiment %v.%v %v (%v), aborting scenario %v",
+			rn.monitor.Namespace, rn.monitor.Name, strings.ToLower(monitor.Result.State.String()), reason, rn.scenario.Name)
+		return true, nil
+	case v1.ExperimentResult_Succeeded:
+		return false, errors.Errorf("experiment %v.%v concluded before the scenario ended", rn.monitor.Namespace, rn.monitor.Name)
+	}
+	return false, nil
+}
+
+func (rn *run) runStep(ctx context.Context, step Step) error {
+	if step.Inject != "" {
+		exp := rn.newExperiment(rn.scenario.Name+"-"+step.Inject+"-"+rn.runID, step.At)
+		exp.Metadata.Annotations = map[string]string{OffsetAnnotation: step.At.String()}
+		exp.Spec.Faults = []*v1.ExperimentSpec_InjectedFault{step.Fault}
+		written, err := rn.Experiments.Write(exp, clients.WriteOpts{Ctx: ctx})
+		if err != nil {
+			return errors.Wrapf(err, "could not inject fault %v", step.Inject)
+		}
+		ref := written.Metadata.Ref()
+		rn.faults[step.Inject] = ref
+		rn.active = append(rn.active, step.Inject)
+		rn.logf("%v with experiment %v.%v", step, ref.Namespace, ref.Name)
+		return nil
+	}
+	if err := rn.remove(ctx, step.Remove); err != nil {
+		return err
+	}
+	rn.logf("%v", step)
+	return nil
+}
+
+func (rn *run) createMonitor(ctx context.Context) error {
+	exp := rn.newExperiment(rn.monitor.Name, 0)
+	exp.Spec.FailureConditions = rn.scenario.FailureConditions
+	if _, err := rn.Experiments.Write(exp, clients.WriteOpts{Ctx: ctx}); err != nil {
+		return errors.Wrapf(err, "could not create experiment monitoring the failure conditions")
+	}
+	rn.logf("started scenario %v, monitoring %v failure conditions with experiment %v.%v",
+		rn.scenario.Name, len(rn.scenario.FailureConditions), rn.monitor.Namespace, rn.monitor.Name)
+	return nil
+}
+
+// the experiment runs from the given time until the end of the scenario
+func (rn *run) newExperiment(name string, at time.Duration) *v1.Experiment {
+	duration := rn.scenario.Duration - at + durationMargin
+	return &v1.Experiment{
+		Metadata: core.Metadata{
+			Namespace: rn.monitor.Namespace,
+			Name:      name,
+			Labels:    map[string]string{ScenarioLabel: rn.scenario.Name},
+		},
+		Spec: &v1.ExperimentSpec{TargetMesh: rn.scenario.TargetMesh, Duration: &duration},
+	}
+}
+
+func (rn *run) remove(ctx context.Context, fault string) error {
+	if err := conclude(ctx, rn.Experiments, rn.faults[fault], v1.ExperimentResult_Succeeded, nil); err != nil {
+		return errors.Wrapf(err, "could not remove fault %v", fault)
+	}
+	for i, name := range rn.active {
+		if name == fault {
+			rn.active = append(rn.active[:i], rn.active[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+// removes the faults which are still injected once the scenario ends
+func (rn *run) removeActive(ctx context.Context) error {
+	var errs []error
+	for _, fault := range append([]string(nil), rn.active...) {
+		if err := rn.remove(ctx, fault); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		rn.logf("removed fault %v as the scenario ended", fault)
+	}
+	return firstError(errs...)
+}
+
+func (rn *run) result() (Result, error) {
+	refs := []core.ResourceRef{rn.monitor}
+	for _, step := range rn.scenario.Steps {
+		if ref, ok := rn.faults[step.Inject]; ok && step.Inject != "" {
+			refs = append(refs, ref)
+		}
+	}
+	result := Result{Timeline: rn.timeline}
+	for _, ref := range refs {
+		report, err := export.ReadExperimentReport(rn.Experiments, rn.Reports, ref.Namespace, ref.Name)
+		if err != nil {
+			return Result{}, err
+		}
+		result.Reports = append(result.Reports, report)
+	}
+	monitor := result.Reports[0].Experiment
+	result.Failed = monitor.Result.State != v1.ExperimentResult_Succeeded
+	result.FailureReport = monitor.Result.FailureReport
+	return result, nil
+}
+
+func (rn *run) logf(format string, args ...interface{}) {
+	line := fmt.Sprintf("T+%v %v", time.Since(rn.start).Round(time.Second), fmt.Sprintf(format, args...))
+	rn.timeline = append(rn.timeline, line)
+	fmt.Fprintln(rn.Log, line)
+}
+
+// concludes the experiment unless glooshot concluded it already, which removes its faults
+// the failure report is optional
+func conclude(ctx context.Context, experiments v1.ExperimentClient, ref core.ResourceRef, state v1.ExperimentResult_State, failureReport map[string]string) error {
+	exp, err := experiments.Read(ref.Namespace, ref.Name, clients.ReadOpts{Ctx: ctx})
+	if err != nil {
+		return err
+	}
+	switch exp.Result.State {
+	case v1.ExperimentResult_Succeeded, v1.ExperimentResult_Failed, v1.ExperimentResult_Rejected:
+		return nil
+	}
+	exp.Result.State = state
+	exp.Result.TimeFinished = types.TimestampNow()
+	if failureReport != nil {
+		exp.Result.FailureReport = failureReport
+	}
+	_, err = experiments.Write(exp, clients.WriteOpts{Ctx: ctx, OverwriteExisting: true})
+	return err
+}
+
+func firstError(errs ...error) error {
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+package scenario_test
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/glooshot/pkg/api/v1"
+	"github.com/solo-io/glooshot/pkg/cli/export"
+	"github.com/solo-io/glooshot/pkg/cli/scenario"
+	"github.com/solo-io/glooshot/pkg/utils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
+	sgv1 "github.com/solo-io/supergloo/pkg/api/v1"
+)
+
+var _ = Describe("Runner", func() {
+
+	var (
+		expClient v1.ExperimentClient
+		runner    *scenario.Runner
+		log       *bytes.Buffer
+		sc        *scenario.Scenario
+	)
+
+	fault := func() *v1.ExperimentSpec_InjectedFault {
+		return &v1.ExperimentSpec_InjectedFault{
+			Fault: &sgv1.FaultInjection{
+				FaultInjectionType: &sgv1.FaultInjection_Abort_{
+					Abort: &sgv1.FaultInjection_Abort{
+						ErrorType: &sgv1.FaultInjection_Abort_HttpStatus{HttpStatus: 503},
+					},
+				},
+				Percentage: 50,
+			},
+		}
+	}
+	read := func(name string) *v1.Experiment {
+		exp, err := expClient.Read("bookinfo", name, clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		return exp
+	}
+
+	BeforeEach(func() {
+		var err error
+		expClient, err = v1.NewExperimentClient(&factory.MemoryResourceClientFactory{Cache: memory.NewInMemoryResourceCache()})
+		Expect(err).NotTo(HaveOccurred())
+		reportClient, err := v1.NewReportClient(&factory.MemoryResourceClientFactory{Cache: memory.NewInMemoryResourceCache()})
+		Expect(err).NotTo(HaveOccurred())
+		log = &bytes.Buffer{}
+		runner = &scenario.Runner{
+			Experiments:  expClient,
+			Reports:      reportClient,
+			Log:          log,
+			PollInterval: 5 * time.Millisecond,
+		}
+		sc = &scenario.Scenario{
+			Name:     "drill",
+			Duration: 150 * time.Millisecond,
+			Steps: []scenario.Step{
+				{At: 0, Inject: "a", Fault: fault()},
+				{At: 50 * time.Millisecond, Inject: "b", Fault: fault()},
+				{At: 100 * time.Millisecond, Remove: "a"},
+			},
+		}
+	})
+
+	It("injects and removes the faults of the timeline", func() {
+		result, err := runner.Run(context.TODO(), sc, "bookinfo", "x1y2z")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Failed).To(BeFalse())
+
+		monitor := read("drill-x1y2z")
+		Expect(monitor.Metadata.Labels).To(Equal(map[string]string{scenario.ScenarioLabel: "drill"}))
+		Expect(monitor.Spec.Faults).To(BeEmpty())
+		Expect(monitor.Result.State).To(Equal(v1.ExperimentResult_Succeeded))
+
+		a, b := read("drill-a-x1y2z"), read("drill-b-x1y2z")
+		Expect(a.Spec.Faults).To(Equal([]*v1.ExperimentSpec_InjectedFault{fault()}))
+		Expect(b.Metadata.Annotations).To(Equal(map[string]string{scenario.OffsetAnnotation: "50ms"}))
+		Expect(a.Result.State).To(Equal(v1.ExperimentResult_Succeeded))
+		Expect(b.Result.State).To(Equal(v1.ExperimentResult_Succeeded))
+
+		Expect(result.Reports).To(HaveLen(3))
+		Expect(result.Reports[0].Experiment.Metadata.Name).To(Equal("drill-x1y2z"))
+		Expect(result.Timeline).To(HaveLen(6))
+		Expect(log.String()).To(ContainSubstring("inject b with experiment bookinfo.drill-b-x1y2z"))
+		Expect(log.String()).To(ContainSubstring("remove a"))
+		Expect(log.String()).To(ContainSubstring("removed fault b as the scenario ended"))
+
+		var report bytes.Buffer
+		Expect(scenario.RenderReport(&report, export.FormatMarkdown, sc, result)).To(Succeed())
+		Expect(report.String()).To(HavePrefix("# Scenario `drill`: Succeeded\n\n## Timeline\n\n- T+0s started scenario drill"))
+		Expect(report.String()).To(ContainSubstring("# Experiment `bookinfo.drill-b-x1y2z`"))
+	})
+
+	It("aborts the scenario once a failure condition is met", func() {
+		go func() {
+			defer GinkgoRecover()
+			Eventually(func() error {
+				_, err := expClient.Read("bookinfo", "drill-b-x1y2z", clients.ReadOpts{})
+				return err
+			}).Should(Succeed())
+			monitor := read("drill-x1y2z")
+			monitor.Result.State = v1.ExperimentResult_Failed
+			monitor.Result.FailureReport = map[string]string{"failure_condition": "errors"}
+			_, err := expClient.Write(monitor, clients.WriteOpts{OverwriteExisting: true})
+			Expect(err).NotTo(HaveOccurred())
+		}()
+
+		result, err := runner.Run(context.TODO(), sc, "bookinfo", "x1y2z")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Failed).To(BeTrue())
+		Expect(result.FailureReport).To(Equal(map[string]string{"failure_condition": "errors"}))
+		Expect(log.String()).To(ContainSubstring("experiment bookinfo.drill-x1y2z failed (errors), aborting scenario drill"))
+		Expect(log.String()).NotTo(ContainSubstring("remove a\n"))
+
+		Expect(read("drill-a-x1y2z").Result.State).To(Equal(v1.ExperimentResult_Succeeded))
+		Expect(read("drill-b-x1y2z").Result.State).To(Equal(v1.ExperimentResult_Succeeded))
+	})
+
+	It("removes the faults when interrupted", func() {
+		ctx, cancel := context.WithTimeout(context.TODO(), 75*time.Millisecond)
+		defer cancel()
+		_, err := runner.Run(ctx, sc, "bookinfo", "x1y2z")
+		Expect(err).To(HaveOccurred())
+
+		Expect(read("drill-a-x1y2z").Result.State).To(Equal(v1.ExperimentResult_Succeeded))
+		Expect(read("drill-b-x1y2z").Result.State).To(Equal(v1.ExperimentResult_Succeeded))
+		monitor := read("drill-x1y2z")
+		Expect(monitor.Result.State).To(Equal(v1.ExperimentResult_Failed))
+		Expect(monitor.Result.FailureReport).To(HaveKeyWithValue("failure_type", "interrupted"))
+	})
+
+	It("runs the experiments until the end of the scenario, however long it is", func() {
+		// longer than glooshot runs experiments without a duration
+		sc.Duration = 15 * time.Minute
+		sc.Steps = []scenario.Step{{At: 0, Inject: "a", Fault: fault()}}
+		ctx, cancel := context.WithTimeout(context.TODO(), 50*time.Millisecond)
+		defer cancel()
+		_, err := runner.Run(ctx, sc, "bookinfo", "x1y2z")
+		Expect(err).To(HaveOccurred())
+
+		Expect(utils.ExperimentDuration(read("drill-x1y2z"))).To(BeNumerically(">", sc.Duration))
+		Expect(utils.ExperimentDuration(read("drill-a-x1y2z"))).To(BeNumerically(">", sc.Duration))
+	})
+})
@@ -0,0 +1,202 @@
+package scenario
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/pkg/errors"
+	v1 "github.com/solo-io/glooshot/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// Stdin is the path which reads the scenario from stdin
+const Stdin = "-"
+
+// Scenario is a timeline of faults which are injected into and removed from a mesh, while failure conditions
+// covering the whole scenario are monitored. Scenarios are local files, driven by the cli rather than by glooshot
+type Scenario struct {
+	// Name identifies the scenario, the experiments it creates are named after it
+	Name string
+	// Namespace is the namespace of the experiments, the namespace given on the command line if empty
+	Namespace string
+	// TargetMesh is the mesh the faults are injected into
+	TargetMesh *core.ResourceRef
+	// Duration is the length of the scenario, the time of its last step if not given
+	Duration time.Duration
+	// FailureConditions are monitored for the whole scenario, the scenario is aborted once one of them is met
+	FailureConditions []*v1.FailureCondition
+	// Steps are ordered by their time
+	Steps []Step
+}
+
+// Step injects or removes a fault at a time relative to the start of the scenario
+type Step struct {
+	At time.Duration
+	// Inject is the name of the fault injected by the step, empty if the step removes a fault
+	Inject string
+	Fault  *v1.ExperimentSpec_InjectedFault
+	// Remove is the name of the fault removed by the step, empty if the step injects a fault
+	Remove string
+}
+
+func (s Step) String() string {
+	if s.Inject != "" {
+		return "inject " + s.Inject
+	}
+	return "remove " + s.Remove
+}
+
+// the scenario file, its faults and failure conditions are parsed with jsonpb as encoding/json does not support oneofs
+type scenarioFile struct {
+	Name              string            `json:"name"`
+	Namespace         string            `json:"namespace,omitempty"`
+	TargetMesh        *core.ResourceRef `json:"targetMesh,omitempty"`
+	Duration          string            `json:"duration,omitempty"`
+	FailureConditions []json.RawMessage `json:"failureConditions,omitempty"`
+	Steps             []stepFile        `json:"steps"`
+}
+
+type stepFile struct {
+	At     string      `json:"at"`
+	Inject *injectFile `json:"inject,omitempty"`
+	Remove string      `json:"remove,omitempty"`
+}
+
+type injectFile struct {
+	Name  string          `json:"name"`
+	Fault json.RawMessage `json:"fault"`
+}
+
+// Read reads a scenario from a yaml or json file, or from stdin
+func Read(path string, stdin io.Reader) (*Scenario, error) {
+	var (
+		content []byte
+		err     error
+	)
+	if path == Stdin {
+		content, err = ioutil.ReadAll(stdin)
+	} else {
+		content, err = ioutil.ReadFile(path)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read scenario")
+	}
+	return Parse(content)
+}
+
+// Parse parses and validates a scenario
+func Parse(content []byte) (*Scenario, error) {
+	jsn, err := yaml.YAMLToJSON(content)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid scenario")
+	}
+	var file scenarioFile
+	if err := json.Unmarshal(jsn, &file); err != nil {
+		return nil, errors.Wrapf(err, "invalid scenario")
+	}
+	sc := &Scenario{
+		Name:       file.Name,
+		Namespace:  file.Namespace,
+		TargetMesh: file.TargetMesh,
+	}
+	if file.Duration != "" {
+		if sc.Duration, err = time.ParseDuration(file.Duration); err != nil {
+			return nil, errors.Wrapf(err, "invalid duration")
+		}
+	}
+	for i, raw := range file.FailureConditions {
+		fc := &v1.FailureCondition{}
+		if err := jsonpb.Unmarshal(bytes.NewReader(raw), fc); err != nil {
+			return nil, errors.Wrapf(err, "invalid failure condition %v", i+1)
+		}
+		sc.FailureConditions = append(sc.FailureConditions, fc)
+	}
+	for i, stepFile := range file.Steps {
+		step, err := parseStep(stepFile)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid step %v", i+1)
+		}
+		sc.Steps = append(sc.Steps, step)
+	}
+	// steps at the same time keep the order of the file
+	sort.SliceStable(sc.Steps, func(i, j int) bool {
+		return sc.Steps[i].At < sc.Steps[j].At
+	})
+	if err := validate(sc); err != nil {
+		return nil, err
+	}
+	if sc.Duration == 0 {
+		sc.Duration = sc.Steps[len(sc.Steps)-1].At
+	}
+	return sc, nil
+}
+
+func parseStep(file stepFile) (Step, error) {
+	var step Step
+	at, err := time.ParseDuration(file.At)
+	if err != nil {
+		return step, errors.Wrapf(err, "invalid time %q, must be a duration such as 0s or 2m", file.At)
+	}
+	if at < 0 {
+		return step, errors.Errorf("invalid time %v, must not be negative", file.At)
+	}
+	step.At = at
+	switch {
+	case file.Inject != nil && file.Remove != "":
+		return step, errors.Errorf("a step either injects or removes a fault, not both")
+	case file.Inject != nil:
+		step.Inject = file.Inject.Name
+		step.Fault = &v1.ExperimentSpec_InjectedFault{}
+		if len(file.Inject.Fault) == 0 {
+			return step, errors.Errorf("fault %v has no fault", step.Inject)
+		}
+		if err := jsonpb.Unmarshal(bytes.NewReader(file.Inject.Fault), step.Fault); err != nil {
+			return step, errors.Wrapf(err, "invalid fault %v", step.Inject)
+		}
+	case file.Remove != "":
+		step.Remove = file.Remove
+	default:
+		return step, errors.Errorf("a step must inject or remove a fault")
+	}
+	return step, nil
+}
+
+func validate(sc *Scenario) error {
+	if errs := validation.IsDNS1123Label(sc.Name); len(errs) > 0 {
+		return errors.Errorf("invalid scenario name %q: %v", sc.Name, strings.Join(errs, ", "))
+	}
+	if len(sc.Steps) == 0 {
+		return errors.Errorf("the scenario has no steps")
+	}
+	injected := make(map[string]bool)
+	active := make(map[string]bool)
+	for _, step := range sc.Steps {
+		if step.Inject != "" {
+			if errs := validation.IsDNS1123Label(step.Inject); len(errs) > 0 {
+				return errors.Errorf("invalid fault name %q: %v", step.Inject, strings.Join(errs, ", "))
+			}
+			if injected[step.Inject] {
+				return errors.Errorf("fault %v is injected more than once", step.Inject)
+			}
+			injected[step.Inject] = true
+			active[step.Inject] = true
+			continue
+		}
+		if !active[step.Remove] {
+			return errors.Errorf("fault %v is removed at %v before it is injected", step.Remove, step.At)
+		}
+		delete(active, step.Remove)
+	}
+	if last := sc.Steps[len(sc.Steps)-1].At; sc.Duration != 0 && sc.Duration < last {
+		return errors.Errorf("the duration %v of the scenario ends before its last step at %v", sc.Duration, last)
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package scenario_test
+
+import (
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/glooshot/pkg/cli/scenario"
+)
+
+const drill = `name: checkout-drill
+namespace: bookinfo
+targetMesh:
+  namespace: supergloo-system
+  name: istio
+failureConditions:
+- name: errors
+  trigger:
+    prometheus:
+      customQuery: scalar(errors)
+      thresholdValue: 0.01
+steps:
+- at: 5m
+  remove: abort-ratings
+- at: 0s
+  inject:
+    name: abort-ratings
+    fault:
+      destinationServices:
+      - namespace: glooshot
+        name: ratings-9080
+      fault:
+        abort:
+          httpStatus: 503
+        percentage: 50
+- at: 2m
+  inject:
+    name: delay-reviews
+    fault:
+      destinationServices:
+      - namespace: glooshot
+        name: reviews-9080
+      fault:
+        delay:
+          duration: 2s
+        percentage: 100
+`
+
+var _ = Describe("Scenario", func() {
+
+	It("parses a scenario and orders its steps by time", func() {
+		sc, err := scenario.Parse([]byte(drill))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sc.Name).To(Equal("checkout-drill"))
+		Expect(sc.Namespace).To(Equal("bookinfo"))
+		Expect(sc.TargetMesh.Name).To(Equal("istio"))
+		Expect(sc.FailureConditions).To(HaveLen(1))
+		Expect(sc.FailureConditions[0].Trigger.GetPrometheus().ThresholdValue).To(Equal(0.01))
+
+		Expect(sc.Steps).To(HaveLen(3))
+		Expect(sc.Steps[0].At).To(Equal(time.Duration(0)))
+		Expect(sc.Steps[0].String()).To(Equal("inject abort-ratings"))
+		Expect(sc.Steps[0].Fault.Fault.GetAbort().HttpStatus).To(BeEquivalentTo(503))
+		Expect(sc.Steps[1].At).To(Equal(2 * time.Minute))
+		Expect(sc.Steps[2].String()).To(Equal("remove abort-ratings"))
+		// defaults to the time of the last step
+		Expect(sc.Duration).To(Equal(5 * time.Minute))
+	})
+
+	It("rejects invalid timelines", func() {
+		for replacement, message := range map[string]string{
+			"at: 1m\n  remove: delay-reviews":                                 "fault delay-reviews is removed at 1m0s before it is injected",
+			"at: 5m\n  remove: abort-ratings\n  inject: {name: x, fault: {}}": "a step either injects or removes a fault",
+			"at: -1m\n  remove: abort-ratings":                                "must not be negative",
+		} {
+			_, err := scenario.Parse([]byte(strings.Replace(drill, "at: 5m\n  remove: abort-ratings", replacement, 1)))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(message))
+		}
+
+		_, err := scenario.Parse([]byte(strings.Replace(drill, "name: delay-reviews", "name: abort-ratings", 1)))
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("fault abort-ratings is injected more than once"))
+
+		_, err = scenario.Parse([]byte(drill + "duration: 1m\n"))
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("ends before its last step"))
+
+		_, err = scenario.Parse([]byte(strings.Replace(drill, "at: 2m", "at: soon", 1)))
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("invalid step"))
+	})
+})
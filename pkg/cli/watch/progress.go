@@ -0,0 +1,205 @@
+package watch
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	v1 "github.com/solo-io/glooshot/pkg/api/v1"
+	"github.com/solo-io/glooshot/pkg/utils"
+)
+
+// width of the progress bar, in characters
+const progressBarWidth = 30
+
+// Progress is a snapshot of a running experiment, built from the experiment and the report glooshot publishes while it runs
+type Progress struct {
+	Experiment string
+	State      v1.ExperimentResult_State
+	Stage      string
+	Elapsed    time.Duration
+	// Duration is how long the experiment runs unless a failure condition is met, nil if the experiment has no spec
+	Duration      *time.Duration
+	Conditions    []ConditionProgress
+	FailureReport map[string]string
+}
+
+type ConditionProgress struct {
+	Name      string
+	Threshold string
+	Latest    string
+	Samples   int
+}
+
+func NewProgress(exp *v1.Experiment, report *v1.Report, now time.Time) Progress {
+	ref := exp.Metadata.Ref()
+	progress := Progress{
+		Experiment:    ref.Namespace + "." + ref.Name,
+		State:         exp.Result.State,
+		Stage:         stage(exp),
+		FailureReport: exp.Result.FailureReport,
+	}
+	if start, ok := timestampToTime(exp.Result.TimeStarted); ok {
+		end := now
+		if finish, ok := timestampToTime(exp.Result.TimeFinished); ok {
+			end = finish
+		}
+		progress.Elapsed = end.Sub(start)
+	}
+	if exp.Spec == nil {
+		return progress
+	}
+	duration := utils.ExperimentDuration(exp)
+	progress.Duration = &duration
+
+	histories := make(map[string]*v1.Report_FailureConditionHistory)
+	if report != nil {
+		for _, history := range report.FailureConditionHistory {
+			if history != nil {
+				histories[history.FailureConditionName] = history
+			}
+		}
+	}
+	for _, fc := range utils.FlattenFailureConditions(exp.Spec.FailureConditions) {
+		if utils.CompoundTrigger(fc) != nil {
+			continue
+		}
+		condition := ConditionProgress{
+			Name:      fc.Name,
+			Threshold: threshold(fc, exp),
+			Latest:    "-",
+		}
+		if history := histories[fc.Name]; history != nil && len(history.FailureConditionSnapshots) > 0 {
+			condition.Samples = len(history.FailureConditionSnapshots)
+			condition.Latest = fmt.Sprintf("%v", history.FailureConditionSnapshots[condition.Samples-1].Value)
+		}
+		progress.Conditions = append(progress.Conditions, condition)
+	}
+	return progress
+}
+
+func (p Progress) Concluded() bool {
+	switch p.State {
+	case v1.ExperimentResult_Succeeded, v1.ExperimentResult_Failed, v1.ExperimentResult_Rejected:
+		return true
+	}
+	return false
+}
+
+// Render writes the full view of the progress, meant to replace the previous view in a terminal
+func (p Progress) Render(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(tw, "Experiment:\t%v\n", p.Experiment)
+	fmt.Fprintf(tw, "State:\t%v\n", p.State.String())
+	fmt.Fprintf(tw, "Stage:\t%v\n", p.Stage)
+	fmt.Fprintf(tw, "Elapsed:\t%v\n", p.elapsed())
+	if len(p.Conditions) > 0 {
+		fmt.Fprintf(tw, "\nCONDITION\tLATEST\tTHRESHOLD\tSAMPLES\n")
+		for _, condition := range p.Conditions {
+			fmt.Fprintf(tw, "%v\t%v\t%v\t%v\n", condition.Name, condition.Latest, condition.Threshold, condition.Samples)
+		}
+	}
+	if p.Concluded() {
+		fmt.Fprintf(tw, "\n%v\n", p.Banner())
+	}
+	return tw.Flush()
+}
+
+// Changes returns a line for each change since the previous progress, for output which is not a terminal
+// previous is nil for the first progress
+func (p Progress) Changes(previous *Progress) []string {
+	var lines []string
+	if previous == nil || previous.Stage != p.Stage {
+		lines = append(lines, fmt.Sprintf("%v: %v", p.Experiment, p.Stage))
+	}
+	previousSamples := make(map[string]int)
+	if previous != nil {
+		for _, condition := range previous.Conditions {
+			previousSamples[condition.Name] = condition.Samples
+		}
+	}
+	for _, condition := range p.Conditions {
+		if condition.Samples > previousSamples[condition.Name] {
+			lines = append(lines, fmt.Sprintf("%v: condition %v is %v (met when %v) after %v",
+				p.Experiment, condition.Name, condition.Latest, condition.Threshold, p.Elapsed.Round(time.Second)))
+		}
+	}
+	if p.Concluded() && (previous == nil || !previous.Concluded()) {
+		lines = append(lines, p.Banner())
+	}
+	return lines
+}
+
+// Banner summarizes the result of a concluded experiment
+func (p Progress) Banner() string {
+	result := fmt.Sprintf("experiment %v %v after %v", p.Experiment, strings.ToLower(p.State.String()), p.Elapsed.Round(time.Second))
+	if len(p.FailureReport) > 0 {
+		var keys []string
+		for k := range p.FailureReport {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		var details []string
+		for _, k := range keys {
+			details = append(details, fmt.Sprintf("%v=%v", k, p.FailureReport[k]))
+		}
+		result += ": " + strings.Join(details, ", ")
+	}
+	border := strings.Repeat("=", 10)
+	return fmt.Sprintf("%v %v %v", border, result, border)
+}
+
+// elapsed time against the duration of the experiment, with a progress bar if it has one
+func (p Progress) elapsed() string {
+	elapsed := p.Elapsed.Round(time.Second)
+	if p.Duration == nil || *p.Duration <= 0 {
+		return elapsed.String()
+	}
+	fraction := float64(p.Elapsed) / float64(*p.Duration)
+	if fraction > 1 {
+		fraction = 1
+	}
+	done := int(fraction * progressBarWidth)
+	return fmt.Sprintf("%v / %v [%v%v] %v%%", elapsed, *p.Duration,
+		strings.Repeat("#", done), strings.Repeat("-", progressBarWidth-done), int(fraction*100))
+}
+
+func stage(exp *v1.Experiment) string {
+	switch exp.Result.State {
+	case v1.ExperimentResult_Pending:
+		return "waiting to start"
+	case v1.ExperimentResult_Started:
+		if exp.Spec == nil || len(exp.Spec.Faults) == 0 {
+			return "monitoring failure conditions, no faults injected"
+		}
+		return "faults injected, monitoring failure conditions"
+	case v1.ExperimentResult_Rejected:
+		return "rejected, no faults were injected"
+	}
+	return "concluded, faults rolled back"
+}
+
+// describes when the failure condition is met
+func threshold(fc *v1.FailureCondition, exp *v1.Experiment) string {
+	if errorBudget := fc.Trigger.GetErrorBudget(); errorBudget != nil {
+		return fmt.Sprintf("burn rate > %.3g", utils.BurnRateThreshold(errorBudget, exp))
+	}
+	if prometheus := fc.Trigger.GetPrometheus(); prometheus != nil {
+		if comparison, err := utils.GetComparison(prometheus); err == nil {
+			return comparison.String()
+		}
+	}
+	return "-"
+}
+
+func timestampToTime(ts *types.Timestamp) (time.Time, bool) {
+	if ts == nil {
+		return time.Time{}, false
+	}
+	t, err := types.TimestampFromProto(ts)
+	return t, err == nil
+}
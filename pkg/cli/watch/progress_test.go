@@ -0,0 +1,86 @@
+package watch_test
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/glooshot/pkg/api/v1"
+	"github.com/solo-io/glooshot/pkg/cli/watch"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("Progress", func() {
+	var (
+		start    = time.Date(2019, 6, 1, 12, 0, 0, 0, time.UTC)
+		duration = 10 * time.Minute
+		exp      *v1.Experiment
+		report   *v1.Report
+	)
+
+	timestamp := func(t time.Time) *types.Timestamp {
+		ts, err := types.TimestampProto(t)
+		Expect(err).NotTo(HaveOccurred())
+		return ts
+	}
+
+	BeforeEach(func() {
+		exp = &v1.Experiment{
+			Metadata: core.Metadata{Namespace: "default", Name: "abort-reviews"},
+			Spec: &v1.ExperimentSpec{
+				Duration: &duration,
+				FailureConditions: []*v1.FailureCondition{{
+					Name: "errors",
+					Trigger: &v1.FailureCondition_Trigger{FailureTrigger: &v1.FailureCondition_Trigger_Prometheus{
+						Prometheus: &v1.PrometheusTrigger{ThresholdValue: 0.2, Operator: v1.PrometheusTrigger_GREATER_THAN},
+					}},
+				}},
+			},
+			Result: v1.ExperimentResult{State: v1.ExperimentResult_Started, TimeStarted: timestamp(start)},
+		}
+		report = &v1.Report{
+			FailureConditionHistory: []*v1.Report_FailureConditionHistory{{
+				FailureConditionName:      "errors",
+				FailureConditionSnapshots: []*v1.Report_FailureConditionSnapshot{{Value: 0.1}},
+			}},
+		}
+	})
+
+	It("renders elapsed time against the duration and the latest value of each condition", func() {
+		progress := watch.NewProgress(exp, report, start.Add(5*time.Minute))
+		var buf bytes.Buffer
+		Expect(progress.Render(&buf)).NotTo(HaveOccurred())
+		Expect(buf.String()).To(ContainSubstring("5m0s / 10m0s [###############---------------] 50%"))
+		Expect(buf.String()).To(MatchRegexp(`errors\s+0.1\s+> 0.2\s+1`))
+		Expect(buf.String()).To(MatchRegexp(`Stage:\s+monitoring failure conditions, no faults injected`))
+		Expect(progress.Concluded()).To(BeFalse())
+	})
+
+	It("renders experiments without a duration against the duration glooshot runs them for", func() {
+		exp.Spec.Duration = nil
+		progress := watch.NewProgress(exp, report, start.Add(5*time.Minute))
+		var buf bytes.Buffer
+		Expect(progress.Render(&buf)).NotTo(HaveOccurred())
+		Expect(buf.String()).To(ContainSubstring("5m0s / 10m0s [###############---------------] 50%"))
+	})
+
+	It("reports changes line by line", func() {
+		first := watch.NewProgress(exp, nil, start)
+		Expect(first.Changes(nil)).To(Equal([]string{"default.abort-reviews: monitoring failure conditions, no faults injected"}))
+
+		second := watch.NewProgress(exp, report, start.Add(time.Minute))
+		Expect(second.Changes(&first)).To(Equal([]string{"default.abort-reviews: condition errors is 0.1 (met when > 0.2) after 1m0s"}))
+		Expect(second.Changes(&second)).To(BeEmpty())
+
+		exp.Result.State = v1.ExperimentResult_Failed
+		exp.Result.TimeFinished = timestamp(start.Add(2 * time.Minute))
+		exp.Result.FailureReport = map[string]string{"failure_condition": "errors"}
+		third := watch.NewProgress(exp, report, start.Add(time.Hour))
+		Expect(third.Changes(&second)).To(Equal([]string{
+			"default.abort-reviews: concluded, faults rolled back",
+			"========== experiment default.abort-reviews failed after 2m0s: failure_condition=errors ==========",
+		}))
+	})
+})
@@ -0,0 +1,73 @@
+package watch
+
+import (
+	"fmt"
+	"time"
+
+	v1 "github.com/solo-io/glooshot/pkg/api/v1"
+	"github.com/solo-io/glooshot/pkg/suite"
+)
+
+// SuiteChanges returns a line for each experiment of the suite whose state changed since the previous observation,
+// in the style of go test. previous is nil for the first observation
+func SuiteChanges(previous, current *v1.ExperimentSuite) []string {
+	previousStates := make(map[string]v1.SuiteResult_EntryResult_State)
+	if previous != nil && previous.Result.RunId == current.Result.RunId {
+		for _, entry := range previous.Result.Experiments {
+			previousStates[entry.Name] = entry.State
+		}
+	}
+	var lines []string
+	for _, entry := range current.Result.Experiments {
+		if entry.State == previousStates[entry.Name] {
+			continue
+		}
+		switch entry.State {
+		case v1.SuiteResult_EntryResult_Running:
+			if entry.Experiment != nil {
+				lines = append(lines, fmt.Sprintf("=== RUN   %v (%v.%v)", entry.Name, entry.Experiment.Namespace, entry.Experiment.Name))
+			}
+		case v1.SuiteResult_EntryResult_Succeeded:
+			lines = append(lines, fmt.Sprintf("--- PASS: %v (%v)", entry.Name, entryDuration(entry)))
+		case v1.SuiteResult_EntryResult_Failed:
+			lines = append(lines, fmt.Sprintf("--- FAIL: %v (%v)", entry.Name, entryDuration(entry)))
+		case v1.SuiteResult_EntryResult_Skipped:
+			lines = append(lines, fmt.Sprintf("--- SKIP: %v", entry.Name))
+		}
+		if entry.Message != "" && entry.State != v1.SuiteResult_EntryResult_Running {
+			lines = append(lines, "    "+entry.Message)
+		}
+	}
+	if suite.Concluded(current.Result) && (previous == nil || !suite.Concluded(previous.Result)) {
+		lines = append(lines, SuiteBanner(current))
+	}
+	return lines
+}
+
+// SuiteBanner summarizes the result of a concluded suite
+func SuiteBanner(s *v1.ExperimentSuite) string {
+	ref := s.Metadata.Ref()
+	verdict := "FAIL"
+	switch s.Result.State {
+	case v1.SuiteResult_Succeeded:
+		verdict = "PASS"
+	case v1.SuiteResult_Rejected:
+		return fmt.Sprintf("REJECTED %v.%v: %v", ref.Namespace, ref.Name, s.Result.Message)
+	}
+	elapsed := "-"
+	start, started := timestampToTime(s.Result.TimeStarted)
+	finish, finished := timestampToTime(s.Result.TimeFinished)
+	if started && finished {
+		elapsed = finish.Sub(start).Round(time.Second).String()
+	}
+	return fmt.Sprintf("%v %v.%v: %v (%v)", verdict, ref.Namespace, ref.Name, suite.Summary(s.Result), elapsed)
+}
+
+func entryDuration(entry *v1.SuiteResult_EntryResult) string {
+	start, started := timestampToTime(entry.TimeStarted)
+	finish, finished := timestampToTime(entry.TimeFinished)
+	if !started || !finished {
+		return "-"
+	}
+	return finish.Sub(start).Round(time.Second).String()
+}
@@ -0,0 +1,71 @@
+package watch_test
+
+import (
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/glooshot/pkg/api/v1"
+	"github.com/solo-io/glooshot/pkg/cli/watch"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("SuiteChanges", func() {
+
+	start := time.Date(2019, 4, 1, 12, 0, 0, 0, time.UTC)
+	timestamp := func(d time.Duration) *types.Timestamp {
+		ts, err := types.TimestampProto(start.Add(d))
+		Expect(err).NotTo(HaveOccurred())
+		return ts
+	}
+
+	It("prints the experiments of the suite as they run and conclude", func() {
+		running := &v1.ExperimentSuite{
+			Metadata: core.Metadata{Namespace: "bookinfo", Name: "game-day"},
+			Result: v1.SuiteResult{
+				State:       v1.SuiteResult_Running,
+				RunId:       "x1y2z",
+				TimeStarted: timestamp(0),
+				Experiments: []*v1.SuiteResult_EntryResult{
+					{
+						Name:        "abort",
+						State:       v1.SuiteResult_EntryResult_Running,
+						Experiment:  &core.ResourceRef{Namespace: "bookinfo", Name: "game-day-abort-x1y2z"},
+						TimeStarted: timestamp(0),
+					},
+					{Name: "delay"},
+				},
+			},
+		}
+		Expect(watch.SuiteChanges(nil, running)).To(Equal([]string{
+			"=== RUN   abort (bookinfo.game-day-abort-x1y2z)",
+		}))
+
+		concluded := proto.Clone(running).(*v1.ExperimentSuite)
+		concluded.Result.State = v1.SuiteResult_Failed
+		concluded.Result.TimeFinished = timestamp(3 * time.Minute)
+		concluded.Result.Experiments[0].State = v1.SuiteResult_EntryResult_Failed
+		concluded.Result.Experiments[0].TimeFinished = timestamp(2 * time.Minute)
+		concluded.Result.Experiments[1].State = v1.SuiteResult_EntryResult_Skipped
+		concluded.Result.Experiments[1].Message = "skipped as a previous experiment failed"
+		Expect(watch.SuiteChanges(running, concluded)).To(Equal([]string{
+			"--- FAIL: abort (2m0s)",
+			"--- SKIP: delay",
+			"    skipped as a previous experiment failed",
+			"FAIL bookinfo.game-day: 1 failed, 1 skipped (3m0s)",
+		}))
+		Expect(watch.SuiteChanges(concluded, concluded)).To(BeEmpty())
+	})
+
+	It("prints why a suite was rejected", func() {
+		rejected := &v1.ExperimentSuite{
+			Metadata: core.Metadata{Namespace: "bookinfo", Name: "game-day"},
+			Result:   v1.SuiteResult{State: v1.SuiteResult_Rejected, Message: "the suite has no experiments"},
+		}
+		Expect(watch.SuiteChanges(nil, rejected)).To(Equal([]string{
+			"REJECTED bookinfo.game-day: the suite has no experiments",
+		}))
+	})
+})
@@ -0,0 +1,63 @@
+package wizard
+
+import (
+	"github.com/pkg/errors"
+	"gopkg.in/AlecAivazis/survey.v1"
+)
+
+// Prompter asks the user for input, it is implemented with survey for terminals and by scripted answers in tests
+type Prompter interface {
+	// Select returns one of the options
+	Select(message string, options []string, defaultOption string) (string, error)
+	// MultiSelect returns the chosen options, at least min of them
+	MultiSelect(message string, options []string, min int) ([]string, error)
+	// Input returns the answer once validate accepts it, validate may be nil
+	Input(message, defaultValue string, validate func(string) error) (string, error)
+	Confirm(message string, defaultValue bool) (bool, error)
+}
+
+type surveyPrompter struct{}
+
+// NewSurveyPrompter prompts on the terminal
+func NewSurveyPrompter() Prompter {
+	return surveyPrompter{}
+}
+
+func (surveyPrompter) Select(message string, options []string, defaultOption string) (string, error) {
+	var answer string
+	err := survey.AskOne(&survey.Select{Message: message, Options: options, Default: defaultOption}, &answer, nil)
+	return answer, err
+}
+
+func (surveyPrompter) MultiSelect(message string, options []string, min int) ([]string, error) {
+	var answer []string
+	var validate survey.Validator
+	if min > 0 {
+		validate = func(ans interface{}) error {
+			if chosen, ok := ans.([]string); ok && len(chosen) < min {
+				return errors.Errorf("choose at least %v", min)
+			}
+			return nil
+		}
+	}
+	err := survey.AskOne(&survey.MultiSelect{Message: message, Options: options}, &answer, validate)
+	return answer, err
+}
+
+func (surveyPrompter) Input(message, defaultValue string, validate func(string) error) (string, error) {
+	var answer string
+	var validator survey.Validator
+	if validate != nil {
+		validator = func(ans interface{}) error {
+			return validate(ans.(string))
+		}
+	}
+	err := survey.AskOne(&survey.Input{Message: message, Default: defaultValue}, &answer, validator)
+	return answer, err
+}
+
+func (surveyPrompter) Confirm(message string, defaultValue bool) (bool, error) {
+	var answer bool
+	err := survey.AskOne(&survey.Confirm{Message: message, Default: defaultValue}, &answer, nil)
+	return answer, err
+}
@@ -0,0 +1,427 @@
+package wizard
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	v1 "github.com/solo-io/glooshot/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	sgv1 "github.com/solo-io/supergloo/pkg/api/v1"
+)
+
+// Choices are the resources the wizard offers to pick from
+type Choices struct {
+	// Meshes are the meshes registered with supergloo
+	Meshes []*core.ResourceRef
+	// Upstreams are the origins and destinations faults can be injected between
+	Upstreams []*core.ResourceRef
+	// Services are the kubernetes services failure conditions can watch
+	Services []*core.ResourceRef
+}
+
+const (
+	faultAbort = "abort"
+	faultDelay = "delay"
+)
+
+// the failure condition templates, in the order they are offered
+const (
+	conditionSuccessRate         = "success rate"
+	conditionErrorRate           = "error rate"
+	conditionLatencyPercentile   = "latency percentile"
+	conditionRequestVolume       = "request volume"
+	conditionTcpConnectionErrors = "tcp connection errors"
+	conditionContainerRestarts   = "container restarts"
+	conditionErrorBudget         = "error budget"
+	conditionCustomQuery         = "custom prometheus query"
+	conditionNone                = "none"
+)
+
+var conditionTemplates = []string{
+	conditionSuccessRate,
+	conditionErrorRate,
+	conditionLatencyPercentile,
+	conditionRequestVolume,
+	conditionTcpConnectionErrors,
+	conditionContainerRestarts,
+	conditionErrorBudget,
+	conditionCustomQuery,
+	conditionNone,
+}
+
+// the operator each template is most commonly compared with, e.g. an experiment fails once the success rate drops
+var defaultOperators = map[string]string{
+	conditionSuccessRate:         "<",
+	conditionErrorRate:           ">",
+	conditionLatencyPercentile:   ">",
+	conditionRequestVolume:       "<",
+	conditionTcpConnectionErrors: ">",
+	conditionContainerRestarts:   ">",
+	conditionCustomQuery:         ">",
+}
+
+var defaultThresholds = map[string]string{
+	conditionSuccessRate:         "0.95",
+	conditionErrorRate:           "0.05",
+	conditionLatencyPercentile:   "500",
+	conditionRequestVolume:       "1",
+	conditionTcpConnectionErrors: "0",
+	conditionContainerRestarts:   "0",
+	conditionCustomQuery:         "0",
+}
+
+var operatorSymbols = []string{"<", "<=", ">", ">=", "==", "!="}
+
+var operators = map[string]v1.PrometheusTrigger_ComparisonOperator{
+	"<":  v1.PrometheusTrigger_LESS_THAN,
+	"<=": v1.PrometheusTrigger_LESS_THAN_OR_EQUAL,
+	">":  v1.PrometheusTrigger_GREATER_THAN,
+	">=": v1.PrometheusTrigger_GREATER_THAN_OR_EQUAL,
+	"==": v1.PrometheusTrigger_EQUAL,
+	"!=": v1.PrometheusTrigger_NOT_EQUAL,
+}
+
+const defaultDuration = "10m"
+
+type wizard struct {
+	prompter Prompter
+	choices  Choices
+}
+
+// NewExperiment walks the user through the spec of an experiment with the given metadata:
+// its target mesh, faults, failure conditions and duration
+func NewExperiment(prompter Prompter, metadata core.Metadata, choices Choices) (*v1.Experiment, error) {
+	if len(choices.Meshes) == 0 {
+		return nil, errors.Errorf("no meshes found, experiments target a mesh registered with supergloo")
+	}
+	if len(choices.Upstreams) == 0 {
+		return nil, errors.Errorf("no upstreams found to inject faults between")
+	}
+	w := wizard{prompter: prompter, choices: choices}
+	spec := &v1.ExperimentSpec{}
+
+	mesh, err := w.selectRef("target mesh", choices.Meshes)
+	if err != nil {
+		return nil, err
+	}
+	spec.TargetMesh = mesh
+
+	for {
+		fault, err := w.fault()
+		if err != nil {
+			return nil, err
+		}
+		spec.Faults = append(spec.Faults, fault)
+		more, err := prompter.Confirm("add another fault?", false)
+		if err != nil {
+			return nil, err
+		}
+		if !more {
+			break
+		}
+	}
+
+	names := make(map[string]bool)
+	for {
+		fc, err := w.failureCondition(names)
+		if err != nil {
+			return nil, err
+		}
+		if fc == nil {
+			break
+		}
+		names[fc.Name] = true
+		spec.FailureConditions = append(spec.FailureConditions, fc)
+		more, err := prompter.Confirm("add another failure condition?", false)
+		if err != nil {
+			return nil, err
+		}
+		if !more {
+			break
+		}
+	}
+
+	duration, err := prompter.Input("duration of the experiment (it ends early once a failure condition is met)", defaultDuration, validatePositiveDuration)
+	if err != nil {
+		return nil, err
+	}
+	d, _ := time.ParseDuration(duration)
+	spec.Duration = &d
+
+	return &v1.Experiment{
+		Metadata: metadata,
+		Spec:     spec,
+	}, nil
+}
+
+func (w wizard) fault() (*v1.ExperimentSpec_InjectedFault, error) {
+	upstreams := refNames(w.choices.Upstreams)
+	origins, err := w.prompter.MultiSelect("origin upstreams (none applies the fault to requests from any origin)", upstreams, 0)
+	if err != nil {
+		return nil, err
+	}
+	destinations, err := w.prompter.MultiSelect("destination upstreams", upstreams, 1)
+	if err != nil {
+		return nil, err
+	}
+	faultType, err := w.prompter.Select("fault type", []string{faultAbort, faultDelay}, faultAbort)
+	if err != nil {
+		return nil, err
+	}
+	fault := &sgv1.FaultInjection{}
+	switch faultType {
+	case faultAbort:
+		status, err := w.prompter.Input("HTTP status to abort requests with", "503", validateHttpStatus)
+		if err != nil {
+			return nil, err
+		}
+		code, _ := strconv.Atoi(status)
+		fault.FaultInjectionType = &sgv1.FaultInjection_Abort_{
+			Abort: &sgv1.FaultInjection_Abort{
+				ErrorType: &sgv1.FaultInjection_Abort_HttpStatus{HttpStatus: int32(code)},
+			},
+		}
+	case faultDelay:
+		delay, err := w.prompter.Input("delay of requests", "1s", validatePositiveDuration)
+		if err != nil {
+			return nil, err
+		}
+		d, _ := time.ParseDuration(delay)
+		fault.FaultInjectionType = &sgv1.FaultInjection_Delay_{
+			Delay: &sgv1.FaultInjection_Delay{
+				Duration:  d,
+				DelayType: sgv1.FaultInjection_Delay_FIXED,
+			},
+		}
+	}
+	percentage, err := w.prompter.Input("percentage of requests to inject the fault into", "100", validatePositiveUpTo(100))
+	if err != nil {
+		return nil, err
+	}
+	fault.Percentage, _ = strconv.ParseFloat(percentage, 64)
+
+	return &v1.ExperimentSpec_InjectedFault{
+		OriginServices:      lookupRefs(w.choices.Upstreams, origins),
+		DestinationServices: lookupRefs(w.choices.Upstreams, destinations),
+		Fault:               fault,
+	}, nil
+}
+
+// returns nil if the user does not want another failure condition
+// names are the names of the failure conditions so far, which must be unique
+func (w wizard) failureCondition(names map[string]bool) (*v1.FailureCondition, error) {
+	template, err := w.prompter.Select("failure condition", conditionTemplates, conditionSuccessRate)
+	if err != nil || template == conditionNone {
+		return nil, err
+	}
+
+	var service *core.ResourceRef
+	if template != conditionCustomQuery {
+		if len(w.choices.Services) == 0 {
+			return nil, errors.Errorf("no services found for the %v failure condition to watch", template)
+		}
+		service, err = w.selectRef("service to watch", w.choices.Services)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	defaultName := strings.Replace(template, " ", "-", -1)
+	if service != nil {
+		defaultName += "-" + service.Name
+	}
+	name, err := w.prompter.Input("name of the failure condition", defaultName, func(name string) error {
+		if name == "" {
+			return errors.Errorf("a name is required")
+		}
+		if names[name] {
+			return errors.Errorf("failure condition %v already exists", name)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	trigger := &v1.FailureCondition_Trigger{}
+	if template == conditionErrorBudget {
+		errorBudget, err := w.errorBudget(service)
+		if err != nil {
+			return nil, err
+		}
+		trigger.FailureTrigger = &v1.FailureCondition_Trigger_ErrorBudget{ErrorBudget: errorBudget}
+	} else {
+		prometheus, err := w.prometheus(template, service)
+		if err != nil {
+			return nil, err
+		}
+		trigger.FailureTrigger = &v1.FailureCondition_Trigger_Prometheus{Prometheus: prometheus}
+	}
+	return &v1.FailureCondition{Name: name, Trigger: trigger}, nil
+}
+
+func (w wizard) prometheus(template string, service *core.ResourceRef) (*v1.PrometheusTrigger, error) {
+	trigger := &v1.PrometheusTrigger{}
+	if template == conditionCustomQuery {
+		query, err := w.prompter.Input("prometheus query, must return a scalar", "", func(query string) error {
+			if query == "" {
+				return errors.Errorf("a query is required")
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		trigger.QueryType = &v1.PrometheusTrigger_CustomQuery{CustomQuery: query}
+	} else {
+		interval, err := w.prompter.Input("interval to compute the rate over", "1m", validatePositiveDuration)
+		if err != nil {
+			return nil, err
+		}
+		d, _ := time.ParseDuration(interval)
+		switch template {
+		case conditionSuccessRate:
+			trigger.QueryType = &v1.PrometheusTrigger_SuccessRate{
+				SuccessRate: &v1.PrometheusTrigger_SuccessRateQuery{Service: service, Interval: &d},
+			}
+		case conditionErrorRate:
+			trigger.QueryType = &v1.PrometheusTrigger_ErrorRate{
+				ErrorRate: &v1.PrometheusTrigger_ErrorRateQuery{Service: service, Interval: &d},
+			}
+		case conditionLatencyPercentile:
+			percentile, err := w.prompter.Input("percentile", "99", validateFloatBetween(0, 100))
+			if err != nil {
+				return nil, err
+			}
+			p, _ := strconv.ParseFloat(percentile, 64)
+			trigger.QueryType = &v1.PrometheusTrigger_LatencyPercentile{
+				LatencyPercentile: &v1.PrometheusTrigger_LatencyPercentileQuery{Service: service, Interval: &d, Percentile: p},
+			}
+		case conditionRequestVolume:
+			trigger.QueryType = &v1.PrometheusTrigger_RequestVolume{
+				RequestVolume: &v1.PrometheusTrigger_RequestVolumeQuery{Service: service, Interval: &d},
+			}
+		case conditionTcpConnectionErrors:
+			trigger.QueryType = &v1.PrometheusTrigger_TcpConnectionErrors{
+				TcpConnectionErrors: &v1.PrometheusTrigger_TcpConnectionErrorsQuery{Service: service, Interval: &d},
+			}
+		case conditionContainerRestarts:
+			trigger.QueryType = &v1.PrometheusTrigger_ContainerRestarts{
+				ContainerRestarts: &v1.PrometheusTrigger_ContainerRestartsQuery{Service: service, Interval: &d},
+			}
+		}
+	}
+
+	operator, err := w.prompter.Select("the condition is met when the value is", operatorSymbols, defaultOperators[template])
+	if err != nil {
+		return nil, err
+	}
+	trigger.Operator = operators[operator]
+	threshold, err := w.prompter.Input(fmt.Sprintf("threshold, met when the value is %v", operator), defaultThresholds[template], validateFloat)
+	if err != nil {
+		return nil, err
+	}
+	trigger.ThresholdValue, _ = strconv.ParseFloat(threshold, 64)
+	return trigger, nil
+}
+
+func (w wizard) errorBudget(service *core.ResourceRef) (*v1.ErrorBudgetTrigger, error) {
+	target, err := w.prompter.Input("success rate objective, in percent", "99.9", validateFloatBetween(0, 100))
+	if err != nil {
+		return nil, err
+	}
+	fraction, err := w.prompter.Input("fraction of the error budget the experiment may consume", "0.1", validatePositiveUpTo(1))
+	if err != nil {
+		return nil, err
+	}
+	trigger := &v1.ErrorBudgetTrigger{Service: service}
+	trigger.Target, _ = strconv.ParseFloat(target, 64)
+	trigger.MaxBudgetFraction, _ = strconv.ParseFloat(fraction, 64)
+	return trigger, nil
+}
+
+func (w wizard) selectRef(message string, refs []*core.ResourceRef) (*core.ResourceRef, error) {
+	names := refNames(refs)
+	name, err := w.prompter.Select(message, names, names[0])
+	if err != nil {
+		return nil, err
+	}
+	chosen := lookupRefs(refs, []string{name})
+	if len(chosen) == 0 {
+		return nil, errors.Errorf("unknown %v %v", message, name)
+	}
+	return chosen[0], nil
+}
+
+func refName(ref *core.ResourceRef) string {
+	return ref.Namespace + "." + ref.Name
+}
+
+func refNames(refs []*core.ResourceRef) []string {
+	var names []string
+	for _, ref := range refs {
+		names = append(names, refName(ref))
+	}
+	return names
+}
+
+func lookupRefs(refs []*core.ResourceRef, names []string) []*core.ResourceRef {
+	var chosen []*core.ResourceRef
+	for _, name := range names {
+		for _, ref := range refs {
+			if refName(ref) == name {
+				chosen = append(chosen, ref)
+				break
+			}
+		}
+	}
+	return chosen
+}
+
+func validateFloat(value string) error {
+	if _, err := strconv.ParseFloat(value, 64); err != nil {
+		return errors.Errorf("%q is not a number", value)
+	}
+	return nil
+}
+
+// validates a number strictly between min and max
+func validateFloatBetween(min, max float64) func(string) error {
+	return func(value string) error {
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil || f <= min || f >= max {
+			return errors.Errorf("must be a number between %v and %v", min, max)
+		}
+		return nil
+	}
+}
+
+// validates a number greater than 0 and at most max
+func validatePositiveUpTo(max float64) func(string) error {
+	return func(value string) error {
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil || f <= 0 || f > max {
+			return errors.Errorf("must be a number greater than 0 and at most %v", max)
+		}
+		return nil
+	}
+}
+
+func validateHttpStatus(value string) error {
+	code, err := strconv.Atoi(value)
+	if err != nil || code < 200 || code > 599 {
+		return errors.Errorf("must be an HTTP status between 200 and 599")
+	}
+	return nil
+}
+
+func validatePositiveDuration(value string) error {
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		return errors.Errorf("must be a positive duration such as 30s or 10m")
+	}
+	return nil
+}
@@ -0,0 +1,13 @@
+package wizard_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestWizard(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Wizard Suite")
+}
@@ -0,0 +1,184 @@
+package wizard_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	v1 "github.com/solo-io/glooshot/pkg/api/v1"
+	"github.com/solo-io/glooshot/pkg/cli/wizard"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	sgv1 "github.com/solo-io/supergloo/pkg/api/v1"
+)
+
+// answers the prompts in order, an empty answer takes the default
+type scriptedPrompter struct {
+	answers []interface{}
+	asked   []string
+}
+
+func (p *scriptedPrompter) next(message string) interface{} {
+	p.asked = append(p.asked, message)
+	Expect(p.answers).NotTo(BeEmpty(), "unexpected prompt %q", message)
+	answer := p.answers[0]
+	p.answers = p.answers[1:]
+	return answer
+}
+
+func (p *scriptedPrompter) Select(message string, options []string, defaultOption string) (string, error) {
+	answer := p.next(message).(string)
+	if answer == "" {
+		return defaultOption, nil
+	}
+	Expect(options).To(ContainElement(answer))
+	return answer, nil
+}
+
+func (p *scriptedPrompter) MultiSelect(message string, options []string, min int) ([]string, error) {
+	answer := p.next(message).([]string)
+	Expect(len(answer)).To(BeNumerically(">=", min))
+	return answer, nil
+}
+
+func (p *scriptedPrompter) Input(message, defaultValue string, validate func(string) error) (string, error) {
+	answer := p.next(message).(string)
+	if answer == "" {
+		answer = defaultValue
+	}
+	if validate != nil {
+		if err := validate(answer); err != nil {
+			return "", errors.Wrapf(err, "invalid answer %q to %q", answer, message)
+		}
+	}
+	return answer, nil
+}
+
+func (p *scriptedPrompter) Confirm(message string, defaultValue bool) (bool, error) {
+	return p.next(message).(bool), nil
+}
+
+var _ = Describe("NewExperiment", func() {
+
+	var (
+		meta    = core.Metadata{Namespace: "bookinfo", Name: "abort-ratings"}
+		choices = wizard.Choices{
+			Meshes:    []*core.ResourceRef{{Namespace: "supergloo-system", Name: "istio"}},
+			Upstreams: []*core.ResourceRef{{Namespace: "glooshot", Name: "reviews-9080"}, {Namespace: "glooshot", Name: "ratings-9080"}},
+			Services:  []*core.ResourceRef{{Namespace: "bookinfo", Name: "productpage"}},
+		}
+	)
+
+	It("builds an experiment from the answers", func() {
+		prompter := &scriptedPrompter{answers: []interface{}{
+			// target mesh
+			"",
+			// fault
+			[]string{"glooshot.reviews-9080"}, []string{"glooshot.ratings-9080"}, "abort", "500", "50", false,
+			// failure conditions
+			"", "", "", "2m", "<", "0.9", true,
+			"error budget", "", "budget", "99.5", "0.2", false,
+			// duration
+			"5m",
+		}}
+		exp, err := wizard.NewExperiment(prompter, meta, choices)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(prompter.answers).To(BeEmpty())
+
+		Expect(exp.Metadata).To(Equal(meta))
+		Expect(exp.Spec.TargetMesh).To(Equal(choices.Meshes[0]))
+		Expect(*exp.Spec.Duration).To(Equal(5 * time.Minute))
+
+		Expect(exp.Spec.Faults).To(HaveLen(1))
+		fault := exp.Spec.Faults[0]
+		Expect(fault.OriginServices).To(Equal([]*core.ResourceRef{choices.Upstreams[0]}))
+		Expect(fault.DestinationServices).To(Equal([]*core.ResourceRef{choices.Upstreams[1]}))
+		Expect(fault.Fault.GetAbort().GetHttpStatus()).To(BeEquivalentTo(500))
+		Expect(fault.Fault.Percentage).To(Equal(50.0))
+
+		Expect(exp.Spec.FailureConditions).To(HaveLen(2))
+		successRate := exp.Spec.FailureConditions[0]
+		Expect(successRate.Name).To(Equal("success-rate-productpage"))
+		prometheus := successRate.Trigger.GetPrometheus()
+		Expect(prometheus.GetSuccessRate().Service).To(Equal(choices.Services[0]))
+		Expect(*prometheus.GetSuccessRate().Interval).To(Equal(2 * time.Minute))
+		Expect(prometheus.Operator).To(Equal(v1.PrometheusTrigger_LESS_THAN))
+		Expect(prometheus.ThresholdValue).To(Equal(0.9))
+
+		budget := exp.Spec.FailureConditions[1]
+		Expect(budget.Name).To(Equal("budget"))
+		Expect(budget.Trigger.GetErrorBudget()).To(Equal(&v1.ErrorBudgetTrigger{
+			Service:           choices.Services[0],
+			Target:            99.5,
+			MaxBudgetFraction: 0.2,
+		}))
+	})
+
+	It("supports delays and custom queries", func() {
+		prompter := &scriptedPrompter{answers: []interface{}{
+			"",
+			[]string{}, []string{"glooshot.ratings-9080"}, "delay", "2s", "", false,
+			"custom prometheus query", "slow", "scalar(latency)", ">=", "1.5", false,
+			"30s",
+		}}
+		exp, err := wizard.NewExperiment(prompter, meta, choices)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*exp.Spec.Duration).To(Equal(30 * time.Second))
+
+		fault := exp.Spec.Faults[0]
+		Expect(fault.OriginServices).To(BeEmpty())
+		Expect(fault.Fault.GetDelay()).To(Equal(&sgv1.FaultInjection_Delay{
+			Duration:  2 * time.Second,
+			DelayType: sgv1.FaultInjection_Delay_FIXED,
+		}))
+		Expect(fault.Fault.Percentage).To(Equal(100.0))
+
+		prometheus := exp.Spec.FailureConditions[0].Trigger.GetPrometheus()
+		Expect(prometheus.GetCustomQuery()).To(Equal("scalar(latency)"))
+		Expect(prometheus.Operator).To(Equal(v1.PrometheusTrigger_GREATER_THAN_OR_EQUAL))
+		Expect(prometheus.ThresholdValue).To(Equal(1.5))
+	})
+
+	It("allows experiments without failure conditions", func() {
+		prompter := &scriptedPrompter{answers: []interface{}{
+			"",
+			[]string{}, []string{"glooshot.ratings-9080"}, "", "", "", false,
+			"none",
+			"",
+		}}
+		exp, err := wizard.NewExperiment(prompter, meta, choices)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(exp.Spec.FailureConditions).To(BeEmpty())
+		Expect(exp.Spec.Faults[0].Fault.GetAbort().GetHttpStatus()).To(BeEquivalentTo(503))
+		Expect(*exp.Spec.Duration).To(Equal(10 * time.Minute))
+	})
+
+	It("rejects duplicate failure condition names", func() {
+		prompter := &scriptedPrompter{answers: []interface{}{
+			"",
+			[]string{}, []string{"glooshot.ratings-9080"}, "", "", "", false,
+			"custom prometheus query", "errors", "scalar(errors)", "", "", true,
+			"custom prometheus query", "errors",
+		}}
+		_, err := wizard.NewExperiment(prompter, meta, choices)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("failure condition errors already exists"))
+	})
+
+	It("rejects experiments without a duration", func() {
+		prompter := &scriptedPrompter{answers: []interface{}{
+			"",
+			[]string{}, []string{"glooshot.ratings-9080"}, "", "", "", false,
+			"none",
+			"0",
+		}}
+		_, err := wizard.NewExperiment(prompter, meta, choices)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("must be a positive duration"))
+	})
+
+	It("requires a mesh to target", func() {
+		_, err := wizard.NewExperiment(&scriptedPrompter{}, meta, wizard.Choices{Upstreams: choices.Upstreams})
+		Expect(err).To(HaveOccurred())
+	})
+})
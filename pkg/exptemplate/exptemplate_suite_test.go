@@ -0,0 +1,13 @@
+package exptemplate_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestExptemplate(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Exptemplate Suite")
+}
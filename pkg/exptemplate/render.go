@@ -0,0 +1,170 @@
+package exptemplate
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/pkg/errors"
+	v1 "github.com/solo-io/glooshot/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// TemplateLabel marks experiments with the name of the template they were rendered from
+const TemplateLabel = "glooshot.solo.io/template"
+
+var parameterName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// ParseValues parses values given as key=value pairs
+func ParseValues(pairs []string) (map[string]string, error) {
+	values := make(map[string]string)
+	for _, pair := range pairs {
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, errors.Errorf("invalid value %q, must be of the form key=value", pair)
+		}
+		values[parts[0]] = parts[1]
+	}
+	return values, nil
+}
+
+// Render renders the template into an experiment with the given metadata, from the values of its parameters
+// the values are validated against the types of the parameters, and must include all required parameters
+func Render(tmpl *v1.ExperimentTemplate, metadata core.Metadata, values map[string]string) (*v1.Experiment, error) {
+	data, err := templateData(tmpl, values)
+	if err != nil {
+		return nil, err
+	}
+	specTemplate, err := template.New(tmpl.Metadata.Name).Option("missingkey=error").Parse(tmpl.Spec)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid template spec")
+	}
+	var rendered bytes.Buffer
+	if err := specTemplate.Execute(&rendered, data); err != nil {
+		return nil, errors.Wrapf(err, "rendering template")
+	}
+	jsn, err := yaml.YAMLToJSON(rendered.Bytes())
+	if err != nil {
+		return nil, errors.Wrapf(err, "the rendered spec is not valid yaml")
+	}
+	spec := &v1.ExperimentSpec{}
+	if err := jsonpb.Unmarshal(bytes.NewReader(jsn), spec); err != nil {
+		return nil, errors.Wrapf(err, "the rendered spec is not a valid experiment spec")
+	}
+
+	labels := make(map[string]string)
+	for k, v := range metadata.Labels {
+		labels[k] = v
+	}
+	labels[TemplateLabel] = tmpl.Metadata.Name
+	metadata.Labels = labels
+	return &v1.Experiment{
+		Metadata: metadata,
+		Spec:     spec,
+	}, nil
+}
+
+// the data the spec is rendered with, the typed value of each parameter by name
+func templateData(tmpl *v1.ExperimentTemplate, values map[string]string) (map[string]interface{}, error) {
+	params := make(map[string]*v1.ExperimentTemplate_Parameter)
+	for _, param := range tmpl.Parameters {
+		if !parameterName.MatchString(param.Name) {
+			return nil, errors.Errorf("invalid template: parameter name %q must be a go identifier", param.Name)
+		}
+		if params[param.Name] != nil {
+			return nil, errors.Errorf("invalid template: duplicate parameter %v", param.Name)
+		}
+		params[param.Name] = param
+	}
+	for name := range values {
+		if params[name] == nil {
+			return nil, errors.Errorf("unknown parameter %v, the template has parameters %v", name, parameterNames(tmpl))
+		}
+	}
+
+	data := make(map[string]interface{})
+	var missing []string
+	for _, param := range tmpl.Parameters {
+		value, ok := values[param.Name]
+		if !ok && param.Default != "" {
+			value, ok = param.Default, true
+		}
+		if !ok {
+			if param.Required {
+				missing = append(missing, param.Name)
+			}
+			data[param.Name] = zeroValue(param.Type)
+			continue
+		}
+		typed, err := convert(param.Type, value)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid value %q for parameter %v", value, param.Name)
+		}
+		data[param.Name] = typed
+	}
+	if len(missing) > 0 {
+		return nil, errors.Errorf("missing values for required parameters %v", strings.Join(missing, ", "))
+	}
+	return data, nil
+}
+
+func convert(paramType v1.ExperimentTemplate_Parameter_Type, value string) (interface{}, error) {
+	switch paramType {
+	case v1.ExperimentTemplate_Parameter_SERVICE_REF:
+		parts := strings.SplitN(value, ".", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, errors.Errorf("must be of the form namespace.name")
+		}
+		return map[string]string{"namespace": parts[0], "name": parts[1]}, nil
+	case v1.ExperimentTemplate_Parameter_NAMESPACE:
+		if errs := validation.IsDNS1123Label(value); len(errs) > 0 {
+			return nil, errors.Errorf("not a valid namespace: %v", strings.Join(errs, ", "))
+		}
+		return value, nil
+	case v1.ExperimentTemplate_Parameter_PERCENTAGE:
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil || f < 0 || f > 100 {
+			return nil, errors.Errorf("must be a number between 0 and 100")
+		}
+		return f, nil
+	case v1.ExperimentTemplate_Parameter_NUMBER:
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, errors.Errorf("must be a number")
+		}
+		return f, nil
+	case v1.ExperimentTemplate_Parameter_DURATION:
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return nil, errors.Errorf("must be a duration such as 30s or 5m")
+		}
+		// the form protobuf durations are given in
+		return fmt.Sprintf("%vs", d.Seconds()), nil
+	}
+	return value, nil
+}
+
+// the value of optional parameters without a value, false in go template conditions
+func zeroValue(paramType v1.ExperimentTemplate_Parameter_Type) interface{} {
+	if paramType == v1.ExperimentTemplate_Parameter_SERVICE_REF {
+		return map[string]string(nil)
+	}
+	return ""
+}
+
+func parameterNames(tmpl *v1.ExperimentTemplate) string {
+	var names []string
+	for _, param := range tmpl.Parameters {
+		names = append(names, param.Name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,125 @@
+package exptemplate_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/glooshot/pkg/api/v1"
+	"github.com/solo-io/glooshot/pkg/exptemplate"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+const spec = `duration: {{ .duration }}
+targetMesh:
+  namespace: {{ .meshNamespace }}
+  name: istio
+faults:
+- destinationServices:
+  - namespace: {{ .destination.namespace }}
+    name: {{ .destination.name }}
+  {{- if .origin }}
+  originServices:
+  - namespace: {{ .origin.namespace }}
+    name: {{ .origin.name }}
+  {{- end }}
+  fault:
+    abort:
+      httpStatus: 500
+    percentage: {{ .percentage }}
+failureConditions:
+- name: errors
+  trigger:
+    prometheus:
+      customQuery: scalar(errors)
+      thresholdValue: {{ .threshold }}
+`
+
+var _ = Describe("Render", func() {
+
+	var (
+		tmpl     *v1.ExperimentTemplate
+		metadata = core.Metadata{Namespace: "bookinfo", Name: "abort-ratings"}
+	)
+
+	BeforeEach(func() {
+		tmpl = &v1.ExperimentTemplate{
+			Metadata: core.Metadata{Namespace: "bookinfo", Name: "abort"},
+			Parameters: []*v1.ExperimentTemplate_Parameter{
+				{Name: "destination", Type: v1.ExperimentTemplate_Parameter_SERVICE_REF, Required: true},
+				{Name: "origin", Type: v1.ExperimentTemplate_Parameter_SERVICE_REF},
+				{Name: "meshNamespace", Type: v1.ExperimentTemplate_Parameter_NAMESPACE, Default: "supergloo-system"},
+				{Name: "percentage", Type: v1.ExperimentTemplate_Parameter_PERCENTAGE, Default: "100"},
+				{Name: "threshold", Type: v1.ExperimentTemplate_Parameter_NUMBER, Required: true},
+				{Name: "duration", Type: v1.ExperimentTemplate_Parameter_DURATION, Default: "5m"},
+			},
+			Spec: spec,
+		}
+	})
+
+	It("renders an experiment from the values and defaults", func() {
+		exp, err := exptemplate.Render(tmpl, metadata, map[string]string{
+			"destination": "glooshot.ratings-9080",
+			"percentage":  "50",
+			"threshold":   "0.01",
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(exp.Metadata.Name).To(Equal("abort-ratings"))
+		Expect(exp.Metadata.Labels).To(Equal(map[string]string{exptemplate.TemplateLabel: "abort"}))
+
+		Expect(*exp.Spec.Duration).To(Equal(5 * time.Minute))
+		Expect(exp.Spec.TargetMesh).To(Equal(&core.ResourceRef{Namespace: "supergloo-system", Name: "istio"}))
+		fault := exp.Spec.Faults[0]
+		Expect(fault.DestinationServices).To(Equal([]*core.ResourceRef{{Namespace: "glooshot", Name: "ratings-9080"}}))
+		Expect(fault.OriginServices).To(BeEmpty())
+		Expect(fault.Fault.Percentage).To(Equal(50.0))
+		Expect(exp.Spec.FailureConditions[0].Trigger.GetPrometheus().ThresholdValue).To(Equal(0.01))
+	})
+
+	It("renders optional parameters which are set", func() {
+		exp, err := exptemplate.Render(tmpl, metadata, map[string]string{
+			"destination": "glooshot.ratings-9080",
+			"origin":      "glooshot.reviews-9080",
+			"threshold":   "1",
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(exp.Spec.Faults[0].OriginServices).To(Equal([]*core.ResourceRef{{Namespace: "glooshot", Name: "reviews-9080"}}))
+	})
+
+	It("requires the required parameters", func() {
+		_, err := exptemplate.Render(tmpl, metadata, map[string]string{})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("missing values for required parameters destination, threshold"))
+	})
+
+	It("validates the types of the values", func() {
+		for name, value := range map[string]string{
+			"destination":   "ratings",
+			"meshNamespace": "Not_A_Namespace",
+			"percentage":    "150",
+			"threshold":     "high",
+			"duration":      "forever",
+		} {
+			values := map[string]string{"destination": "glooshot.ratings-9080", "threshold": "1"}
+			values[name] = value
+			_, err := exptemplate.Render(tmpl, metadata, values)
+			Expect(err).To(HaveOccurred(), "%v=%v", name, value)
+			Expect(err.Error()).To(ContainSubstring("parameter " + name))
+		}
+	})
+
+	It("rejects unknown parameters", func() {
+		_, err := exptemplate.Render(tmpl, metadata, map[string]string{"destination": "glooshot.ratings-9080", "threshold": "1", "color": "red"})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("unknown parameter color"))
+	})
+
+	It("parses key=value pairs", func() {
+		values, err := exptemplate.ParseValues([]string{"destination=glooshot.ratings-9080", "query=a=b"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(values).To(Equal(map[string]string{"destination": "glooshot.ratings-9080", "query": "a=b"}))
+
+		_, err = exptemplate.ParseValues([]string{"destination"})
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,191 @@
+package lifecycle
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/go-utils/errors"
+	"github.com/solo-io/go-utils/hashutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"go.uber.org/zap"
+)
+
+// how events are encoded in HTTP requests, see https://github.com/cloudevents/spec/blob/v1.0/http-protocol-binding.md
+type CloudEventsMode string
+
+const (
+	// attributes are sent as ce- headers, the body contains only the data
+	CloudEventsBinary CloudEventsMode = "binary"
+	// attributes and data are sent together as a single json document
+	CloudEventsStructured CloudEventsMode = "structured"
+
+	cloudEventsSpecVersion    = "1.0"
+	cloudEventsTypePrefix     = "io.solo.glooshot."
+	cloudEventsContentType    = "application/json"
+	cloudEventsStructuredType = "application/cloudevents+json"
+
+	// events are dropped rather than blocking glooshot if the sink falls this far behind
+	cloudEventsQueueSize = 100
+)
+
+type CloudEventsOpts struct {
+	// the url events are POSTed to
+	SinkURL string
+	Mode    CloudEventsMode
+	// the source attribute of the events
+	Source string
+}
+
+// the data of the events
+type CloudEventData struct {
+	Experiment   core.ResourceRef   `json:"experiment"`
+	SpecHash     string             `json:"specHash"`
+	Result       json.RawMessage    `json:"result,omitempty"`
+	Message      string             `json:"message,omitempty"`
+	Details      map[string]string  `json:"details,omitempty"`
+	RoutingRules []core.ResourceRef `json:"routingRules,omitempty"`
+}
+
+// a cloud event in structured mode
+type CloudEvent struct {
+	SpecVersion     string          `json:"specversion"`
+	ID              string          `json:"id"`
+	Source          string          `json:"source"`
+	Type            string          `json:"type"`
+	Subject         string          `json:"subject,omitempty"`
+	Time            string          `json:"time"`
+	DataContentType string          `json:"datacontenttype"`
+	Data            *CloudEventData `json:"data"`
+}
+
+type cloudEventsObserver struct {
+	opts       CloudEventsOpts
+	httpClient *http.Client
+	events     chan Event
+	sequence   uint64
+}
+
+// delivers events to the sink in the order they are observed, until the context is cancelled
+func NewCloudEventsObserver(ctx context.Context, opts CloudEventsOpts) (Observer, error) {
+	if opts.SinkURL == "" {
+		return nil, errors.Errorf("a sink url is required to emit cloud events")
+	}
+	switch opts.Mode {
+	case CloudEventsBinary, CloudEventsStructured:
+	default:
+		return nil, errors.Errorf("invalid cloud events mode %q, must be %v or %v", opts.Mode, CloudEventsBinary, CloudEventsStructured)
+	}
+	o := &cloudEventsObserver{
+		opts:       opts,
+		httpClient: &http.Client{Timeout: time.Second * 5},
+		events:     make(chan Event, cloudEventsQueueSize),
+	}
+	go o.run(ctx)
+	return o, nil
+}
+
+func (o *cloudEventsObserver) Observe(ctx context.Context, event Event) {
+	select {
+	case o.events <- event:
+	default:
+		contextutils.LoggerFrom(ctx).Warnw("cloud events queue is full, dropping event", "type", event.Type)
+	}
+}
+
+func (o *cloudEventsObserver) run(ctx context.Context) {
+	logger := contextutils.LoggerFrom(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-o.events:
+			if err := o.send(ctx, event); err != nil {
+				logger.Warnw("failed to emit cloud event", zap.Error(err), "type", event.Type)
+			}
+		}
+	}
+}
+
+func (o *cloudEventsObserver) send(ctx context.Context, event Event) error {
+	o.sequence++
+	ce, err := o.toCloudEvent(event)
+	if err != nil {
+		return err
+	}
+	req, err := cloudEventRequest(o.opts.SinkURL, o.opts.Mode, ce)
+	if err != nil {
+		return err
+	}
+	resp, err := o.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return errors.Errorf("unexpected response from %v: %v", o.opts.SinkURL, resp.Status)
+	}
+	return nil
+}
+
+func (o *cloudEventsObserver) toCloudEvent(event Event) (*CloudEvent, error) {
+	ref := event.Experiment.Metadata.Ref()
+	data := &CloudEventData{
+		Experiment:   ref,
+		SpecHash:     fmt.Sprintf("%v", hashutils.HashAll(event.Experiment.Spec)),
+		Message:      event.Message,
+		Details:      event.Details,
+		RoutingRules: event.RoutingRules,
+	}
+	var result bytes.Buffer
+	if err := (&jsonpb.Marshaler{}).Marshal(&result, &event.Experiment.Result); err != nil {
+		return nil, errors.Wrapf(err, "marshalling experiment result")
+	}
+	data.Result = result.Bytes()
+	return &CloudEvent{
+		SpecVersion:     cloudEventsSpecVersion,
+		ID:              fmt.Sprintf("%v-%v", event.Time.UnixNano(), o.sequence),
+		Source:          o.opts.Source,
+		Type:            cloudEventsTypePrefix + string(event.Type),
+		Subject:         ref.Namespace + "/" + ref.Name,
+		Time:            event.Time.UTC().Format(time.RFC3339Nano),
+		DataContentType: cloudEventsContentType,
+		Data:            data,
+	}, nil
+}
+
+func cloudEventRequest(url string, mode CloudEventsMode, ce *CloudEvent) (*http.Request, error) {
+	if mode == CloudEventsStructured {
+		body, err := json.Marshal(ce)
+		if err != nil {
+			return nil, err
+		}
+		req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", cloudEventsStructuredType)
+		return req, nil
+	}
+	body, err := json.Marshal(ce.Data)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", ce.DataContentType)
+	req.Header.Set("ce-specversion", ce.SpecVersion)
+	req.Header.Set("ce-id", ce.ID)
+	req.Header.Set("ce-source", ce.Source)
+	req.Header.Set("ce-type", ce.Type)
+	req.Header.Set("ce-subject", ce.Subject)
+	req.Header.Set("ce-time", ce.Time)
+	return req, nil
+}
@@ -0,0 +1,98 @@
+package lifecycle_test
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/glooshot/pkg/api/v1"
+
+	. "github.com/solo-io/glooshot/pkg/lifecycle"
+)
+
+var _ = Describe("CloudEvents", func() {
+	type received struct {
+		header http.Header
+		body   []byte
+	}
+	var (
+		ctx        context.Context
+		cancel     context.CancelFunc
+		server     *httptest.Server
+		requests   chan received
+		experiment *v1.Experiment
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		requests = make(chan received, 10)
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := ioutil.ReadAll(r.Body)
+			requests <- received{header: r.Header, body: body}
+		}))
+		experiment = v1.NewExperiment("default", "abort-ratings")
+		experiment.Result.State = v1.ExperimentResult_Failed
+		experiment.Result.FailureReport = map[string]string{"failure_type": "value_exceeded_threshold"}
+	})
+
+	AfterEach(func() {
+		cancel()
+		server.Close()
+	})
+
+	It("emits events in binary mode", func() {
+		observer, err := NewCloudEventsObserver(ctx, CloudEventsOpts{SinkURL: server.URL, Mode: CloudEventsBinary, Source: "glooshot"})
+		Expect(err).NotTo(HaveOccurred())
+
+		observer.Observe(ctx, NewEvent(ExperimentConcluded, experiment, "experiment failed"))
+
+		var req received
+		Eventually(requests).Should(Receive(&req))
+		Expect(req.header.Get("Content-Type")).To(Equal("application/json"))
+		Expect(req.header.Get("ce-specversion")).To(Equal("1.0"))
+		Expect(req.header.Get("ce-type")).To(Equal("io.solo.glooshot.experiment.concluded"))
+		Expect(req.header.Get("ce-source")).To(Equal("glooshot"))
+		Expect(req.header.Get("ce-subject")).To(Equal("default/abort-ratings"))
+		Expect(req.header.Get("ce-id")).NotTo(BeEmpty())
+
+		var data CloudEventData
+		Expect(json.Unmarshal(req.body, &data)).NotTo(HaveOccurred())
+		Expect(data.Experiment).To(Equal(experiment.Metadata.Ref()))
+		Expect(data.SpecHash).NotTo(BeEmpty())
+		Expect(data.Message).To(Equal("experiment failed"))
+		var result map[string]interface{}
+		Expect(json.Unmarshal(data.Result, &result)).NotTo(HaveOccurred())
+		Expect(result).To(HaveKeyWithValue("state", "Failed"))
+	})
+
+	It("emits events in structured mode", func() {
+		observer, err := NewCloudEventsObserver(ctx, CloudEventsOpts{SinkURL: server.URL, Mode: CloudEventsStructured, Source: "glooshot"})
+		Expect(err).NotTo(HaveOccurred())
+
+		observer.Observe(ctx, NewEvent(ExperimentStarted, experiment, "experiment started"))
+		observer.Observe(ctx, NewEvent(ExperimentConcluded, experiment, "experiment failed"))
+
+		var types []string
+		for i := 0; i < 2; i++ {
+			var req received
+			Eventually(requests).Should(Receive(&req))
+			Expect(req.header.Get("Content-Type")).To(Equal("application/cloudevents+json"))
+			var ce CloudEvent
+			Expect(json.Unmarshal(req.body, &ce)).NotTo(HaveOccurred())
+			Expect(ce.SpecVersion).To(Equal("1.0"))
+			Expect(ce.Data.Experiment).To(Equal(experiment.Metadata.Ref()))
+			types = append(types, ce.Type)
+		}
+		// events are delivered in order
+		Expect(types).To(Equal([]string{"io.solo.glooshot.experiment.started", "io.solo.glooshot.experiment.concluded"}))
+	})
+
+	It("rejects unknown modes", func() {
+		_, err := NewCloudEventsObserver(ctx, CloudEventsOpts{SinkURL: server.URL, Mode: "batched"})
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,212 @@
+package lifecycle
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	v1 "github.com/solo-io/glooshot/pkg/api/v1"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	sgv1 "github.com/solo-io/supergloo/pkg/api/v1"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	kubeEventsComponent = "glooshot"
+
+	// events are dropped rather than blocking glooshot if the api server falls this far behind
+	kubeEventsQueueSize = 100
+)
+
+var (
+	experimentGVR = schema.GroupVersionResource{
+		Group:    v1.ExperimentCrd.Group,
+		Version:  v1.ExperimentCrd.Version,
+		Resource: v1.ExperimentCrd.Plural,
+	}
+	routingRuleGVR = schema.GroupVersionResource{
+		Group:    sgv1.RoutingRuleCrd.Group,
+		Version:  sgv1.RoutingRuleCrd.Version,
+		Resource: sgv1.RoutingRuleCrd.Plural,
+	}
+)
+
+// looks up the uid of a kubernetes object
+// tools such as kubectl describe match events to objects by uid, solo-kit resources do not carry it
+type UIDLookup func(gvr schema.GroupVersionResource, namespace, name string) (types.UID, error)
+
+func DynamicUIDLookup(client dynamic.Interface) UIDLookup {
+	return func(gvr schema.GroupVersionResource, namespace, name string) (types.UID, error) {
+		obj, err := client.Resource(gvr).Namespace(namespace).Get(name, metav1.GetOptions{})
+		if err != nil {
+			return "", err
+		}
+		return obj.GetUID(), nil
+	}
+}
+
+type kubeEventsObserver struct {
+	kube      kubernetes.Interface
+	lookupUID UIDLookup
+	events    chan kubeEvent
+}
+
+// the kubernetes events of a transition, prepared when it is observed so later changes to the experiment do not leak in
+type kubeEvent struct {
+	reason    string
+	eventType string
+	objects   []kubeEventObject
+}
+
+type kubeEventObject struct {
+	gvr     schema.GroupVersionResource
+	kind    string
+	ref     core.ResourceRef
+	message string
+}
+
+// records kubernetes events against the experiment and the routing rules affected by each transition, in the order
+// they are observed, until the context is cancelled
+// lookupUID is optional, events are recorded without a uid if it is nil or the lookup fails
+func NewKubeEventsObserver(ctx context.Context, kube kubernetes.Interface, lookupUID UIDLookup) Observer {
+	o := &kubeEventsObserver{
+		kube:      kube,
+		lookupUID: lookupUID,
+		events:    make(chan kubeEvent, kubeEventsQueueSize),
+	}
+	go o.run(ctx)
+	return o
+}
+
+func (o *kubeEventsObserver) Observe(ctx context.Context, event Event) {
+	reason, eventType, ok := kubeEventReason(event)
+	if !ok {
+		return
+	}
+	message := kubeEventMessage(event)
+	ref := event.Experiment.Metadata.Ref()
+	queued := kubeEvent{reason: reason, eventType: eventType}
+	queued.objects = append(queued.objects, kubeEventObject{
+		gvr:     experimentGVR,
+		kind:    v1.ExperimentCrd.KindName,
+		ref:     ref,
+		message: message,
+	})
+	for _, rr := range event.RoutingRules {
+		queued.objects = append(queued.objects, kubeEventObject{
+			gvr:     routingRuleGVR,
+			kind:    sgv1.RoutingRuleCrd.KindName,
+			ref:     rr,
+			message: fmt.Sprintf("experiment %v.%v: %v", ref.Namespace, ref.Name, message),
+		})
+	}
+	select {
+	case o.events <- queued:
+	default:
+		contextutils.LoggerFrom(ctx).Warnw("kubernetes events queue is full, dropping event", "type", event.Type)
+	}
+}
+
+func (o *kubeEventsObserver) run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-o.events:
+			for _, obj := range event.objects {
+				o.record(ctx, o.objectReference(ctx, obj.gvr, obj.kind, obj.ref), event.reason, event.eventType, obj.message)
+			}
+		}
+	}
+}
+
+// the reason and type of the kubernetes event for the transition, false if it should not be recorded
+func kubeEventReason(event Event) (string, string, bool) {
+	switch event.Type {
+	case ExperimentStarted:
+		return "Started", corev1.EventTypeNormal, true
+	case ExperimentStageChanged:
+		if event.Details["stage"] == StageFaultsInjected {
+			return "FaultsInjected", corev1.EventTypeNormal, true
+		}
+		return "StageChanged", corev1.EventTypeNormal, true
+	case ExperimentConditionBreached:
+		return "ConditionBreached", corev1.EventTypeWarning, true
+	case ExperimentConcluded:
+		switch event.Experiment.Result.State {
+		case v1.ExperimentResult_Succeeded:
+			return "Succeeded", corev1.EventTypeNormal, true
+		case v1.ExperimentResult_Rejected:
+			return "Rejected", corev1.EventTypeWarning, true
+		}
+		return "Failed", corev1.EventTypeWarning, true
+	case ExperimentRolledBack:
+		return "RolledBack", corev1.EventTypeNormal, true
+	}
+	return "", "", false
+}
+
+// the message of the transition followed by its details, e.g. the measured value of a breached condition
+func kubeEventMessage(event Event) string {
+	if len(event.Details) == 0 {
+		return event.Message
+	}
+	keys := make([]string, 0, len(event.Details))
+	for k := range event.Details {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var details []string
+	for _, k := range keys {
+		details = append(details, fmt.Sprintf("%v=%v", k, event.Details[k]))
+	}
+	return fmt.Sprintf("%v (%v)", event.Message, strings.Join(details, ", "))
+}
+
+func (o *kubeEventsObserver) objectReference(ctx context.Context, gvr schema.GroupVersionResource, kind string, ref core.ResourceRef) corev1.ObjectReference {
+	objRef := corev1.ObjectReference{
+		APIVersion: gvr.GroupVersion().String(),
+		Kind:       kind,
+		Namespace:  ref.Namespace,
+		Name:       ref.Name,
+	}
+	if o.lookupUID != nil {
+		uid, err := o.lookupUID(gvr, ref.Namespace, ref.Name)
+		if err != nil {
+			contextutils.LoggerFrom(ctx).Debugw("could not look up uid for event", zap.Error(err), "object", ref)
+		}
+		objRef.UID = uid
+	}
+	return objRef
+}
+
+func (o *kubeEventsObserver) record(ctx context.Context, obj corev1.ObjectReference, reason, eventType, message string) {
+	now := metav1.Now()
+	_, err := o.kube.CoreV1().Events(obj.Namespace).Create(&corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%v.%x", obj.Name, now.UnixNano()),
+			Namespace: obj.Namespace,
+		},
+		InvolvedObject: obj,
+		Reason:         reason,
+		Message:        message,
+		Type:           eventType,
+		Source:         corev1.EventSource{Component: kubeEventsComponent},
+		FirstTimestamp: now,
+		LastTimestamp:  now,
+		Count:          1,
+	})
+	if err != nil {
+		contextutils.LoggerFrom(ctx).Warnw("failed to record kubernetes event", zap.Error(err),
+			"reason", reason,
+			"object", obj.Namespace+"."+obj.Name)
+	}
+}
@@ -0,0 +1,111 @@
+package lifecycle_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/glooshot/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+
+	. "github.com/solo-io/glooshot/pkg/lifecycle"
+)
+
+var _ = Describe("KubeEvents", func() {
+	var (
+		ctx        context.Context
+		cancel     context.CancelFunc
+		kube       *fake.Clientset
+		observer   Observer
+		experiment *v1.Experiment
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.TODO())
+		kube = fake.NewSimpleClientset()
+		observer = NewKubeEventsObserver(ctx, kube, func(gvr schema.GroupVersionResource, namespace, name string) (types.UID, error) {
+			return types.UID(gvr.Resource + "-" + name), nil
+		})
+		experiment = v1.NewExperiment("default", "abort-ratings")
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	listEvents := func() []corev1.Event {
+		events, err := kube.CoreV1().Events("default").List(metav1.ListOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		return events.Items
+	}
+	// events are recorded in the background
+	awaitEvents := func(count int) []corev1.Event {
+		Eventually(listEvents, time.Second).Should(HaveLen(count))
+		return listEvents()
+	}
+
+	It("records breached conditions against the experiment", func() {
+		event := NewEvent(ExperimentConditionBreached, experiment, "failure condition breached: value_exceeded_threshold")
+		event.Details = map[string]string{"value": "0.42", "threshold": "0.9"}
+		observer.Observe(context.TODO(), event)
+
+		events := awaitEvents(1)
+		Expect(events[0].InvolvedObject).To(Equal(corev1.ObjectReference{
+			APIVersion: "glooshot.solo.io/v1",
+			Kind:       "Experiment",
+			Namespace:  "default",
+			Name:       "abort-ratings",
+			UID:        "experiments-abort-ratings",
+		}))
+		Expect(events[0].Type).To(Equal(corev1.EventTypeWarning))
+		Expect(events[0].Reason).To(Equal("ConditionBreached"))
+		Expect(events[0].Message).To(Equal("failure condition breached: value_exceeded_threshold (threshold=0.9, value=0.42)"))
+	})
+
+	It("records fault injection against the experiment and its routing rules", func() {
+		event := NewEvent(ExperimentStageChanged, experiment, "applied 1 routing rule(s) injecting faults")
+		event.Details = map[string]string{"stage": StageFaultsInjected}
+		event.RoutingRules = []core.ResourceRef{{Namespace: "default", Name: "abort-ratings-0"}}
+		observer.Observe(context.TODO(), event)
+
+		events := awaitEvents(2)
+		kinds := map[string]string{}
+		for _, e := range events {
+			Expect(e.Reason).To(Equal("FaultsInjected"))
+			kinds[e.InvolvedObject.Kind] = e.InvolvedObject.Name
+		}
+		Expect(kinds).To(Equal(map[string]string{
+			"Experiment":  "abort-ratings",
+			"RoutingRule": "abort-ratings-0",
+		}))
+	})
+
+	It("records the outcome of concluded experiments", func() {
+		experiment.Result.State = v1.ExperimentResult_Succeeded
+		observer.Observe(context.TODO(), NewEvent(ExperimentConcluded, experiment, "experiment succeeded"))
+
+		events := awaitEvents(1)
+		Expect(events[0].Type).To(Equal(corev1.EventTypeNormal))
+		Expect(events[0].Reason).To(Equal("Succeeded"))
+	})
+
+	It("does not record created events", func() {
+		observer.Observe(context.TODO(), NewEvent(ExperimentCreated, experiment, "experiment created"))
+		Consistently(listEvents, 100*time.Millisecond).Should(BeEmpty())
+	})
+
+	It("lists the details in the order of their keys", func() {
+		event := NewEvent(ExperimentConditionBreached, experiment, "failure condition breached")
+		event.Details = map[string]string{"value-max": "1", "value": "0.42", "condition": "errors"}
+		observer.Observe(context.TODO(), event)
+
+		events := awaitEvents(1)
+		Expect(events[0].Message).To(Equal("failure condition breached (condition=errors, value=0.42, value-max=1)"))
+	})
+})
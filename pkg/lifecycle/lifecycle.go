@@ -0,0 +1,78 @@
+package lifecycle
+
+import (
+	"context"
+	"time"
+
+	v1 "github.com/solo-io/glooshot/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+// the transitions an experiment goes through
+type EventType string
+
+const (
+	// the experiment was observed for the first time
+	ExperimentCreated EventType = "experiment.created"
+	// the experiment was marked as started, and its failure conditions are being monitored
+	ExperimentStarted EventType = "experiment.started"
+	// the experiment moved to a new stage, the stage is provided in the event details
+	ExperimentStageChanged EventType = "experiment.stage_changed"
+	// one of the failure conditions of the experiment was met
+	ExperimentConditionBreached EventType = "experiment.condition_breached"
+	// the experiment succeeded, failed or was rejected
+	ExperimentConcluded EventType = "experiment.concluded"
+	// the faults injected by the experiment were removed
+	ExperimentRolledBack EventType = "experiment.rolled_back"
+)
+
+const (
+	// the routing rules injecting the faults of the experiment were applied
+	StageFaultsInjected = "faults_injected"
+)
+
+// a transition of an experiment
+type Event struct {
+	Type EventType
+	// the experiment as of the transition
+	Experiment *v1.Experiment
+	// a human readable description of the transition
+	Message string
+	// additional information about the transition, e.g. the failure report for breached conditions
+	Details map[string]string
+	// the routing rules affected by the transition, if any
+	RoutingRules []core.ResourceRef
+	Time         time.Time
+}
+
+func NewEvent(eventType EventType, experiment *v1.Experiment, message string) Event {
+	return Event{
+		Type:       eventType,
+		Experiment: experiment,
+		Message:    message,
+		Time:       time.Now(),
+	}
+}
+
+// Observer is notified of experiment transitions
+// implementations should not block, and are responsible for handling their own errors
+type Observer interface {
+	Observe(ctx context.Context, event Event)
+}
+
+// notifies each of the observers in order
+type Observers []Observer
+
+func (o Observers) Observe(ctx context.Context, event Event) {
+	for _, observer := range o {
+		observer.Observe(ctx, event)
+	}
+}
+
+// returns the observer, or an observer which ignores all events if it is nil
+func OrNoop(observer Observer) Observer {
+	if observer == nil {
+		return Observers(nil)
+	}
+	return observer
+}
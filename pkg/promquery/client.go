@@ -131,7 +131,12 @@ func NewQueryPubSub(rootCtx context.Context, promClient QueryClient, customPolli
 }
 
 func (c *queryPubSub) queryScalar(ctx context.Context, query Query) (Result, error) {
-	result, err := c.client.Query(ctx, string(query), time.Now())
+	return QueryScalar(ctx, c.client, query)
+}
+
+// QueryScalar runs the query once, failing if it does not return a scalar
+func QueryScalar(ctx context.Context, client QueryClient, query Query) (Result, error) {
+	result, err := client.Query(ctx, string(query), time.Now())
 	if err != nil {
 		return 0, err
 	}
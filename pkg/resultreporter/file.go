@@ -0,0 +1,37 @@
+package resultreporter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	v1 "github.com/solo-io/glooshot/pkg/api/v1"
+	"github.com/solo-io/go-utils/errors"
+)
+
+type fileSink struct {
+	directory string
+}
+
+// writes the result as JSON to <directory>/<namespace>.<name>.json, overwriting the result of any previous run
+func NewFileSink(file *v1.ReportSink_FileSink) (Sink, error) {
+	if file.Directory == "" {
+		return nil, errors.Errorf("file sink must specify a directory")
+	}
+	return &fileSink{directory: file.Directory}, nil
+}
+
+func (s *fileSink) Report(ctx context.Context, result *Result) error {
+	if err := os.MkdirAll(s.directory, 0755); err != nil {
+		return err
+	}
+	b, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return err
+	}
+	filename := fmt.Sprintf("%v.%v.json", result.Experiment.Namespace, result.Experiment.Name)
+	return ioutil.WriteFile(filepath.Join(s.directory, filename), b, 0644)
+}
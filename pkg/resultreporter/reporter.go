@@ -1 +1,136 @@
 package resultreporter
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	v1 "github.com/solo-io/glooshot/pkg/api/v1"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/go-utils/errors"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"go.uber.org/multierr"
+)
+
+// the result of a concluded experiment, as delivered to sinks
+type Result struct {
+	Experiment        core.ResourceRef         `json:"experiment"`
+	State             string                   `json:"state"`
+	FailureReport     map[string]string        `json:"failureReport,omitempty"`
+	TimeStarted       *time.Time               `json:"timeStarted,omitempty"`
+	TimeFinished      *time.Time               `json:"timeFinished,omitempty"`
+	FailureConditions []FailureConditionResult `json:"failureConditions,omitempty"`
+}
+
+// a summary of the measurements of a single failure condition
+type FailureConditionResult struct {
+	Name                string  `json:"name"`
+	Samples             int     `json:"samples"`
+	LastValue           float64 `json:"lastValue"`
+	ErrorBudgetConsumed float64 `json:"errorBudgetConsumed,omitempty"`
+}
+
+func NewResult(experiment *v1.Experiment, report *v1.Report) *Result {
+	result := &Result{
+		Experiment:    experiment.Metadata.Ref(),
+		State:         experiment.Result.State.String(),
+		FailureReport: experiment.Result.FailureReport,
+		TimeStarted:   timeFromProto(experiment.Result.TimeStarted),
+		TimeFinished:  timeFromProto(experiment.Result.TimeFinished),
+	}
+	if report == nil {
+		return result
+	}
+	for _, history := range report.FailureConditionHistory {
+		if history == nil {
+			continue
+		}
+		fcResult := FailureConditionResult{
+			Name:                history.FailureConditionName,
+			Samples:             len(history.FailureConditionSnapshots),
+			ErrorBudgetConsumed: history.ErrorBudgetConsumed,
+		}
+		if fcResult.Samples > 0 {
+			fcResult.LastValue = history.FailureConditionSnapshots[fcResult.Samples-1].Value
+		}
+		result.FailureConditions = append(result.FailureConditions, fcResult)
+	}
+	return result
+}
+
+func timeFromProto(ts *types.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t, err := types.TimestampFromProto(ts)
+	if err != nil {
+		return nil
+	}
+	return &t
+}
+
+// Sink delivers the result of a concluded experiment to a destination
+type Sink interface {
+	Report(ctx context.Context, result *Result) error
+}
+
+// Reporter delivers the result of a concluded experiment to every sink configured in the experiment's namespace
+type Reporter interface {
+	ReportResult(ctx context.Context, experiment *v1.Experiment, report *v1.Report) error
+}
+
+type reporter struct {
+	sinks      v1.ReportSinkClient
+	httpClient *http.Client
+}
+
+var defaultTimeout = time.Second * 10
+
+func NewReporter(sinks v1.ReportSinkClient) Reporter {
+	return &reporter{
+		sinks:      sinks,
+		httpClient: &http.Client{Timeout: defaultTimeout},
+	}
+}
+
+func (r *reporter) ReportResult(ctx context.Context, experiment *v1.Experiment, report *v1.Report) error {
+	reportSinks, err := r.sinks.List(experiment.Metadata.Namespace, clients.ListOpts{Ctx: ctx})
+	if err != nil {
+		return errors.Wrapf(err, "listing report sinks")
+	}
+	result := NewResult(experiment, report)
+	var errs error
+	for _, reportSink := range reportSinks {
+		if reportSink.FailuresOnly && experiment.Result.State != v1.ExperimentResult_Failed {
+			continue
+		}
+		sink, err := SinkFor(reportSink, r.httpClient)
+		if err != nil {
+			errs = multierr.Append(errs, err)
+			continue
+		}
+		if err := sink.Report(ctx, result); err != nil {
+			errs = multierr.Append(errs, errors.Wrapf(err, "reporting to sink %v", reportSink.Metadata.Ref()))
+			continue
+		}
+		contextutils.LoggerFrom(ctx).Debugw("reported experiment result to sink",
+			"experiment", result.Experiment,
+			"sink", reportSink.Metadata.Ref())
+	}
+	return errs
+}
+
+// returns the sink described by a ReportSink resource
+func SinkFor(reportSink *v1.ReportSink, httpClient *http.Client) (Sink, error) {
+	switch sink := reportSink.SinkType.(type) {
+	case *v1.ReportSink_Webhook:
+		return NewWebhookSink(sink.Webhook, httpClient)
+	case *v1.ReportSink_Slack:
+		return NewSlackSink(sink.Slack, httpClient)
+	case *v1.ReportSink_File:
+		return NewFileSink(sink.File)
+	}
+	return nil, errors.Errorf("report sink %v does not specify a sink type", reportSink.Metadata.Ref())
+}
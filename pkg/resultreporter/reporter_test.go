@@ -0,0 +1,171 @@
+package resultreporter_test
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/glooshot/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
+
+	. "github.com/solo-io/glooshot/pkg/resultreporter"
+)
+
+var _ = Describe("Reporter", func() {
+	var (
+		sinks      v1.ReportSinkClient
+		reporter   Reporter
+		server     *httptest.Server
+		requests   chan *http.Request
+		bodies     chan []byte
+		experiment *v1.Experiment
+		report     *v1.Report
+	)
+
+	BeforeEach(func() {
+		var err error
+		sinks, err = v1.NewReportSinkClient(&factory.MemoryResourceClientFactory{Cache: memory.NewInMemoryResourceCache()})
+		Expect(err).NotTo(HaveOccurred())
+		reporter = NewReporter(sinks)
+
+		requests = make(chan *http.Request, 10)
+		bodies = make(chan []byte, 10)
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := ioutil.ReadAll(r.Body)
+			requests <- r
+			bodies <- body
+		}))
+
+		experiment = v1.NewExperiment("default", "abort-ratings")
+		experiment.Result.State = v1.ExperimentResult_Failed
+		experiment.Result.FailureReport = map[string]string{"failure_type": "value_exceeded_threshold"}
+		report = &v1.Report{
+			FailureConditionHistory: []*v1.Report_FailureConditionHistory{{
+				FailureConditionName: "success-rate",
+				FailureConditionSnapshots: []*v1.Report_FailureConditionSnapshot{
+					{Value: 0.99},
+					{Value: 0.42},
+				},
+			}},
+		}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	writeSink := func(sink *v1.ReportSink) {
+		_, err := sinks.Write(sink, clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	It("posts the result as json to webhook sinks", func() {
+		sink := v1.NewReportSink("default", "webhook")
+		sink.SinkType = &v1.ReportSink_Webhook{Webhook: &v1.ReportSink_WebhookSink{
+			Url:     server.URL,
+			Headers: map[string]string{"Authorization": "Bearer token"},
+		}}
+		writeSink(sink)
+
+		err := reporter.ReportResult(context.TODO(), experiment, report)
+		Expect(err).NotTo(HaveOccurred())
+
+		var req *http.Request
+		Eventually(requests).Should(Receive(&req))
+		Expect(req.Method).To(Equal(http.MethodPost))
+		Expect(req.Header.Get("Content-Type")).To(Equal("application/json"))
+		Expect(req.Header.Get("Authorization")).To(Equal("Bearer token"))
+
+		var result Result
+		Expect(json.Unmarshal(<-bodies, &result)).NotTo(HaveOccurred())
+		Expect(result.Experiment).To(Equal(experiment.Metadata.Ref()))
+		Expect(result.State).To(Equal("Failed"))
+		Expect(result.FailureReport).To(Equal(experiment.Result.FailureReport))
+		Expect(result.FailureConditions).To(Equal([]FailureConditionResult{{
+			Name:      "success-rate",
+			Samples:   2,
+			LastValue: 0.42,
+		}}))
+	})
+
+	It("posts a summary to slack sinks", func() {
+		sink := v1.NewReportSink("default", "slack")
+		sink.SinkType = &v1.ReportSink_Slack{Slack: &v1.ReportSink_SlackSink{
+			WebhookUrl: server.URL,
+			Channel:    "#chaos",
+		}}
+		writeSink(sink)
+
+		err := reporter.ReportResult(context.TODO(), experiment, report)
+		Expect(err).NotTo(HaveOccurred())
+
+		var message map[string]string
+		Expect(json.Unmarshal(<-bodies, &message)).NotTo(HaveOccurred())
+		Expect(message["channel"]).To(Equal("#chaos"))
+		Expect(message["text"]).To(ContainSubstring("Experiment *default.abort-ratings* Failed"))
+		Expect(message["text"]).To(ContainSubstring("failure_type: `value_exceeded_threshold`"))
+	})
+
+	It("writes the result to file sinks", func() {
+		dir, err := ioutil.TempDir("", "glooshot-results")
+		Expect(err).NotTo(HaveOccurred())
+		defer os.RemoveAll(dir)
+
+		sink := v1.NewReportSink("default", "file")
+		sink.SinkType = &v1.ReportSink_File{File: &v1.ReportSink_FileSink{Directory: dir}}
+		writeSink(sink)
+
+		err = reporter.ReportResult(context.TODO(), experiment, report)
+		Expect(err).NotTo(HaveOccurred())
+
+		b, err := ioutil.ReadFile(filepath.Join(dir, "default.abort-ratings.json"))
+		Expect(err).NotTo(HaveOccurred())
+		var result Result
+		Expect(json.Unmarshal(b, &result)).NotTo(HaveOccurred())
+		Expect(result.State).To(Equal("Failed"))
+	})
+
+	It("skips failures only sinks for successful experiments", func() {
+		sink := v1.NewReportSink("default", "webhook")
+		sink.SinkType = &v1.ReportSink_Webhook{Webhook: &v1.ReportSink_WebhookSink{Url: server.URL}}
+		sink.FailuresOnly = true
+		writeSink(sink)
+
+		experiment.Result.State = v1.ExperimentResult_Succeeded
+		err := reporter.ReportResult(context.TODO(), experiment, report)
+		Expect(err).NotTo(HaveOccurred())
+		Consistently(requests).ShouldNot(Receive())
+	})
+
+	It("only delivers to sinks in the experiment's namespace", func() {
+		sink := v1.NewReportSink("elsewhere", "webhook")
+		sink.SinkType = &v1.ReportSink_Webhook{Webhook: &v1.ReportSink_WebhookSink{Url: server.URL}}
+		writeSink(sink)
+
+		err := reporter.ReportResult(context.TODO(), experiment, report)
+		Expect(err).NotTo(HaveOccurred())
+		Consistently(requests).ShouldNot(Receive())
+	})
+
+	It("returns an error if a sink rejects the result", func() {
+		failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer failing.Close()
+		sink := v1.NewReportSink("default", "webhook")
+		sink.SinkType = &v1.ReportSink_Webhook{Webhook: &v1.ReportSink_WebhookSink{Url: failing.URL}}
+		writeSink(sink)
+
+		err := reporter.ReportResult(context.TODO(), experiment, report)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("500"))
+	})
+})
@@ -0,0 +1,13 @@
+package resultreporter_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestResultReporter(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "ResultReporter Suite")
+}
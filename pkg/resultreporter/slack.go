@@ -0,0 +1,56 @@
+package resultreporter
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	v1 "github.com/solo-io/glooshot/pkg/api/v1"
+	"github.com/solo-io/go-utils/errors"
+)
+
+type slackSink struct {
+	webhookUrl string
+	channel    string
+	httpClient *http.Client
+}
+
+// posts a summary of the result to a Slack-compatible incoming webhook
+func NewSlackSink(slack *v1.ReportSink_SlackSink, httpClient *http.Client) (Sink, error) {
+	if slack.WebhookUrl == "" {
+		return nil, errors.Errorf("slack sink must specify a webhook url")
+	}
+	return &slackSink{webhookUrl: slack.WebhookUrl, channel: slack.Channel, httpClient: httpClient}, nil
+}
+
+type slackMessage struct {
+	Text    string `json:"text"`
+	Channel string `json:"channel,omitempty"`
+}
+
+func (s *slackSink) Report(ctx context.Context, result *Result) error {
+	return postJson(ctx, s.httpClient, s.webhookUrl, nil, &slackMessage{
+		Text:    SlackText(result),
+		Channel: s.channel,
+	})
+}
+
+// renders the result as slack markdown
+func SlackText(result *Result) string {
+	var lines []string
+	lines = append(lines, fmt.Sprintf("Experiment *%v.%v* %v", result.Experiment.Namespace, result.Experiment.Name, result.State))
+	if result.TimeStarted != nil && result.TimeFinished != nil {
+		lines = append(lines, fmt.Sprintf("Duration: %v", result.TimeFinished.Sub(*result.TimeStarted)))
+	}
+	var keys []string
+	for k := range result.FailureReport {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		lines = append(lines, fmt.Sprintf("• %v: `%v`", k, result.FailureReport[k]))
+	}
+	return strings.Join(lines, "\n")
+}
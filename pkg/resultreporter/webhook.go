@@ -0,0 +1,57 @@
+package resultreporter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	v1 "github.com/solo-io/glooshot/pkg/api/v1"
+	"github.com/solo-io/go-utils/errors"
+)
+
+type webhookSink struct {
+	url        string
+	headers    map[string]string
+	httpClient *http.Client
+}
+
+// POSTs the result as JSON to the url of the webhook
+func NewWebhookSink(webhook *v1.ReportSink_WebhookSink, httpClient *http.Client) (Sink, error) {
+	if webhook.Url == "" {
+		return nil, errors.Errorf("webhook sink must specify a url")
+	}
+	return &webhookSink{url: webhook.Url, headers: webhook.Headers, httpClient: httpClient}, nil
+}
+
+func (s *webhookSink) Report(ctx context.Context, result *Result) error {
+	return postJson(ctx, s.httpClient, s.url, s.headers, result)
+}
+
+func postJson(ctx context.Context, httpClient *http.Client, url string, headers map[string]string, payload interface{}) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+		return errors.Errorf("unexpected response from %v: %v %s", url, resp.Status, msg)
+	}
+	return nil
+}
@@ -10,12 +10,20 @@ type Opts struct {
 	MeshResourceNamespace     string
 	PrometheusURL             string
 	PrometheusPollingInterval time.Duration
+	CloudEventsSinkURL        string
+	CloudEventsMode           string
+	CloudEventsSource         string
+	RecordKubeEvents          bool
+	Kubeconfig                string
+	KubeContext               string
 }
 
 const (
 	DefaultSummaryBindAddr           = ":8085"
 	DefaultMeshResourceNamespace     = ""
 	DefaultPrometheusPollingInterval = time.Second * 5
+	DefaultCloudEventsMode           = "binary"
+	DefaultCloudEventsSource         = "glooshot"
 
 	EnvPrometheusURL = "PROMETHEUS_URL"
 )
@@ -35,5 +43,8 @@ func DefaultOpts() Opts {
 		MeshResourceNamespace:     DefaultMeshResourceNamespace,
 		PrometheusURL:             DefaultPrometheusURL,
 		PrometheusPollingInterval: DefaultPrometheusPollingInterval,
+		CloudEventsMode:           DefaultCloudEventsMode,
+		CloudEventsSource:         DefaultCloudEventsSource,
+		RecordKubeEvents:          true,
 	}
 }
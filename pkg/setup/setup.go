@@ -13,15 +13,19 @@ import (
 	v1 "github.com/solo-io/glooshot/pkg/api/v1"
 	"github.com/solo-io/glooshot/pkg/checker"
 	"github.com/solo-io/glooshot/pkg/cli/gsutil"
+	"github.com/solo-io/glooshot/pkg/lifecycle"
 	"github.com/solo-io/glooshot/pkg/promquery"
+	"github.com/solo-io/glooshot/pkg/resultreporter"
 	"github.com/solo-io/glooshot/pkg/setup/options"
 	"github.com/solo-io/glooshot/pkg/starter"
+	"github.com/solo-io/glooshot/pkg/suite"
 	"github.com/solo-io/glooshot/pkg/translator"
 	"github.com/solo-io/glooshot/pkg/version"
 	"github.com/solo-io/go-checkpoint"
 	"github.com/solo-io/go-utils/contextutils"
 	"github.com/solo-io/go-utils/stats"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/wrapper"
+	"k8s.io/client-go/dynamic"
 )
 
 func GetOptions() options.Opts {
@@ -33,6 +37,18 @@ func GetOptions() options.Opts {
 	flag.StringVar(&opts.PrometheusURL, "prometheus-url", options.DefaultPrometheusURL, "required, url on which to reach the prometheus server")
 	flag.DurationVar(&opts.PrometheusPollingInterval, "polling-interval", options.DefaultPrometheusPollingInterval, "optional, "+
 		"interval between polls on running prometheus queries for experiments")
+	flag.StringVar(&opts.CloudEventsSinkURL, "cloudevents-sink-url", "", "optional, url to POST CloudEvents for experiment "+
+		"lifecycle transitions to, no events are emitted unless specified")
+	flag.StringVar(&opts.CloudEventsMode, "cloudevents-mode", options.DefaultCloudEventsMode, "optional, CloudEvents HTTP "+
+		"content mode, either binary or structured")
+	flag.StringVar(&opts.CloudEventsSource, "cloudevents-source", options.DefaultCloudEventsSource, "optional, source "+
+		"attribute of the emitted CloudEvents")
+	flag.BoolVar(&opts.RecordKubeEvents, "record-kube-events", true, "optional, record kubernetes events against "+
+		"experiments and their routing rules for lifecycle transitions")
+	flag.StringVar(&opts.Kubeconfig, "kubeconfig", "", "optional, path to the kubeconfig file, the in-cluster "+
+		"config is used if unspecified")
+	flag.StringVar(&opts.KubeContext, "context", "", "optional, name of the kubeconfig context to use, defaults to "+
+		"the current context")
 	flag.Parse()
 	return opts
 }
@@ -45,6 +61,11 @@ func Run(ctx context.Context, opts options.Opts) error {
 		stats.StartStatsServer()
 	}
 
+	gsutil.SetKubeOptions(gsutil.KubeOptions{
+		Kubeconfig: opts.Kubeconfig,
+		Context:    opts.KubeContext,
+	})
+
 	go func() {
 		mux := http.NewServeMux()
 		mux.Handle("/", newSummaryHandler(ctx))
@@ -67,6 +88,18 @@ func Run(ctx context.Context, opts options.Opts) error {
 	if err != nil {
 		return err
 	}
+	reportSinkClient, err := gsutil.GetReportSinkClient(ctx, true)
+	if err != nil {
+		return err
+	}
+	suiteClient, err := gsutil.GetExperimentSuiteClient(ctx, true)
+	if err != nil {
+		return err
+	}
+	templateClient, err := gsutil.GetExperimentTemplateClient(ctx, true)
+	if err != nil {
+		return err
+	}
 
 	promClient, err := api.NewClient(api.Config{Address: opts.PrometheusURL})
 	if err != nil {
@@ -78,18 +111,29 @@ func Run(ctx context.Context, opts options.Opts) error {
 		return err
 	}
 
+	observer, err := lifecycleObserver(ctx, opts)
+	if err != nil {
+		return err
+	}
+
 	promCache := promquery.NewQueryPubSub(ctx, promApi, opts.PrometheusPollingInterval)
-	failureChecker := checker.NewChecker(promCache, expClient, reportClient)
+	resultReporter := resultreporter.NewReporter(reportSinkClient)
+	failureChecker := checker.NewChecker(promCache, expClient, reportClient, meshClient, opts.MeshResourceNamespace, resultReporter, observer)
 
+	// the suite runner requests syncs once the cool-down between the experiments of a suite elapsed
+	emit := make(chan struct{}, 1)
 	syncers := []v1.ApiSyncer{
-		starter.NewExperimentStarter(expClient),
-		translator.NewSyncer(expClient, rrClient, meshClient, opts),
+		starter.NewExperimentStarter(expClient, observer),
+		translator.NewSyncer(expClient, rrClient, meshClient, opts, observer),
 		checker.NewFailureChecker(failureChecker),
+		suite.NewSuiteRunner(expClient, suiteClient, templateClient, emit),
 	}
 
-	emitter := v1.NewApiSimpleEmitter(wrapper.AggregatedWatchFromClients(wrapper.ClientWatchOpts{
+	emitter := v1.NewApiSimpleEmitterWithEmit(wrapper.AggregatedWatchFromClients(wrapper.ClientWatchOpts{
 		BaseClient: expClient.BaseClient(),
-	}))
+	}, wrapper.ClientWatchOpts{
+		BaseClient: suiteClient.BaseClient(),
+	}), emit)
 	el := v1.NewApiSimpleEventLoop(emitter, syncers...)
 	errs, err := el.Run(ctx)
 
@@ -99,6 +143,38 @@ func Run(ctx context.Context, opts options.Opts) error {
 	return nil
 }
 
+// the observers notified of experiment lifecycle transitions, as enabled by the options
+func lifecycleObserver(ctx context.Context, opts options.Opts) (lifecycle.Observer, error) {
+	var observers lifecycle.Observers
+	if opts.CloudEventsSinkURL != "" {
+		cloudEvents, err := lifecycle.NewCloudEventsObserver(ctx, lifecycle.CloudEventsOpts{
+			SinkURL: opts.CloudEventsSinkURL,
+			Mode:    lifecycle.CloudEventsMode(opts.CloudEventsMode),
+			Source:  opts.CloudEventsSource,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "configuring cloud events")
+		}
+		observers = append(observers, cloudEvents)
+	}
+	if opts.RecordKubeEvents {
+		kubeClient, err := gsutil.GetKubeClient()
+		if err != nil {
+			return nil, err
+		}
+		cfg, err := gsutil.GetRestConfig()
+		if err != nil {
+			return nil, err
+		}
+		dynamicClient, err := dynamic.NewForConfig(cfg)
+		if err != nil {
+			return nil, errors.Wrapf(err, "creating dynamic kubernetes client")
+		}
+		observers = append(observers, lifecycle.NewKubeEventsObserver(ctx, kubeClient, lifecycle.DynamicUIDLookup(dynamicClient)))
+	}
+	return observers, nil
+}
+
 func checkPrometheusConnection(ctx context.Context, promApi promv1.API) error {
 	cfg, err := promApi.Config(ctx)
 	if err != nil {
@@ -9,8 +9,11 @@ import (
 
 	"github.com/gogo/protobuf/types"
 	v1 "github.com/solo-io/glooshot/pkg/api/v1"
+	"github.com/solo-io/glooshot/pkg/checker"
+	"github.com/solo-io/glooshot/pkg/lifecycle"
 	"github.com/solo-io/glooshot/pkg/utils"
 	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/go-utils/errors"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
 	"go.uber.org/multierr"
 )
@@ -18,10 +21,12 @@ import (
 // simple syncer, marks experiments as started
 type experimentStarter struct {
 	experiments v1.ExperimentClient
+	observer    lifecycle.Observer
 }
 
-func NewExperimentStarter(experiments v1.ExperimentClient) v1.ApiSyncer {
-	return &experimentStarter{experiments: experiments}
+// observer is optional, if provided it is notified of created, started and rejected experiments
+func NewExperimentStarter(experiments v1.ExperimentClient, observer lifecycle.Observer) v1.ApiSyncer {
+	return &experimentStarter{experiments: experiments, observer: lifecycle.OrNoop(observer)}
 }
 
 func (s *experimentStarter) Sync(ctx context.Context, snap *v1.ApiSnapshot) error {
@@ -52,17 +57,55 @@ func (s *experimentStarter) ShouldSync(old, new *v1.ApiSnapshot) bool {
 }
 
 func (s *experimentStarter) writeAsStarted(ctx context.Context, experimentToStart *v1.Experiment, now *types.Timestamp) error {
-	experimentToStart.Result.TimeStarted = now
-	experimentToStart.Result.State = v1.ExperimentResult_Started
-	if err := validateOrGenerateFailureConditionNames(experimentToStart); err != nil {
+	s.observer.Observe(ctx, lifecycle.NewEvent(lifecycle.ExperimentCreated, experimentToStart, "experiment created"))
+	rejection := ""
+	if err := validateExperiment(experimentToStart); err != nil {
+		// reject the experiment rather than retrying it on every sync, or silently running it with a default
+		contextutils.LoggerFrom(ctx).Warnf("rejecting invalid experiment %v: %v", experimentToStart.Metadata.Ref(), err)
+		experimentToStart.Result.State = v1.ExperimentResult_Rejected
+		experimentToStart.Result.FailureReport = map[string]string{
+			utils.FailureReportKeyType: "invalid_config",
+			"message":                  err.Error(),
+		}
+		experimentToStart.Result.TimeFinished = now
+		rejection = err.Error()
+	} else {
+		experimentToStart.Result.TimeStarted = now
+		experimentToStart.Result.State = v1.ExperimentResult_Started
+	}
+	written, err := s.experiments.Write(experimentToStart, clients.WriteOpts{Ctx: ctx, OverwriteExisting: true})
+	if err != nil {
 		return err
 	}
-	_, err := s.experiments.Write(experimentToStart, clients.WriteOpts{Ctx: ctx, OverwriteExisting: true})
-	return err
+	if rejection != "" {
+		s.observer.Observe(ctx, lifecycle.NewEvent(lifecycle.ExperimentConcluded, written, "experiment rejected: "+rejection))
+	} else {
+		s.observer.Observe(ctx, lifecycle.NewEvent(lifecycle.ExperimentStarted, written, "experiment started"))
+	}
+	return nil
+}
+
+func validateExperiment(exp *v1.Experiment) error {
+	if err := validateOrGenerateFailureConditionNames(exp); err != nil {
+		return err
+	}
+	if exp.Spec == nil {
+		return nil
+	}
+	for _, fc := range utils.FlattenFailureConditions(exp.Spec.FailureConditions) {
+		if fc.Trigger == nil {
+			continue
+		}
+		if trigger, ok := fc.Trigger.FailureTrigger.(*v1.FailureCondition_Trigger_Prometheus); ok {
+			if _, err := checker.GetComparison(trigger.Prometheus); err != nil {
+				return errors.Wrapf(err, "invalid failure condition %v", fc.Name)
+			}
+		}
+	}
+	return nil
 }
 
 func validateOrGenerateFailureConditionNames(exp *v1.Experiment) error {
-	nameMap := make(map[string]bool)
 	if exp.Spec == nil {
 		return nil
 	}
@@ -70,6 +113,11 @@ func validateOrGenerateFailureConditionNames(exp *v1.Experiment) error {
 		if fc.Name == "" {
 			fc.Name = kubeutils.SanitizeName(fmt.Sprintf("%v-%v", i, time.Now().UnixNano()))
 		}
+		generateNestedFailureConditionNames(fc)
+	}
+	// names must be unique across nested conditions as well, as they identify the measurement history in the report
+	nameMap := make(map[string]bool)
+	for _, fc := range utils.FlattenFailureConditions(exp.Spec.FailureConditions) {
 		if _, exists := nameMap[fc.Name]; exists {
 			return fmt.Errorf("duplicate failure condition names are not allowed, found multiple with name %v", fc.Name)
 		}
@@ -77,3 +125,16 @@ func validateOrGenerateFailureConditionNames(exp *v1.Experiment) error {
 	}
 	return nil
 }
+
+func generateNestedFailureConditionNames(parent *v1.FailureCondition) {
+	compound := utils.CompoundTrigger(parent)
+	if compound == nil {
+		return
+	}
+	for i, fc := range compound.Conditions {
+		if fc.Name == "" {
+			fc.Name = kubeutils.SanitizeName(fmt.Sprintf("%v-%v", parent.Name, i))
+		}
+		generateNestedFailureConditionNames(fc)
+	}
+}
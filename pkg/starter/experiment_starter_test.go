@@ -34,7 +34,7 @@ var _ = Describe("ExperimentStarter", func() {
 		exp2, err = experimentClient.Write(exp2, clients.WriteOpts{})
 		Expect(err).NotTo(HaveOccurred())
 
-		starter := NewExperimentStarter(experimentClient)
+		starter := NewExperimentStarter(experimentClient, nil)
 
 		err = starter.Sync(context.TODO(), &v1.ApiSnapshot{Experiments: v1.ExperimentList{exp1, exp2}})
 		Expect(err).NotTo(HaveOccurred())
@@ -51,4 +51,64 @@ var _ = Describe("ExperimentStarter", func() {
 		Expect(exp2.Result.State).To(Equal(v1.ExperimentResult_Started))
 		Expect(exp2.Result.TimeStarted).NotTo(BeNil())
 	})
+
+	It("generates names for nested failure conditions", func() {
+		experimentClient, err := v1.NewExperimentClient(&factory.MemoryResourceClientFactory{
+			Cache: memory.NewInMemoryResourceCache(),
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		exp := inputs.MakeExperiment("h")
+		exp.Result.TimeStarted = nil
+		exp.Result.State = v1.ExperimentResult_Pending
+		exp.Spec.FailureConditions = []*v1.FailureCondition{{
+			Name: "parent",
+			Trigger: &v1.FailureCondition_Trigger{
+				FailureTrigger: &v1.FailureCondition_Trigger_Compound{
+					Compound: &v1.CompoundTrigger{
+						Operator: v1.CompoundTrigger_OR,
+						Conditions: []*v1.FailureCondition{
+							{Trigger: &v1.FailureCondition_Trigger{}},
+							{Trigger: &v1.FailureCondition_Trigger{}},
+						},
+					},
+				},
+			},
+		}}
+		exp, err = experimentClient.Write(exp, clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+
+		err = NewExperimentStarter(experimentClient, nil).Sync(context.TODO(), &v1.ApiSnapshot{Experiments: v1.ExperimentList{exp}})
+		Expect(err).NotTo(HaveOccurred())
+
+		exp, err = experimentClient.Read(exp.Metadata.Namespace, exp.Metadata.Name, clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		conditions := exp.Spec.FailureConditions[0].Trigger.GetCompound().Conditions
+		Expect(conditions[0].Name).To(Equal("parent-0"))
+		Expect(conditions[1].Name).To(Equal("parent-1"))
+	})
+
+	It("rejects experiments with an unknown comparison operator", func() {
+		experimentClient, err := v1.NewExperimentClient(&factory.MemoryResourceClientFactory{
+			Cache: memory.NewInMemoryResourceCache(),
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		exp := inputs.MakeExperiment("h")
+		exp.Result.TimeStarted = nil
+		exp.Result.State = v1.ExperimentResult_Pending
+		exp.Spec.FailureConditions[0].Trigger.GetPrometheus().ComparisonOperator = "=>"
+		exp, err = experimentClient.Write(exp, clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+
+		err = NewExperimentStarter(experimentClient, nil).Sync(context.TODO(), &v1.ApiSnapshot{Experiments: v1.ExperimentList{exp}})
+		Expect(err).NotTo(HaveOccurred())
+
+		exp, err = experimentClient.Read(exp.Metadata.Namespace, exp.Metadata.Name, clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(exp.Result.State).To(Equal(v1.ExperimentResult_Rejected))
+		Expect(exp.Result.TimeStarted).To(BeNil())
+		Expect(exp.Result.FailureReport["failure_type"]).To(Equal("invalid_config"))
+		Expect(exp.Result.FailureReport["message"]).To(ContainSubstring("unknown comparison operator"))
+	})
 })
@@ -0,0 +1,244 @@
+package suite
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	v1 "github.com/solo-io/glooshot/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// SuiteLabel marks the experiments of a suite with the name of the suite
+const SuiteLabel = "glooshot.solo.io/suite"
+
+// Lineage is shared by the experiments of an entry across the runs of the suite,
+// so that get experiments --history lists them
+func Lineage(suite *v1.ExperimentSuite, entry string) string {
+	return suite.Metadata.Name + "-" + entry
+}
+
+// ExperimentRef references the experiment of the entry in the current run of the suite
+func ExperimentRef(suite *v1.ExperimentSuite, entry string) core.ResourceRef {
+	return core.ResourceRef{
+		Namespace: suite.Metadata.Namespace,
+		Name:      Lineage(suite, entry) + "-" + suite.Result.RunId,
+	}
+}
+
+// Plan is the next step of a suite
+type Plan struct {
+	// Result is the updated result of the suite
+	Result *v1.SuiteResult
+	// Start are the indexes of the entries whose experiments should be created
+	Start []int
+	// Wait is how long to wait for the cool-down before the next experiment can start, zero if none is cooling down
+	Wait time.Duration
+}
+
+// Concluded is true if the suite is done, including suites which were rejected
+func Concluded(result v1.SuiteResult) bool {
+	switch result.State {
+	case v1.SuiteResult_Succeeded, v1.SuiteResult_Failed, v1.SuiteResult_Rejected:
+		return true
+	}
+	return false
+}
+
+// Start begins a new run of a pending suite with the given run id, or rejects it if it is invalid
+func Start(suite *v1.ExperimentSuite, runID string, now time.Time) *v1.SuiteResult {
+	timestamp := toTimestamp(now)
+	if err := Validate(suite); err != nil {
+		return &v1.SuiteResult{
+			State:        v1.SuiteResult_Rejected,
+			TimeStarted:  timestamp,
+			TimeFinished: timestamp,
+			Message:      err.Error(),
+		}
+	}
+	result := &v1.SuiteResult{
+		State:       v1.SuiteResult_Running,
+		RunId:       runID,
+		TimeStarted: timestamp,
+	}
+	for _, entry := range suite.Experiments {
+		result.Experiments = append(result.Experiments, &v1.SuiteResult_EntryResult{Name: entry.Name})
+	}
+	return result
+}
+
+// Validate checks that the entries of the suite can be run
+func Validate(suite *v1.ExperimentSuite) error {
+	if len(suite.Experiments) == 0 {
+		return errors.Errorf("the suite has no experiments")
+	}
+	names := make(map[string]bool)
+	for i, entry := range suite.Experiments {
+		if entry == nil {
+			return errors.Errorf("experiment %v is empty", i+1)
+		}
+		if errs := validation.IsDNS1123Label(entry.Name); len(errs) > 0 {
+			return errors.Errorf("invalid name %q of experiment %v: %v", entry.Name, i+1, strings.Join(errs, ", "))
+		}
+		if names[entry.Name] {
+			return errors.Errorf("duplicate experiment name %v", entry.Name)
+		}
+		names[entry.Name] = true
+		if entry.Source == nil {
+			return errors.Errorf("experiment %v has neither a spec nor a template", entry.Name)
+		}
+	}
+	return nil
+}
+
+// Advance updates the result of a running suite from the states of its experiments,
+// and determines which experiments should start next
+func Advance(suite *v1.ExperimentSuite, experiments v1.ExperimentList, now time.Time) Plan {
+	result := proto.Clone(&suite.Result).(*v1.SuiteResult)
+	plan := Plan{Result: result}
+	if result.State != v1.SuiteResult_Running {
+		return plan
+	}
+
+	for _, entry := range result.Experiments {
+		if entry.State != v1.SuiteResult_EntryResult_Running || entry.Experiment == nil {
+			continue
+		}
+		exp, err := experiments.Find(entry.Experiment.Namespace, entry.Experiment.Name)
+		if err != nil {
+			// not observed yet
+			continue
+		}
+		switch exp.Result.State {
+		case v1.ExperimentResult_Succeeded:
+			entry.State = v1.SuiteResult_EntryResult_Succeeded
+		case v1.ExperimentResult_Failed, v1.ExperimentResult_Rejected:
+			entry.State = v1.SuiteResult_EntryResult_Failed
+		default:
+			continue
+		}
+		entry.TimeFinished = exp.Result.TimeFinished
+		if entry.TimeFinished == nil {
+			entry.TimeFinished = toTimestamp(now)
+		}
+	}
+
+	if suite.Mode == v1.ExperimentSuite_PARALLEL {
+		for i, entry := range result.Experiments {
+			if entry.State == v1.SuiteResult_EntryResult_Waiting {
+				plan.Start = append(plan.Start, i)
+			}
+		}
+	} else {
+		plan.Start, plan.Wait = next(suite, result, now)
+	}
+	for _, i := range plan.Start {
+		entry := result.Experiments[i]
+		ref := ExperimentRef(suite, entry.Name)
+		entry.State = v1.SuiteResult_EntryResult_Running
+		entry.Experiment = &ref
+		entry.TimeStarted = toTimestamp(now)
+	}
+
+	conclude(result, now)
+	return plan
+}
+
+// the entry to start when experiments run one after another, if the cool-down elapsed
+func next(suite *v1.ExperimentSuite, result *v1.SuiteResult, now time.Time) ([]int, time.Duration) {
+	var (
+		lastFinished time.Time
+		failed       bool
+	)
+	for i, entry := range result.Experiments {
+		switch entry.State {
+		case v1.SuiteResult_EntryResult_Running:
+			return nil, 0
+		case v1.SuiteResult_EntryResult_Failed:
+			failed = true
+		case v1.SuiteResult_EntryResult_Waiting:
+			if failed && suite.Mode == v1.ExperimentSuite_STOP_ON_FIRST_FAILURE {
+				skipRemaining(result, "skipped as a previous experiment failed")
+				return nil, 0
+			}
+			if suite.CoolDown != nil && !lastFinished.IsZero() {
+				if wait := lastFinished.Add(*suite.CoolDown).Sub(now); wait > 0 {
+					return nil, wait
+				}
+			}
+			return []int{i}, 0
+		}
+		if finished, ok := toTime(entry.TimeFinished); ok && finished.After(lastFinished) {
+			lastFinished = finished
+		}
+	}
+	return nil, 0
+}
+
+func skipRemaining(result *v1.SuiteResult, message string) {
+	for _, entry := range result.Experiments {
+		if entry.State == v1.SuiteResult_EntryResult_Waiting {
+			entry.State = v1.SuiteResult_EntryResult_Skipped
+			entry.Message = message
+		}
+	}
+}
+
+// concludes the suite once none of its experiments are waiting or running
+func conclude(result *v1.SuiteResult, now time.Time) {
+	state := v1.SuiteResult_Succeeded
+	for _, entry := range result.Experiments {
+		switch entry.State {
+		case v1.SuiteResult_EntryResult_Waiting, v1.SuiteResult_EntryResult_Running:
+			return
+		case v1.SuiteResult_EntryResult_Failed, v1.SuiteResult_EntryResult_Skipped:
+			state = v1.SuiteResult_Failed
+		}
+	}
+	result.State = state
+	result.TimeFinished = toTimestamp(now)
+}
+
+// Summary counts the experiments of the suite by their state, e.g. "2 succeeded, 1 failed"
+func Summary(result v1.SuiteResult) string {
+	counts := make(map[v1.SuiteResult_EntryResult_State]int)
+	for _, entry := range result.Experiments {
+		counts[entry.State]++
+	}
+	var parts []string
+	for _, state := range []v1.SuiteResult_EntryResult_State{
+		v1.SuiteResult_EntryResult_Succeeded,
+		v1.SuiteResult_EntryResult_Failed,
+		v1.SuiteResult_EntryResult_Skipped,
+		v1.SuiteResult_EntryResult_Running,
+		v1.SuiteResult_EntryResult_Waiting,
+	} {
+		if counts[state] > 0 {
+			parts = append(parts, fmt.Sprintf("%v %v", counts[state], strings.ToLower(state.String())))
+		}
+	}
+	if len(parts) == 0 {
+		return "no experiments"
+	}
+	return strings.Join(parts, ", ")
+}
+
+func toTimestamp(t time.Time) *types.Timestamp {
+	ts, err := types.TimestampProto(t)
+	if err != nil {
+		return nil
+	}
+	return ts
+}
+
+func toTime(ts *types.Timestamp) (time.Time, bool) {
+	if ts == nil {
+		return time.Time{}, false
+	}
+	t, err := types.TimestampFromProto(ts)
+	return t, err == nil
+}
@@ -0,0 +1,131 @@
+package suite_test
+
+import (
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/glooshot/pkg/api/v1"
+	"github.com/solo-io/glooshot/pkg/suite"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("Plan", func() {
+
+	var (
+		now     = time.Date(2019, 4, 1, 12, 0, 0, 0, time.UTC)
+		minute  = time.Minute
+		gameDay *v1.ExperimentSuite
+	)
+
+	entry := func(name string) *v1.ExperimentSuite_Entry {
+		return &v1.ExperimentSuite_Entry{Name: name, Source: &v1.ExperimentSuite_Entry_Spec{Spec: &v1.ExperimentSpec{}}}
+	}
+	timestamp := func(t time.Time) *types.Timestamp {
+		ts, err := types.TimestampProto(t)
+		Expect(err).NotTo(HaveOccurred())
+		return ts
+	}
+	concluded := func(name string, state v1.ExperimentResult_State, finished time.Time) *v1.Experiment {
+		return &v1.Experiment{
+			Metadata: core.Metadata{Namespace: "bookinfo", Name: name},
+			Result:   v1.ExperimentResult{State: state, TimeFinished: timestamp(finished)},
+		}
+	}
+	states := func(result *v1.SuiteResult) []v1.SuiteResult_EntryResult_State {
+		var states []v1.SuiteResult_EntryResult_State
+		for _, entry := range result.Experiments {
+			states = append(states, entry.State)
+		}
+		return states
+	}
+
+	BeforeEach(func() {
+		gameDay = &v1.ExperimentSuite{
+			Metadata:    core.Metadata{Namespace: "bookinfo", Name: "game-day"},
+			Experiments: []*v1.ExperimentSuite_Entry{entry("abort"), entry("delay"), entry("partition")},
+			CoolDown:    &minute,
+		}
+		gameDay.Result = *suite.Start(gameDay, "x1y2z", now)
+	})
+
+	It("starts a suite with all experiments waiting", func() {
+		Expect(gameDay.Result.State).To(Equal(v1.SuiteResult_Running))
+		Expect(gameDay.Result.RunId).To(Equal("x1y2z"))
+		Expect(states(&gameDay.Result)).To(Equal([]v1.SuiteResult_EntryResult_State{
+			v1.SuiteResult_EntryResult_Waiting, v1.SuiteResult_EntryResult_Waiting, v1.SuiteResult_EntryResult_Waiting,
+		}))
+	})
+
+	It("rejects invalid suites", func() {
+		gameDay.Experiments = append(gameDay.Experiments, entry("abort"))
+		result := suite.Start(gameDay, "x1y2z", now)
+		Expect(result.State).To(Equal(v1.SuiteResult_Rejected))
+		Expect(result.Message).To(ContainSubstring("duplicate experiment name abort"))
+
+		gameDay.Experiments = []*v1.ExperimentSuite_Entry{{Name: "Not_Valid"}}
+		Expect(suite.Start(gameDay, "x1y2z", now).State).To(Equal(v1.SuiteResult_Rejected))
+		gameDay.Experiments = []*v1.ExperimentSuite_Entry{{Name: "no-source"}}
+		Expect(suite.Start(gameDay, "x1y2z", now).State).To(Equal(v1.SuiteResult_Rejected))
+	})
+
+	It("runs experiments one after another with a cool-down", func() {
+		plan := suite.Advance(gameDay, nil, now)
+		Expect(plan.Start).To(Equal([]int{0}))
+		Expect(plan.Result.Experiments[0].Experiment).To(Equal(&core.ResourceRef{Namespace: "bookinfo", Name: "game-day-abort-x1y2z"}))
+		gameDay.Result = *plan.Result
+
+		// running
+		plan = suite.Advance(gameDay, nil, now.Add(minute))
+		Expect(plan.Start).To(BeEmpty())
+		Expect(plan.Wait).To(BeZero())
+
+		// concluded, cooling down
+		exps := v1.ExperimentList{concluded("game-day-abort-x1y2z", v1.ExperimentResult_Failed, now.Add(2*minute))}
+		plan = suite.Advance(gameDay, exps, now.Add(2*minute+10*time.Second))
+		Expect(plan.Start).To(BeEmpty())
+		Expect(plan.Wait).To(Equal(50 * time.Second))
+		Expect(plan.Result.Experiments[0].State).To(Equal(v1.SuiteResult_EntryResult_Failed))
+		gameDay.Result = *plan.Result
+
+		plan = suite.Advance(gameDay, exps, now.Add(3*minute))
+		Expect(plan.Start).To(Equal([]int{1}))
+		Expect(plan.Result.State).To(Equal(v1.SuiteResult_Running))
+	})
+
+	It("skips the remaining experiments after a failure when stopping on the first failure", func() {
+		gameDay.Mode = v1.ExperimentSuite_STOP_ON_FIRST_FAILURE
+		gameDay.Result = *suite.Advance(gameDay, nil, now).Result
+
+		exps := v1.ExperimentList{concluded("game-day-abort-x1y2z", v1.ExperimentResult_Rejected, now)}
+		plan := suite.Advance(gameDay, exps, now.Add(minute))
+		Expect(plan.Start).To(BeEmpty())
+		Expect(states(plan.Result)).To(Equal([]v1.SuiteResult_EntryResult_State{
+			v1.SuiteResult_EntryResult_Failed, v1.SuiteResult_EntryResult_Skipped, v1.SuiteResult_EntryResult_Skipped,
+		}))
+		Expect(plan.Result.State).To(Equal(v1.SuiteResult_Failed))
+		Expect(plan.Result.TimeFinished).To(Equal(timestamp(now.Add(minute))))
+		Expect(suite.Summary(*plan.Result)).To(Equal("1 failed, 2 skipped"))
+	})
+
+	It("starts all experiments at once in parallel, and succeeds once all succeeded", func() {
+		gameDay.Mode = v1.ExperimentSuite_PARALLEL
+		plan := suite.Advance(gameDay, nil, now)
+		Expect(plan.Start).To(Equal([]int{0, 1, 2}))
+		gameDay.Result = *plan.Result
+
+		exps := v1.ExperimentList{
+			concluded("game-day-abort-x1y2z", v1.ExperimentResult_Succeeded, now),
+			concluded("game-day-delay-x1y2z", v1.ExperimentResult_Succeeded, now),
+		}
+		plan = suite.Advance(gameDay, exps, now)
+		Expect(plan.Result.State).To(Equal(v1.SuiteResult_Running))
+		gameDay.Result = *plan.Result
+
+		exps = append(exps, concluded("game-day-partition-x1y2z", v1.ExperimentResult_Succeeded, now))
+		plan = suite.Advance(gameDay, exps, now)
+		Expect(plan.Result.State).To(Equal(v1.SuiteResult_Succeeded))
+		Expect(suite.Summary(*plan.Result)).To(Equal("3 succeeded"))
+	})
+})
@@ -0,0 +1,169 @@
+package suite
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	v1 "github.com/solo-io/glooshot/pkg/api/v1"
+	"github.com/solo-io/glooshot/pkg/exptemplate"
+	"github.com/solo-io/glooshot/pkg/utils"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	skerrors "github.com/solo-io/solo-kit/pkg/errors"
+	"go.uber.org/multierr"
+	"k8s.io/apimachinery/pkg/util/rand"
+)
+
+// runs experiment suites, creating the experiments of each suite as the previous ones conclude
+type suiteRunner struct {
+	experiments v1.ExperimentClient
+	suites      v1.ExperimentSuiteClient
+	templates   v1.ExperimentTemplateClient
+	// requests another sync once the cool-down of a suite elapsed, as nothing else changes in the meantime
+	emit chan<- struct{}
+
+	mutex sync.Mutex
+	// the time each suite which is cooling down was last scheduled to be synced again
+	wakeUps map[core.ResourceRef]time.Time
+}
+
+// emit should be the channel the emitter of the event loop force emits snapshots on, it must be buffered
+func NewSuiteRunner(experiments v1.ExperimentClient, suites v1.ExperimentSuiteClient, templates v1.ExperimentTemplateClient, emit chan<- struct{}) v1.ApiSyncer {
+	return &suiteRunner{
+		experiments: experiments,
+		suites:      suites,
+		templates:   templates,
+		emit:        emit,
+		wakeUps:     make(map[core.ResourceRef]time.Time),
+	}
+}
+
+func (s *suiteRunner) Sync(ctx context.Context, snap *v1.ApiSnapshot) error {
+	ctx = contextutils.WithLogger(ctx, fmt.Sprintf("suite-runner-sync-%v", snap.Hash()))
+	logger := contextutils.LoggerFrom(ctx)
+	logger.Infof("begin sync %v", snap.Hash())
+	defer logger.Infof("end sync %v", snap.Hash())
+	logger.Debugf("full snapshot: %v", snap)
+
+	var errs error
+	for _, suite := range snap.Experimentsuites {
+		if Concluded(suite.Result) {
+			continue
+		}
+		if err := s.syncSuite(ctx, suite, snap.Experiments); err != nil {
+			errs = multierr.Append(errs, errors.Wrapf(err, "syncing experiment suite %v", suite.Metadata.Ref()))
+		}
+	}
+	return errs
+}
+
+func (s *suiteRunner) ShouldSync(old, new *v1.ApiSnapshot) bool {
+	for _, suite := range new.Experimentsuites {
+		if !Concluded(suite.Result) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *suiteRunner) syncSuite(ctx context.Context, suite *v1.ExperimentSuite, experiments v1.ExperimentList) error {
+	now := time.Now()
+	if suite.Result.State == v1.SuiteResult_Pending {
+		result := Start(suite, rand.String(5), now)
+		if result.State == v1.SuiteResult_Rejected {
+			contextutils.LoggerFrom(ctx).Warnf("rejecting invalid experiment suite %v: %v", suite.Metadata.Ref(), result.Message)
+		}
+		// the experiments are started once the result is written and synced
+		return s.writeResult(ctx, suite, result)
+	}
+
+	plan := Advance(suite, experiments, now)
+	started := proto.Clone(suite).(*v1.ExperimentSuite)
+	started.Result = *plan.Result
+	for _, i := range plan.Start {
+		entry := plan.Result.Experiments[i]
+		if err := s.createExperiment(ctx, started, suite.Experiments[i]); err != nil {
+			contextutils.LoggerFrom(ctx).Warnf("could not create experiment %v of suite %v: %v", entry.Name, suite.Metadata.Ref(), err)
+			entry.State = v1.SuiteResult_EntryResult_Failed
+			entry.TimeFinished = toTimestamp(now)
+			entry.Message = err.Error()
+		}
+	}
+	if plan.Wait > 0 {
+		s.wakeUp(suite.Metadata.Ref(), now.Add(plan.Wait))
+	}
+	if plan.Result.Equal(&suite.Result) {
+		return nil
+	}
+	return s.writeResult(ctx, suite, plan.Result)
+}
+
+func (s *suiteRunner) writeResult(ctx context.Context, suite *v1.ExperimentSuite, result *v1.SuiteResult) error {
+	updated := proto.Clone(suite).(*v1.ExperimentSuite)
+	updated.Result = *result
+	_, err := s.suites.Write(updated, clients.WriteOpts{Ctx: ctx, OverwriteExisting: true})
+	return err
+}
+
+// creates the experiment of the entry in the current run of the suite, unless it exists already
+func (s *suiteRunner) createExperiment(ctx context.Context, suite *v1.ExperimentSuite, entry *v1.ExperimentSuite_Entry) error {
+	ref := ExperimentRef(suite, entry.Name)
+	metadata := core.Metadata{
+		Namespace: ref.Namespace,
+		Name:      ref.Name,
+		Labels: map[string]string{
+			SuiteLabel:         suite.Metadata.Name,
+			utils.LineageLabel: Lineage(suite, entry.Name),
+		},
+	}
+	var exp *v1.Experiment
+	switch source := entry.Source.(type) {
+	case *v1.ExperimentSuite_Entry_Spec:
+		exp = &v1.Experiment{Metadata: metadata}
+		if source.Spec != nil {
+			exp.Spec = proto.Clone(source.Spec).(*v1.ExperimentSpec)
+		}
+	case *v1.ExperimentSuite_Entry_Template:
+		tmplRef := source.Template.Template
+		if tmplRef.Namespace == "" {
+			tmplRef.Namespace = suite.Metadata.Namespace
+		}
+		tmpl, err := s.templates.Read(tmplRef.Namespace, tmplRef.Name, clients.ReadOpts{Ctx: ctx})
+		if err != nil {
+			return errors.Wrapf(err, "reading experiment template %v.%v", tmplRef.Namespace, tmplRef.Name)
+		}
+		exp, err = exptemplate.Render(tmpl, metadata, source.Template.Values)
+		if err != nil {
+			return errors.Wrapf(err, "rendering experiment template %v.%v", tmplRef.Namespace, tmplRef.Name)
+		}
+	default:
+		return errors.Errorf("experiment %v has neither a spec nor a template", entry.Name)
+	}
+	_, err := s.experiments.Write(exp, clients.WriteOpts{Ctx: ctx})
+	if err != nil && !skerrors.IsExist(err) {
+		return err
+	}
+	return nil
+}
+
+// syncs again at the given time, unless a sync is scheduled for that time already
+func (s *suiteRunner) wakeUp(ref core.ResourceRef, at time.Time) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if scheduled, ok := s.wakeUps[ref]; ok && scheduled.Equal(at) {
+		return
+	}
+	s.wakeUps[ref] = at
+	time.AfterFunc(time.Until(at), func() {
+		select {
+		case s.emit <- struct{}{}:
+		default:
+			// a sync is pending already
+		}
+	})
+}
@@ -0,0 +1,115 @@
+package suite_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/glooshot/pkg/api/v1"
+	"github.com/solo-io/glooshot/pkg/exptemplate"
+	"github.com/solo-io/glooshot/pkg/suite"
+	"github.com/solo-io/glooshot/pkg/utils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("SuiteRunner", func() {
+
+	var (
+		expClient   v1.ExperimentClient
+		suiteClient v1.ExperimentSuiteClient
+		tmplClient  v1.ExperimentTemplateClient
+		runner      v1.ApiSyncer
+	)
+
+	memoryFactory := func() factory.ResourceClientFactory {
+		return &factory.MemoryResourceClientFactory{Cache: memory.NewInMemoryResourceCache()}
+	}
+
+	// syncs the suite with the current experiments, and returns the written suite
+	sync := func(gameDay *v1.ExperimentSuite) *v1.ExperimentSuite {
+		exps, err := expClient.List("bookinfo", clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		err = runner.Sync(context.TODO(), &v1.ApiSnapshot{Experiments: exps, Experimentsuites: v1.ExperimentSuiteList{gameDay}})
+		Expect(err).NotTo(HaveOccurred())
+		gameDay, err = suiteClient.Read(gameDay.Metadata.Namespace, gameDay.Metadata.Name, clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		return gameDay
+	}
+
+	BeforeEach(func() {
+		var err error
+		expClient, err = v1.NewExperimentClient(memoryFactory())
+		Expect(err).NotTo(HaveOccurred())
+		suiteClient, err = v1.NewExperimentSuiteClient(memoryFactory())
+		Expect(err).NotTo(HaveOccurred())
+		tmplClient, err = v1.NewExperimentTemplateClient(memoryFactory())
+		Expect(err).NotTo(HaveOccurred())
+		runner = suite.NewSuiteRunner(expClient, suiteClient, tmplClient, make(chan struct{}, 1))
+
+		_, err = tmplClient.Write(&v1.ExperimentTemplate{
+			Metadata: core.Metadata{Namespace: "bookinfo", Name: "abort"},
+			Parameters: []*v1.ExperimentTemplate_Parameter{
+				{Name: "destination", Type: v1.ExperimentTemplate_Parameter_SERVICE_REF, Required: true},
+			},
+			Spec: "faults:\n- destinationServices:\n  - namespace: {{ .destination.namespace }}\n    name: {{ .destination.name }}\n",
+		}, clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("creates the experiments of a parallel suite from specs and templates", func() {
+		gameDay, err := suiteClient.Write(&v1.ExperimentSuite{
+			Metadata: core.Metadata{Namespace: "bookinfo", Name: "game-day"},
+			Mode:     v1.ExperimentSuite_PARALLEL,
+			Experiments: []*v1.ExperimentSuite_Entry{
+				{Name: "delay", Source: &v1.ExperimentSuite_Entry_Spec{Spec: &v1.ExperimentSpec{}}},
+				{Name: "abort", Source: &v1.ExperimentSuite_Entry_Template{Template: &v1.ExperimentSuite_TemplateRef{
+					Template: core.ResourceRef{Name: "abort"},
+					Values:   map[string]string{"destination": "glooshot.ratings-9080"},
+				}}},
+				{Name: "missing", Source: &v1.ExperimentSuite_Entry_Template{Template: &v1.ExperimentSuite_TemplateRef{
+					Template: core.ResourceRef{Name: "missing"},
+				}}},
+			},
+		}, clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+
+		gameDay = sync(gameDay)
+		Expect(gameDay.Result.State).To(Equal(v1.SuiteResult_Running))
+		runID := gameDay.Result.RunId
+		Expect(runID).NotTo(BeEmpty())
+
+		gameDay = sync(gameDay)
+		delay, err := expClient.Read("bookinfo", "game-day-delay-"+runID, clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(delay.Metadata.Labels).To(Equal(map[string]string{
+			suite.SuiteLabel:   "game-day",
+			utils.LineageLabel: "game-day-delay",
+		}))
+		abort, err := expClient.Read("bookinfo", "game-day-abort-"+runID, clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(abort.Metadata.Labels).To(HaveKeyWithValue(exptemplate.TemplateLabel, "abort"))
+		Expect(abort.Spec.Faults[0].DestinationServices).To(Equal([]*core.ResourceRef{{Namespace: "glooshot", Name: "ratings-9080"}}))
+
+		missing := gameDay.Result.Experiments[2]
+		Expect(missing.State).To(Equal(v1.SuiteResult_EntryResult_Failed))
+		Expect(missing.Message).To(ContainSubstring("reading experiment template bookinfo.missing"))
+
+		for _, exp := range []*v1.Experiment{delay, abort} {
+			exp.Result.State = v1.ExperimentResult_Succeeded
+			_, err = expClient.Write(exp, clients.WriteOpts{OverwriteExisting: true})
+			Expect(err).NotTo(HaveOccurred())
+		}
+		gameDay = sync(gameDay)
+		Expect(gameDay.Result.State).To(Equal(v1.SuiteResult_Failed))
+		Expect(suite.Summary(gameDay.Result)).To(Equal("2 succeeded, 1 failed"))
+	})
+
+	It("does not sync concluded suites", func() {
+		Expect(runner.(v1.ApiSyncDecider).ShouldSync(nil, &v1.ApiSnapshot{Experimentsuites: v1.ExperimentSuiteList{
+			{Metadata: core.Metadata{Name: "done"}, Result: v1.SuiteResult{State: v1.SuiteResult_Succeeded}},
+		}})).To(BeFalse())
+	})
+})
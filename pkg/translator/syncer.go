@@ -6,7 +6,9 @@ import (
 
 	"github.com/solo-io/go-utils/contextutils"
 
+	"github.com/solo-io/glooshot/pkg/lifecycle"
 	"github.com/solo-io/glooshot/pkg/setup/options"
+	"github.com/solo-io/glooshot/pkg/utils"
 
 	"github.com/gogo/protobuf/proto"
 
@@ -25,21 +27,34 @@ func applyCreatedByLabels(labels map[string]string) {
 	labels["created_by"] = "glooshot"
 }
 
+// CreatedByLabels select the routing rules created by glooshot
+func CreatedByLabels() map[string]string {
+	labels := map[string]string{}
+	applyCreatedByLabels(labels)
+	return labels
+}
+
 type glooshotSyncer struct {
 	expClient    v1.ExperimentClient
 	rrClient     sgv1.RoutingRuleClient
 	rrReconciler sgv1.RoutingRuleReconciler
 	meshClient   sgv1.MeshClient
 	opts         options.Opts
+	observer     lifecycle.Observer
+	// the experiments whose faults were applied as of the last sync
+	applied map[core.ResourceRef]appliedFaults
 }
 
-func NewSyncer(expClient v1.ExperimentClient, rrClient sgv1.RoutingRuleClient, meshClient sgv1.MeshClient, opts options.Opts) *glooshotSyncer {
+// observer is optional, if provided it is notified when the faults of an experiment are applied or removed
+func NewSyncer(expClient v1.ExperimentClient, rrClient sgv1.RoutingRuleClient, meshClient sgv1.MeshClient, opts options.Opts, observer lifecycle.Observer) *glooshotSyncer {
 	return &glooshotSyncer{
 		expClient:    expClient,
 		rrClient:     rrClient,
 		rrReconciler: sgv1.NewRoutingRuleReconciler(rrClient),
 		meshClient:   meshClient,
 		opts:         opts,
+		observer:     lifecycle.OrNoop(observer),
+		applied:      make(map[core.ResourceRef]appliedFaults),
 	}
 }
 
@@ -54,11 +69,10 @@ func (g *glooshotSyncer) Sync(ctx context.Context, snap *v1.ApiSnapshot) error {
 	if err != nil {
 		return err
 	}
-	labels := map[string]string{}
-	applyCreatedByLabels(labels)
-	if err := g.rrReconciler.Reconcile("", desired, nil, clients.ListOpts{Ctx: ctx, Selector: labels}); err != nil {
+	if err := g.rrReconciler.Reconcile("", desired, nil, clients.ListOpts{Ctx: ctx, Selector: CreatedByLabels()}); err != nil {
 		return err
 	}
+	g.observeFaultTransitions(ctx, snap.Experiments, desired)
 	return nil
 }
 
@@ -82,7 +96,8 @@ func (g *glooshotSyncer) translateExperimentsToRoutingRules(ctx context.Context,
 }
 
 func (g *glooshotSyncer) translateToRoutingRule(ctx context.Context, exp *v1.Experiment, index int) (*sgv1.RoutingRule, error) {
-	if exp.Result.State == v1.ExperimentResult_Failed || exp.Result.State == v1.ExperimentResult_Succeeded {
+	switch exp.Result.State {
+	case v1.ExperimentResult_Failed, v1.ExperimentResult_Succeeded, v1.ExperimentResult_Rejected:
 		contextutils.LoggerFrom(ctx).Infow("experiment concluded",
 			"namespace", exp.Metadata.Namespace,
 			"name", exp.Metadata.Name,
@@ -97,7 +112,7 @@ func (g *glooshotSyncer) translateToRoutingRule(ctx context.Context, exp *v1.Exp
 	rrName := fmt.Sprintf("%v-%v", expName, index)
 	labels := LabelsForRoutingRule(expName)
 	f := exp.Spec.Faults[index]
-	targetMesh, err := g.getTargetMesh(exp.Spec.TargetMesh)
+	targetMesh, err := g.getTargetMesh(ctx, exp.Spec.TargetMesh)
 	if err != nil {
 		return nil, wrap(err)
 	}
@@ -133,33 +148,12 @@ func (g *glooshotSyncer) translateToRoutingRule(ctx context.Context, exp *v1.Exp
 	}, nil
 }
 
-func (g *glooshotSyncer) getTargetMesh(entry *core.ResourceRef) (*core.ResourceRef, error) {
-	// user provided a mesh spec on the Experiment, verify that it exists
-	if entry != nil {
-		if _, err := g.meshClient.Read(entry.Namespace, entry.Name, clients.ReadOpts{}); err != nil {
-			return nil, err
-		}
-		return entry, nil
-	}
-
-	// user did not provide a mesh spec, try to choose a default
-	meshes, err := g.meshClient.List(g.opts.MeshResourceNamespace, clients.ListOpts{})
+func (g *glooshotSyncer) getTargetMesh(ctx context.Context, entry *core.ResourceRef) (*core.ResourceRef, error) {
+	mesh, err := utils.GetTargetMesh(ctx, g.meshClient, g.opts.MeshResourceNamespace, entry)
 	if err != nil {
 		return nil, err
 	}
-	if len(meshes) == 0 {
-		return nil, fmt.Errorf("no mesh target specified and "+
-			"no meshes found in namespace: %v",
-			g.opts.MeshResourceNamespace)
-	}
-	if len(meshes) > 1 {
-		return nil, fmt.Errorf("no target mesh specified and "+
-			"cannot choose default among the multiple (%v) meshes found in namespace: %v",
-			len(meshes),
-			g.opts.MeshResourceNamespace)
-	}
-
-	meshRef := meshes[0].Metadata.Ref()
+	meshRef := mesh.Metadata.Ref()
 	return &meshRef, nil
 }
 
@@ -0,0 +1,59 @@
+package translator
+
+import (
+	"context"
+	"fmt"
+
+	v1 "github.com/solo-io/glooshot/pkg/api/v1"
+	"github.com/solo-io/glooshot/pkg/lifecycle"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	sgv1 "github.com/solo-io/supergloo/pkg/api/v1"
+)
+
+type appliedFaults struct {
+	experiment   *v1.Experiment
+	routingRules []core.ResourceRef
+}
+
+// compares the routing rules applied in this sync to those of the last one,
+// notifying the observer of experiments whose faults were injected or rolled back
+func (g *glooshotSyncer) observeFaultTransitions(ctx context.Context, experiments v1.ExperimentList, applied sgv1.RoutingRuleList) {
+	current := make(map[core.ResourceRef]appliedFaults)
+	for _, rr := range applied {
+		expRef := core.ResourceRef{Namespace: rr.Metadata.Namespace, Name: rr.Metadata.Labels[RoutingRuleLabelKey]}
+		exp, err := experiments.Find(expRef.Strings())
+		if err != nil {
+			continue
+		}
+		faults := current[expRef]
+		faults.experiment = exp
+		faults.routingRules = append(faults.routingRules, rr.Metadata.Ref())
+		current[expRef] = faults
+	}
+
+	for expRef, faults := range current {
+		if _, ok := g.applied[expRef]; ok {
+			continue
+		}
+		event := lifecycle.NewEvent(lifecycle.ExperimentStageChanged, faults.experiment,
+			fmt.Sprintf("applied %v routing rule(s) injecting faults", len(faults.routingRules)))
+		event.Details = map[string]string{"stage": lifecycle.StageFaultsInjected}
+		event.RoutingRules = faults.routingRules
+		g.observer.Observe(ctx, event)
+	}
+	for expRef, faults := range g.applied {
+		if _, ok := current[expRef]; ok {
+			continue
+		}
+		// prefer the latest version of the experiment, it is gone if the experiment was deleted
+		exp := faults.experiment
+		if latest, err := experiments.Find(expRef.Strings()); err == nil {
+			exp = latest
+		}
+		event := lifecycle.NewEvent(lifecycle.ExperimentRolledBack, exp,
+			fmt.Sprintf("removed %v routing rule(s) injecting faults", len(faults.routingRules)))
+		event.RoutingRules = faults.routingRules
+		g.observer.Observe(ctx, event)
+	}
+	g.applied = current
+}
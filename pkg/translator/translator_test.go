@@ -7,6 +7,7 @@ import (
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
 
 	"github.com/solo-io/glooshot/pkg/api/v1/mocks"
+	"github.com/solo-io/glooshot/pkg/lifecycle"
 	"github.com/solo-io/glooshot/pkg/setup/options"
 	sgmock "github.com/solo-io/supergloo/pkg/api/v1/mocks"
 
@@ -185,6 +186,42 @@ var _ = Describe("translator", func() {
 
 })
 
+var _ = Describe("fault transitions", func() {
+	It("notifies the observer when faults are injected and rolled back", func() {
+		observer := &recordingObserver{}
+		syncer := glooshotSyncer{observer: observer, applied: make(map[core.ResourceRef]appliedFaults)}
+		exp := v1.NewExperiment("default", "exp1")
+		rr := &sgv1.RoutingRule{Metadata: core.Metadata{
+			Namespace: "default",
+			Name:      "exp1-0",
+			Labels:    LabelsForRoutingRule("exp1"),
+		}}
+
+		syncer.observeFaultTransitions(context.TODO(), v1.ExperimentList{exp}, sgv1.RoutingRuleList{rr})
+		Expect(observer.events).To(HaveLen(1))
+		Expect(observer.events[0].Type).To(Equal(lifecycle.ExperimentStageChanged))
+		Expect(observer.events[0].Details).To(HaveKeyWithValue("stage", lifecycle.StageFaultsInjected))
+		Expect(observer.events[0].RoutingRules).To(Equal([]core.ResourceRef{rr.Metadata.Ref()}))
+
+		// no transition while the faults stay applied
+		syncer.observeFaultTransitions(context.TODO(), v1.ExperimentList{exp}, sgv1.RoutingRuleList{rr})
+		Expect(observer.events).To(HaveLen(1))
+
+		syncer.observeFaultTransitions(context.TODO(), v1.ExperimentList{exp}, nil)
+		Expect(observer.events).To(HaveLen(2))
+		Expect(observer.events[1].Type).To(Equal(lifecycle.ExperimentRolledBack))
+		Expect(observer.events[1].Experiment).To(Equal(exp))
+	})
+})
+
+type recordingObserver struct {
+	events []lifecycle.Event
+}
+
+func (o *recordingObserver) Observe(ctx context.Context, event lifecycle.Event) {
+	o.events = append(o.events, event)
+}
+
 // populates clients with mocks
 // override as needed with mocks that provide mock.EXPECT().SomeMethod("some","args")
 // these placeholders will at least notify you where you need to add overrides when/if they fail
@@ -0,0 +1,84 @@
+package utils
+
+import (
+	"fmt"
+
+	v1 "github.com/solo-io/glooshot/pkg/api/v1"
+	"github.com/solo-io/go-utils/errors"
+)
+
+// the symbols accepted by the deprecated string comparison_operator field
+var comparisonOperatorSymbols = map[string]v1.PrometheusTrigger_ComparisonOperator{
+	"<":  v1.PrometheusTrigger_LESS_THAN,
+	"<=": v1.PrometheusTrigger_LESS_THAN_OR_EQUAL,
+	">":  v1.PrometheusTrigger_GREATER_THAN,
+	">=": v1.PrometheusTrigger_GREATER_THAN_OR_EQUAL,
+	"==": v1.PrometheusTrigger_EQUAL,
+	"!=": v1.PrometheusTrigger_NOT_EQUAL,
+}
+
+// Comparison is the resolved operator and threshold or range of a prometheus failure condition
+type Comparison struct {
+	Operator  v1.PrometheusTrigger_ComparisonOperator
+	Threshold float64
+	Low       float64
+	High      float64
+}
+
+// GetComparison resolves the comparison of a prometheus trigger
+// unknown or conflicting operators and invalid ranges are rejected rather than defaulted
+func GetComparison(trigger *v1.PrometheusTrigger) (*Comparison, error) {
+	operator := trigger.Operator
+	if _, known := v1.PrometheusTrigger_ComparisonOperator_name[int32(operator)]; !known {
+		return nil, errors.Errorf("unknown comparison operator %v", operator)
+	}
+	if trigger.ComparisonOperator != "" {
+		fromSymbol, ok := comparisonOperatorSymbols[trigger.ComparisonOperator]
+		if !ok {
+			return nil, errors.Errorf("unknown comparison operator %q, must be one of '<', '<=', '>', '>=', '==' or '!='", trigger.ComparisonOperator)
+		}
+		if operator != v1.PrometheusTrigger_UNSPECIFIED && operator != fromSymbol {
+			return nil, errors.Errorf("conflicting comparison operators %v and %q", operator, trigger.ComparisonOperator)
+		}
+		operator = fromSymbol
+	}
+	if operator == v1.PrometheusTrigger_UNSPECIFIED {
+		operator = v1.PrometheusTrigger_LESS_THAN
+	}
+	comparison := &Comparison{
+		Operator:  operator,
+		Threshold: trigger.ThresholdValue,
+	}
+	if operator == v1.PrometheusTrigger_OUTSIDE_RANGE {
+		if trigger.Range == nil {
+			return nil, errors.Errorf("a range must be provided for the OUTSIDE_RANGE operator")
+		}
+		if trigger.Range.Low > trigger.Range.High {
+			return nil, errors.Errorf("invalid range: low (%v) must not be greater than high (%v)", trigger.Range.Low, trigger.Range.High)
+		}
+		comparison.Low = trigger.Range.Low
+		comparison.High = trigger.Range.High
+	}
+	return comparison, nil
+}
+
+// Symbol returns the symbol of the operator, e.g. ">", or false for operators without one
+func (c *Comparison) Symbol() (string, bool) {
+	for symbol, operator := range comparisonOperatorSymbols {
+		if operator == c.Operator {
+			return symbol, true
+		}
+	}
+	return "", false
+}
+
+// String describes when the failure condition is met, e.g. "> 0.2" or "outside [0.1, 0.5]"
+func (c *Comparison) String() string {
+	if c.Operator == v1.PrometheusTrigger_OUTSIDE_RANGE {
+		return fmt.Sprintf("outside [%v, %v]", c.Low, c.High)
+	}
+	if symbol, ok := c.Symbol(); ok {
+		return fmt.Sprintf("%v %v", symbol, c.Threshold)
+	}
+	return fmt.Sprintf("%v %v", c.Operator, c.Threshold)
+}
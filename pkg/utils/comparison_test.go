@@ -0,0 +1,30 @@
+package utils_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/glooshot/pkg/api/v1"
+	"github.com/solo-io/glooshot/pkg/utils"
+)
+
+var _ = Describe("Comparison", func() {
+
+	describe := func(trigger *v1.PrometheusTrigger) string {
+		comparison, err := utils.GetComparison(trigger)
+		Expect(err).NotTo(HaveOccurred())
+		return comparison.String()
+	}
+
+	It("describes when it is met", func() {
+		Expect(describe(&v1.PrometheusTrigger{ThresholdValue: 0.2, Operator: v1.PrometheusTrigger_GREATER_THAN})).To(Equal("> 0.2"))
+		Expect(describe(&v1.PrometheusTrigger{ThresholdValue: 50})).To(Equal("< 50"))
+		Expect(describe(&v1.PrometheusTrigger{
+			Operator: v1.PrometheusTrigger_OUTSIDE_RANGE,
+			Range:    &v1.PrometheusTrigger_Range{Low: 10, High: 20},
+		})).To(Equal("outside [10, 20]"))
+	})
+
+	It("describes the deprecated string operators by their symbol", func() {
+		Expect(describe(&v1.PrometheusTrigger{ThresholdValue: 1, ComparisonOperator: "!="})).To(Equal("!= 1"))
+	})
+})
@@ -1,9 +1,43 @@
 package utils
 
 import (
+	"fmt"
+	"strings"
+	"time"
+
 	v1 "github.com/solo-io/glooshot/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+const (
+	// DefaultExperimentDuration is how long experiments without a duration run, unless a failure condition is met
+	DefaultExperimentDuration = time.Minute * 10
+	// DefaultSloWindow is the slo window of error budget triggers without one
+	DefaultSloWindow = time.Hour * 24 * 30
 )
 
+// returns how long the experiment runs after it started, unless a failure condition is met
+func ExperimentDuration(experiment *v1.Experiment) time.Duration {
+	if experiment.Spec != nil && experiment.Spec.Duration != nil {
+		return *experiment.Spec.Duration
+	}
+	return DefaultExperimentDuration
+}
+
+// returns the window over which the error budget of the trigger is defined
+func SloWindow(trigger *v1.ErrorBudgetTrigger) time.Duration {
+	if trigger.SloWindow != nil {
+		return *trigger.SloWindow
+	}
+	return DefaultSloWindow
+}
+
+// returns the burn rate above which the error budget trigger is met over the experiment's duration
+// this is the value recorded in the history of error budget failure conditions
+func BurnRateThreshold(trigger *v1.ErrorBudgetTrigger, experiment *v1.Experiment) float64 {
+	return trigger.MaxBudgetFraction * float64(SloWindow(trigger)) / float64(ExperimentDuration(experiment))
+}
+
 func ExperimentsWithState(list v1.ExperimentList, state v1.ExperimentResult_State) v1.ExperimentList {
 	var started v1.ExperimentList
 	list.Each(func(element *v1.Experiment) {
@@ -13,3 +47,73 @@ func ExperimentsWithState(list v1.ExperimentList, state v1.ExperimentResult_Stat
 	})
 	return started
 }
+
+// returns the given failure conditions along with all the conditions nested in compound triggers, depth first
+func FlattenFailureConditions(conditions []*v1.FailureCondition) []*v1.FailureCondition {
+	var flattened []*v1.FailureCondition
+	for _, fc := range conditions {
+		flattened = append(flattened, fc)
+		if compound := CompoundTrigger(fc); compound != nil {
+			flattened = append(flattened, FlattenFailureConditions(compound.Conditions)...)
+		}
+	}
+	return flattened
+}
+
+// returns the compound trigger of the failure condition, or nil if it has a different trigger
+func CompoundTrigger(fc *v1.FailureCondition) *v1.CompoundTrigger {
+	if fc.Trigger == nil {
+		return nil
+	}
+	if compound, ok := fc.Trigger.FailureTrigger.(*v1.FailureCondition_Trigger_Compound); ok {
+		return compound.Compound
+	}
+	return nil
+}
+
+// a short description of the kind of trigger of the failure condition
+func FailureConditionType(fc *v1.FailureCondition) string {
+	switch {
+	case CompoundTrigger(fc) != nil:
+		return "compound (" + CompoundTrigger(fc).Operator.String() + ")"
+	case fc.Trigger.GetPrometheus() != nil:
+		return "prometheus"
+	case fc.Trigger.GetErrorBudget() != nil:
+		return "error budget burn rate"
+	case fc.Trigger.GetWebhookUrl() != "":
+		return "webhook"
+	}
+	return "unknown"
+}
+
+// a human readable summary of the fault, e.g. "abort with HTTP 500 on 50% of requests to default.reviews"
+func FaultSummary(fault *v1.ExperimentSpec_InjectedFault) string {
+	var b strings.Builder
+	switch {
+	case fault.Fault.GetAbort() != nil:
+		fmt.Fprintf(&b, "abort with HTTP %v", fault.Fault.GetAbort().GetHttpStatus())
+	case fault.Fault.GetDelay() != nil:
+		fmt.Fprintf(&b, "delay (%v)", fault.Fault.GetDelay().String())
+	default:
+		b.WriteString("fault")
+	}
+	if fault.Fault != nil {
+		fmt.Fprintf(&b, " on %v%% of requests", fault.Fault.Percentage)
+	}
+	if len(fault.OriginServices) > 0 {
+		fmt.Fprintf(&b, " from %v", RefNames(fault.OriginServices))
+	}
+	if len(fault.DestinationServices) > 0 {
+		fmt.Fprintf(&b, " to %v", RefNames(fault.DestinationServices))
+	}
+	return b.String()
+}
+
+// the namespace.name of each ref, comma separated
+func RefNames(refs []*core.ResourceRef) string {
+	var names []string
+	for _, ref := range refs {
+		names = append(names, ref.Namespace+"."+ref.Name)
+	}
+	return strings.Join(names, ", ")
+}
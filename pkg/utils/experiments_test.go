@@ -0,0 +1,22 @@
+package utils_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/glooshot/pkg/api/v1"
+	"github.com/solo-io/glooshot/pkg/utils"
+)
+
+var _ = Describe("ExperimentDuration", func() {
+
+	It("defaults for experiments without a duration", func() {
+		Expect(utils.ExperimentDuration(&v1.Experiment{})).To(Equal(utils.DefaultExperimentDuration))
+		Expect(utils.ExperimentDuration(&v1.Experiment{Spec: &v1.ExperimentSpec{}})).To(Equal(utils.DefaultExperimentDuration))
+	})
+
+	It("relates the burn rate threshold to the experiment's duration", func() {
+		duration := utils.DefaultSloWindow / 1000
+		exp := &v1.Experiment{Spec: &v1.ExperimentSpec{Duration: &duration}}
+		Expect(utils.BurnRateThreshold(&v1.ErrorBudgetTrigger{MaxBudgetFraction: 0.02}, exp)).To(BeNumerically("~", 20, 1e-9))
+	})
+})
@@ -0,0 +1,9 @@
+package utils
+
+// the keys of the failure report of an experiment
+const (
+	// the kind of failure, e.g. value_exceeded_threshold
+	FailureReportKeyType = "failure_type"
+	// the name of the failure condition which was met
+	FailureReportKeyCondition = "failure_condition"
+)
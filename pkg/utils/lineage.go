@@ -0,0 +1,84 @@
+package utils
+
+import (
+	"sort"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	v1 "github.com/solo-io/glooshot/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+const (
+	// LineageLabel marks every run of an experiment with the name of its first run
+	LineageLabel = "glooshot.solo.io/lineage"
+	// RerunOfLabel marks a run with the name of the experiment it was copied from
+	RerunOfLabel = "glooshot.solo.io/rerun-of"
+)
+
+// Lineage returns the name of the first run of the experiment, which all its runs share
+func Lineage(exp *v1.Experiment) string {
+	if lineage := exp.Metadata.Labels[LineageLabel]; lineage != "" {
+		return lineage
+	}
+	return exp.Metadata.Name
+}
+
+// NewRun copies the spec, labels and annotations of the experiment into a new pending experiment
+// it is named after the lineage of the experiment with the given suffix
+func NewRun(exp *v1.Experiment, suffix string) *v1.Experiment {
+	lineage := Lineage(exp)
+	labels := map[string]string{}
+	for k, v := range exp.Metadata.Labels {
+		labels[k] = v
+	}
+	labels[LineageLabel] = lineage
+	labels[RerunOfLabel] = exp.Metadata.Name
+	annotations := map[string]string{}
+	for k, v := range exp.Metadata.Annotations {
+		annotations[k] = v
+	}
+	run := &v1.Experiment{
+		Metadata: core.Metadata{
+			Namespace:   exp.Metadata.Namespace,
+			Name:        lineage + "-" + suffix,
+			Labels:      labels,
+			Annotations: annotations,
+		},
+	}
+	if exp.Spec != nil {
+		run.Spec = proto.Clone(exp.Spec).(*v1.ExperimentSpec)
+	}
+	return run
+}
+
+// RunsOf returns the runs of the lineage, ordered by the time they started, followed by the runs which have not started
+func RunsOf(list v1.ExperimentList, lineage string) v1.ExperimentList {
+	var runs v1.ExperimentList
+	list.Each(func(element *v1.Experiment) {
+		if Lineage(element) == lineage {
+			runs = append(runs, element)
+		}
+	})
+	sort.SliceStable(runs, func(i, j int) bool {
+		start, started := startTime(runs[i])
+		otherStart, otherStarted := startTime(runs[j])
+		if started != otherStarted {
+			return started
+		}
+		if started && !start.Equal(otherStart) {
+			return start.Before(otherStart)
+		}
+		return runs[i].Metadata.Name < runs[j].Metadata.Name
+	})
+	return runs
+}
+
+func startTime(exp *v1.Experiment) (time.Time, bool) {
+	if exp.Result.TimeStarted == nil {
+		return time.Time{}, false
+	}
+	t, err := types.TimestampFromProto(exp.Result.TimeStarted)
+	return t, err == nil
+}
@@ -0,0 +1,72 @@
+package utils_test
+
+import (
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/glooshot/pkg/api/v1"
+	"github.com/solo-io/glooshot/pkg/utils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("Lineage", func() {
+
+	var duration = time.Minute
+
+	concluded := func(name string, labels map[string]string, started time.Time) *v1.Experiment {
+		ts, err := types.TimestampProto(started)
+		Expect(err).NotTo(HaveOccurred())
+		return &v1.Experiment{
+			Metadata: core.Metadata{Namespace: "bookinfo", Name: name, Labels: labels, ResourceVersion: "42"},
+			Spec:     &v1.ExperimentSpec{Duration: &duration},
+			Result: v1.ExperimentResult{
+				State:       v1.ExperimentResult_Failed,
+				TimeStarted: ts,
+			},
+		}
+	}
+
+	It("copies the spec of the experiment into a new pending run", func() {
+		original := concluded("abort-ratings", map[string]string{"team": "chaos"}, time.Now())
+		run := utils.NewRun(original, "x7k2p")
+
+		Expect(run.Metadata).To(Equal(core.Metadata{
+			Namespace: "bookinfo",
+			Name:      "abort-ratings-x7k2p",
+			Labels: map[string]string{
+				"team":             "chaos",
+				utils.LineageLabel: "abort-ratings",
+				utils.RerunOfLabel: "abort-ratings",
+			},
+			Annotations: map[string]string{},
+		}))
+		Expect(run.Spec).To(Equal(original.Spec))
+		Expect(run.Spec).NotTo(BeIdenticalTo(original.Spec))
+		Expect(run.Result).To(Equal(v1.ExperimentResult{}))
+		Expect(original.Metadata.Labels).NotTo(HaveKey(utils.LineageLabel))
+	})
+
+	It("names reruns of reruns after the first run", func() {
+		first := concluded("abort-ratings", nil, time.Now())
+		second := utils.NewRun(first, "aaaaa")
+		third := utils.NewRun(second, "bbbbb")
+		Expect(third.Metadata.Name).To(Equal("abort-ratings-bbbbb"))
+		Expect(utils.Lineage(third)).To(Equal("abort-ratings"))
+		Expect(third.Metadata.Labels[utils.RerunOfLabel]).To(Equal("abort-ratings-aaaaa"))
+	})
+
+	It("lists the runs of a lineage in the order they started", func() {
+		now := time.Now()
+		first := concluded("abort-ratings", nil, now.Add(-time.Hour))
+		lineage := map[string]string{utils.LineageLabel: "abort-ratings"}
+		second := concluded("abort-ratings-aaaaa", lineage, now.Add(-time.Minute))
+		third := concluded("abort-ratings-bbbbb", lineage, now)
+		pending := utils.NewRun(third, "ccccc")
+		other := concluded("delay-reviews", nil, now)
+
+		runs := utils.RunsOf(v1.ExperimentList{pending, third, other, first, second}, "abort-ratings")
+		Expect(runs).To(Equal(v1.ExperimentList{first, second, third, pending}))
+	})
+})
@@ -0,0 +1,37 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	sgv1 "github.com/solo-io/supergloo/pkg/api/v1"
+)
+
+// returns the mesh to which an experiment applies
+// if the experiment does not specify a mesh, the only mesh in the given namespace is chosen
+func GetTargetMesh(ctx context.Context, meshClient sgv1.MeshClient, meshNamespace string, entry *core.ResourceRef) (*sgv1.Mesh, error) {
+	// user provided a mesh spec on the Experiment, verify that it exists
+	if entry != nil {
+		return meshClient.Read(entry.Namespace, entry.Name, clients.ReadOpts{Ctx: ctx})
+	}
+
+	// user did not provide a mesh spec, try to choose a default
+	meshes, err := meshClient.List(meshNamespace, clients.ListOpts{Ctx: ctx})
+	if err != nil {
+		return nil, err
+	}
+	if len(meshes) == 0 {
+		return nil, fmt.Errorf("no mesh target specified and "+
+			"no meshes found in namespace: %v",
+			meshNamespace)
+	}
+	if len(meshes) > 1 {
+		return nil, fmt.Errorf("no target mesh specified and "+
+			"cannot choose default among the multiple (%v) meshes found in namespace: %v",
+			len(meshes),
+			meshNamespace)
+	}
+	return meshes[0], nil
+}